@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"go-ride/services/admin-cli/internal/commands"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+const usage = `usage: go-ride-admin <command> [arguments]
+
+commands:
+  dlq        inspect, replay and purge dead-lettered messages
+  drivers    review driver onboardings and their documents
+  payments   refund the fare charged for a trip
+  promos     create, deactivate and list promo codes
+  wallet     credit rider wallets and reconcile the ledger
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	var err error
+	switch os.Args[1] {
+	case "dlq":
+		err = commands.RunDLQ(ctx, os.Args[2:])
+	case "drivers":
+		err = commands.RunDrivers(ctx, os.Args[2:])
+	case "payments":
+		err = commands.RunPayments(ctx, os.Args[2:])
+	case "promos":
+		err = commands.RunPromos(ctx, os.Args[2:])
+	case "wallet":
+		err = commands.RunWallet(ctx, os.Args[2:])
+	case "help", "-h", "--help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "go-ride-admin: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,223 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go-ride/shared/messaging"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const dlqUsage = `usage: go-ride-admin dlq <subcommand> [flags]
+
+subcommands:
+  list      list dead-lettered messages
+  replay    publish selected messages back to the queue they died in
+  purge     remove selected messages from the dead letter queue
+
+replay does not go through the trip exchange: it publishes each message to the
+queue it died in through the default exchange, so the other consumers of its
+routing key do not process it a second time. The original routing key travels
+in the x-replay-routing-key header.
+`
+
+var (
+	ErrNoSelection = errors.New("select messages with --ids or --all")
+)
+
+// RunDLQ executes the dlq command and its subcommands.
+func RunDLQ(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, dlqUsage)
+		return errors.New("missing dlq subcommand")
+	}
+
+	switch args[0] {
+	case "list":
+		return runDLQList(ctx, args[1:])
+	case "replay":
+		return runDLQReplay(ctx, args[1:])
+	case "purge":
+		return runDLQPurge(ctx, args[1:])
+	default:
+		fmt.Fprint(os.Stderr, dlqUsage)
+		return fmt.Errorf("unknown dlq subcommand: %s", args[0])
+	}
+}
+
+func runDLQList(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq list", flag.ContinueOnError)
+	amqpURI := fs.String("amqp", messaging.AMQPAddr, "RabbitMQ connection URI")
+	limit := fs.Int("limit", 50, "maximum number of messages to list (0 lists all)")
+	asJSON := fs.Bool("json", false, "print the messages as JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rabbitmq, err := messaging.NewRabbitMQ(*amqpURI)
+	if err != nil {
+		return err
+	}
+	defer rabbitmq.Close()
+
+	letters, err := rabbitmq.InspectDeadLetters(ctx, *limit)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return writeDeadLettersJSON(os.Stdout, letters)
+	}
+
+	return writeDeadLetters(os.Stdout, letters)
+}
+
+func runDLQReplay(ctx context.Context, args []string) error {
+	fs, amqpURI, selection := newDLQSelectionFlags("dlq replay")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	selector, err := selection.selector()
+	if err != nil {
+		return err
+	}
+
+	rabbitmq, err := messaging.NewRabbitMQ(*amqpURI)
+	if err != nil {
+		return err
+	}
+	defer rabbitmq.Close()
+
+	replayed, err := rabbitmq.ReplayDeadLetters(ctx, selector, selection.dryRun)
+	printDLQResult(os.Stdout, "replayed", replayed, selection.dryRun)
+	return err
+}
+
+func runDLQPurge(ctx context.Context, args []string) error {
+	fs, amqpURI, selection := newDLQSelectionFlags("dlq purge")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	selector, err := selection.selector()
+	if err != nil {
+		return err
+	}
+
+	rabbitmq, err := messaging.NewRabbitMQ(*amqpURI)
+	if err != nil {
+		return err
+	}
+	defer rabbitmq.Close()
+
+	purged, err := rabbitmq.PurgeDeadLetters(ctx, selector, selection.dryRun)
+	printDLQResult(os.Stdout, "purged", purged, selection.dryRun)
+	return err
+}
+
+type dlqSelection struct {
+	ids    string
+	all    bool
+	dryRun bool
+}
+
+func newDLQSelectionFlags(name string) (*flag.FlagSet, *string, *dlqSelection) {
+	selection := &dlqSelection{}
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	amqpURI := fs.String("amqp", messaging.AMQPAddr, "RabbitMQ connection URI")
+	fs.StringVar(&selection.ids, "ids", "", "comma separated message IDs, as shown by dlq list")
+	fs.BoolVar(&selection.all, "all", false, "select every message on the dead letter queue")
+	fs.BoolVar(&selection.dryRun, "dry-run", false, "only print the messages that would be affected")
+
+	return fs, amqpURI, selection
+}
+
+func (s *dlqSelection) selector() (messaging.DeadLetterSelector, error) {
+	if s.all {
+		return messaging.SelectAllDeadLetters, nil
+	}
+
+	var ids []string
+	for _, id := range strings.Split(s.ids, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, ErrNoSelection
+	}
+
+	return messaging.SelectDeadLettersByID(ids), nil
+}
+
+func writeDeadLetters(w io.Writer, letters []*messaging.DeadLetter) error {
+	if len(letters) == 0 {
+		fmt.Fprintln(w, "dead letter queue is empty")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tROUTING KEY\tQUEUE\tREASON\tRETRIES\tDEAD LETTERED AT\tOWNER\tDATA")
+	for _, letter := range letters {
+		deadAt := "-"
+		if !letter.DeadLetteredAt.IsZero() {
+			deadAt = letter.DeadLetteredAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			letter.MessageID,
+			letter.RoutingKey,
+			letter.Queue,
+			letter.Reason,
+			letter.Count,
+			deadAt,
+			letter.Message.OwnerID,
+			string(letter.Message.Data),
+		)
+	}
+
+	return tw.Flush()
+}
+
+// deadLetterView renders the AmqpMessage payload as JSON instead of base64.
+type deadLetterView struct {
+	*messaging.DeadLetter
+	Data json.RawMessage `json:"data"`
+}
+
+func writeDeadLettersJSON(w io.Writer, letters []*messaging.DeadLetter) error {
+	views := make([]deadLetterView, len(letters))
+	for i, letter := range letters {
+		views[i] = deadLetterView{DeadLetter: letter}
+		if json.Valid(letter.Message.Data) {
+			views[i].Data = letter.Message.Data
+		} else {
+			views[i].Data, _ = json.Marshal(string(letter.Message.Data))
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(views)
+}
+
+func printDLQResult(w io.Writer, action string, letters []*messaging.DeadLetter, dryRun bool) {
+	prefix := ""
+	if dryRun {
+		prefix = "[dry-run] would have "
+	}
+
+	for _, letter := range letters {
+		fmt.Fprintf(w, "%s%s %s (%s)\n", prefix, action, letter.MessageID, letter.RoutingKey)
+	}
+	fmt.Fprintf(w, "%s%s %d message(s)\n", prefix, action, len(letters))
+}
@@ -0,0 +1,271 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"go-ride/shared/env"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const driversUsage = `usage: go-ride-admin drivers <subcommand> [flags]
+
+subcommands:
+  list        list drivers by onboarding state, UNDER_REVIEW by default
+  review      approve a driver, ask for new documents or suspend it
+  document    download a document uploaded by a driver
+  shifts      show the shift history and driving time of a driver
+`
+
+var driverServiceAddr = env.GetString("DRIVER_SERVICE_ADDR", "driver-service:9092")
+
+var (
+	ErrMissingDriver = errors.New("missing --driver")
+)
+
+// RunDrivers executes the drivers command and its subcommands.
+func RunDrivers(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, driversUsage)
+		return errors.New("missing drivers subcommand")
+	}
+
+	switch args[0] {
+	case "list":
+		return runDriversList(ctx, args[1:])
+	case "review":
+		return runDriversReview(ctx, args[1:])
+	case "document":
+		return runDriversDocument(ctx, args[1:])
+	case "shifts":
+		return runDriversShifts(ctx, args[1:])
+	default:
+		fmt.Fprint(os.Stderr, driversUsage)
+		return fmt.Errorf("unknown drivers subcommand: %s", args[0])
+	}
+}
+
+func runDriversList(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("drivers list", flag.ContinueOnError)
+	addr := fs.String("addr", driverServiceAddr, "driver-service gRPC address")
+	state := fs.String("state", pd.OnboardingState_UNDER_REVIEW.String(), "onboarding state to list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	onboardingState, err := parseOnboardingState(*state)
+	if err != nil {
+		return err
+	}
+
+	client, conn, err := newDriverClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.ListOnboardings(ctx, &pd.ListOnboardingsRequest{State: onboardingState})
+	if err != nil {
+		return err
+	}
+
+	return writeOnboardings(os.Stdout, res.Onboardings)
+}
+
+func runDriversReview(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("drivers review", flag.ContinueOnError)
+	addr := fs.String("addr", driverServiceAddr, "driver-service gRPC address")
+	driverID := fs.String("driver", "", "ID of the driver")
+	state := fs.String("state", "", "APPROVED, DOCUMENTS_PENDING or SUSPENDED")
+	reason := fs.String("reason", "", "reason shown to the driver, required unless approving")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *driverID == "" {
+		return ErrMissingDriver
+	}
+
+	onboardingState, err := parseOnboardingState(*state)
+	if err != nil {
+		return err
+	}
+
+	client, conn, err := newDriverClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.ReviewDriver(ctx, &pd.ReviewDriverRequest{
+		DriverID: *driverID,
+		State:    onboardingState,
+		Reason:   *reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "driver %s is now %s\n", res.DriverID, res.State)
+	return nil
+}
+
+func runDriversDocument(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("drivers document", flag.ContinueOnError)
+	addr := fs.String("addr", driverServiceAddr, "driver-service gRPC address")
+	driverID := fs.String("driver", "", "ID of the driver")
+	documentType := fs.String("type", "", "DRIVER_LICENSE or VEHICLE_REGISTRATION")
+	out := fs.String("out", "", "file to write the document to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *driverID == "" {
+		return ErrMissingDriver
+	}
+	if *out == "" {
+		return errors.New("missing --out")
+	}
+
+	typeValue, ok := pd.DocumentType_value[strings.ToUpper(*documentType)]
+	if !ok || typeValue == 0 {
+		return fmt.Errorf("unknown document type: %s", *documentType)
+	}
+
+	client, conn, err := newDriverClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.GetDocument(ctx, &pd.GetDocumentRequest{
+		DriverID: *driverID,
+		Type:     pd.DocumentType(typeValue),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(*out, res.Content, 0o600); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "wrote %s (%s, %d bytes) to %s\n", res.Document.Type, res.Document.ContentType, len(res.Content), *out)
+	return nil
+}
+
+func runDriversShifts(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("drivers shifts", flag.ContinueOnError)
+	addr := fs.String("addr", driverServiceAddr, "driver-service gRPC address")
+	driverID := fs.String("driver", "", "ID of the driver")
+	limit := fs.Int("limit", 20, "maximum number of shifts to list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *driverID == "" {
+		return ErrMissingDriver
+	}
+
+	client, conn, err := newDriverClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.ListShifts(ctx, &pd.ListShiftsRequest{
+		DriverID: *driverID,
+		Limit:    int32(*limit),
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeShifts(os.Stdout, res)
+}
+
+func newDriverClient(addr string) (pd.DriverServiceClient, *grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pd.NewDriverServiceClient(conn), conn, nil
+}
+
+func parseOnboardingState(state string) (pd.OnboardingState, error) {
+	value, ok := pd.OnboardingState_value[strings.ToUpper(state)]
+	if !ok || value == 0 {
+		return 0, fmt.Errorf("unknown onboarding state: %q", state)
+	}
+
+	return pd.OnboardingState(value), nil
+}
+
+func writeOnboardings(w io.Writer, onboardings []*pd.DriverOnboarding) error {
+	if len(onboardings) == 0 {
+		fmt.Fprintln(w, "no drivers found")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "DRIVER\tSTATE\tUPDATED AT\tDOCUMENTS\tREASON")
+	for _, onboarding := range onboardings {
+		documents := make([]string, len(onboarding.Documents))
+		for i, document := range onboarding.Documents {
+			documents[i] = fmt.Sprintf("%s (expires %s)", document.Type, time.UnixMilli(document.ExpiresAt).Format(time.DateOnly))
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			onboarding.DriverID,
+			onboarding.State,
+			time.UnixMilli(onboarding.UpdatedAt).Format(time.RFC3339),
+			strings.Join(documents, ", "),
+			onboarding.Reason,
+		)
+	}
+
+	return tw.Flush()
+}
+
+func writeShifts(w io.Writer, res *pd.ListShiftsResponse) error {
+	fmt.Fprintf(w, "driving time over the last 24 hours: %s of %s\n\n",
+		time.Duration(res.DrivingSecondsLast24H)*time.Second,
+		time.Duration(res.DrivingLimitSeconds)*time.Second,
+	)
+
+	if len(res.Shifts) == 0 {
+		fmt.Fprintln(w, "no shifts found")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SHIFT\tSTARTED AT\tENDED AT\tDRIVING\tBREAK\tFORCED BREAKS")
+	for _, shift := range res.Shifts {
+		endedAt := "open"
+		if shift.EndedAt != 0 {
+			endedAt = time.UnixMilli(shift.EndedAt).Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\n",
+			shift.ID,
+			time.UnixMilli(shift.StartedAt).Format(time.RFC3339),
+			endedAt,
+			time.Duration(shift.DrivingSeconds)*time.Second,
+			time.Duration(shift.BreakSeconds)*time.Second,
+			shift.ForcedBreaks,
+		)
+	}
+
+	return tw.Flush()
+}
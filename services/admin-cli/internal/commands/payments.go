@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"go-ride/shared/env"
+	"os"
+
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const paymentsUsage = `usage: go-ride-admin payments <subcommand> [flags]
+
+subcommands:
+  refund      give back part or all of the fare charged for a trip
+`
+
+var tripServiceAddr = env.GetString("TRIP_SERVICE_ADDR", "trip-service:9093")
+
+var (
+	ErrMissingTrip = errors.New("missing --trip")
+)
+
+// RunPayments executes the payments command and its subcommands.
+func RunPayments(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, paymentsUsage)
+		return errors.New("missing payments subcommand")
+	}
+
+	switch args[0] {
+	case "refund":
+		return runPaymentsRefund(ctx, args[1:])
+	default:
+		fmt.Fprint(os.Stderr, paymentsUsage)
+		return fmt.Errorf("unknown payments subcommand: %s", args[0])
+	}
+}
+
+func runPaymentsRefund(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("payments refund", flag.ContinueOnError)
+	addr := fs.String("addr", tripServiceAddr, "trip-service gRPC address")
+	tripID := fs.String("trip", "", "ID of the trip")
+	amount := fs.Int64("amount", 0, "amount to refund in cents, everything left when zero")
+	toWallet := fs.Bool("wallet", false, "credit the rider wallet instead of refunding the card")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *tripID == "" {
+		return ErrMissingTrip
+	}
+
+	client, conn, err := newTripClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.RefundPayment(ctx, &pb.RefundPaymentRequest{
+		TripID:      *tripID,
+		AmountCents: *amount,
+		ToWallet:    *toWallet,
+	})
+	if err != nil {
+		return err
+	}
+
+	payment := res.Payment
+	fmt.Fprintf(os.Stdout, "trip %s refunded %d of %d cents, payment is %s\n",
+		payment.TripID, payment.RefundedCents, payment.AmountCents, payment.Status)
+	return nil
+}
+
+func newTripClient(addr string) (pb.TripServiceClient, *grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pb.NewTripServiceClient(conn), conn, nil
+}
@@ -0,0 +1,199 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "go-ride/shared/proto/trip"
+)
+
+const promosUsage = `usage: go-ride-admin promos <subcommand> [flags]
+
+subcommands:
+  create       create a promo code, active right away
+  deactivate   stop a promo code from being applied
+  list         list the promo codes with their usage
+`
+
+var (
+	ErrMissingCode = errors.New("missing --code")
+)
+
+// RunPromos executes the promos command and its subcommands.
+func RunPromos(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, promosUsage)
+		return errors.New("missing promos subcommand")
+	}
+
+	switch args[0] {
+	case "create":
+		return runPromosCreate(ctx, args[1:])
+	case "deactivate":
+		return runPromosDeactivate(ctx, args[1:])
+	case "list":
+		return runPromosList(ctx, args[1:])
+	default:
+		fmt.Fprint(os.Stderr, promosUsage)
+		return fmt.Errorf("unknown promos subcommand: %s", args[0])
+	}
+}
+
+func runPromosCreate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("promos create", flag.ContinueOnError)
+	addr := fs.String("addr", tripServiceAddr, "trip-service gRPC address")
+	code := fs.String("code", "", "the code riders type, case insensitive")
+	percent := fs.Int64("percent", 0, "percentage taken off the fare")
+	fixed := fs.Int64("fixed", 0, "cents taken off the fare")
+	maxDiscount := fs.Int64("max-discount", 0, "cap of the discount in cents, none when zero")
+	minFare := fs.Int64("min-fare", 0, "minimum fare in cents for the code to apply")
+	packages := fs.String("packages", "", "comma separated packages the code applies to, all when empty")
+	maxUses := fs.Int("max-uses", 0, "redemptions across every rider, unlimited when zero")
+	maxUsesPerUser := fs.Int("max-uses-per-user", 1, "redemptions per rider, unlimited when zero")
+	firstRide := fs.Bool("first-ride", false, "only riders without a previous trip can use it")
+	startsAt := fs.String("starts", "", "RFC 3339 time the code becomes valid, right away when empty")
+	endsAt := fs.String("ends", "", "RFC 3339 time the code expires, never when empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *code == "" {
+		return ErrMissingCode
+	}
+	if (*percent == 0) == (*fixed == 0) {
+		return errors.New("set exactly one of --percent and --fixed")
+	}
+
+	promo := &pb.PromoCode{
+		Code:             *code,
+		DiscountType:     pb.DiscountType_PERCENTAGE,
+		Value:            *percent,
+		MaxDiscountCents: *maxDiscount,
+		MinFareCents:     *minFare,
+		MaxUses:          int32(*maxUses),
+		MaxUsesPerUser:   int32(*maxUsesPerUser),
+		FirstRideOnly:    *firstRide,
+	}
+	if *fixed != 0 {
+		promo.DiscountType = pb.DiscountType_FIXED
+		promo.Value = *fixed
+	}
+
+	if *packages != "" {
+		for _, name := range strings.Split(*packages, ",") {
+			slug, ok := pb.PackageSlug_value[strings.ToUpper(strings.TrimSpace(name))]
+			if !ok {
+				return fmt.Errorf("unknown package: %s", name)
+			}
+			promo.Packages = append(promo.Packages, pb.PackageSlug(slug))
+		}
+	}
+
+	var err error
+	if promo.StartsAt, err = parseMillis(*startsAt); err != nil {
+		return fmt.Errorf("invalid --starts: %w", err)
+	}
+	if promo.EndsAt, err = parseMillis(*endsAt); err != nil {
+		return fmt.Errorf("invalid --ends: %w", err)
+	}
+
+	client, conn, err := newTripClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.CreatePromoCode(ctx, &pb.CreatePromoCodeRequest{PromoCode: promo})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "promo code %s created\n", res.PromoCode.Code)
+	return nil
+}
+
+func runPromosDeactivate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("promos deactivate", flag.ContinueOnError)
+	addr := fs.String("addr", tripServiceAddr, "trip-service gRPC address")
+	code := fs.String("code", "", "the code to deactivate")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *code == "" {
+		return ErrMissingCode
+	}
+
+	client, conn, err := newTripClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.DeactivatePromoCode(ctx, &pb.DeactivatePromoCodeRequest{Code: *code})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "promo code %s deactivated after %d redemptions\n", res.PromoCode.Code, res.PromoCode.Redemptions)
+	return nil
+}
+
+func runPromosList(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("promos list", flag.ContinueOnError)
+	addr := fs.String("addr", tripServiceAddr, "trip-service gRPC address")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, conn, err := newTripClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.ListPromoCodes(ctx, &pb.ListPromoCodesRequest{})
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "CODE\tDISCOUNT\tACTIVE\tREDEMPTIONS\tENDS")
+	for _, promo := range res.PromoCodes {
+		discount := fmt.Sprintf("%d cents", promo.Value)
+		if promo.DiscountType == pb.DiscountType_PERCENTAGE {
+			discount = fmt.Sprintf("%d%%", promo.Value)
+		}
+
+		uses := fmt.Sprintf("%d", promo.Redemptions)
+		if promo.MaxUses > 0 {
+			uses = fmt.Sprintf("%d/%d", promo.Redemptions, promo.MaxUses)
+		}
+
+		ends := "-"
+		if promo.EndsAt != 0 {
+			ends = time.UnixMilli(promo.EndsAt).Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%t\t%s\t%s\n", promo.Code, discount, promo.Active, uses, ends)
+	}
+	return tw.Flush()
+}
+
+func parseMillis(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixMilli(), nil
+}
@@ -0,0 +1,223 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	pb "go-ride/shared/proto/trip"
+)
+
+const walletUsage = `usage: go-ride-admin wallet <subcommand> [flags]
+
+subcommands:
+  credit      give a rider wallet balance paid by the platform
+  reconcile   verify every journal of the ledger balances to zero
+`
+
+// reconcilePageSize is the number of journal entries fetched per call while reconciling
+const reconcilePageSize = 500
+
+var (
+	ErrMissingUser  = errors.New("missing --user")
+	ErrUnreconciled = errors.New("the ledger does not reconcile")
+)
+
+// RunWallet executes the wallet command and its subcommands.
+func RunWallet(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, walletUsage)
+		return errors.New("missing wallet subcommand")
+	}
+
+	switch args[0] {
+	case "credit":
+		return runWalletCredit(ctx, args[1:])
+	case "reconcile":
+		return runWalletReconcile(ctx, args[1:])
+	default:
+		fmt.Fprint(os.Stderr, walletUsage)
+		return fmt.Errorf("unknown wallet subcommand: %s", args[0])
+	}
+}
+
+func runWalletCredit(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("wallet credit", flag.ContinueOnError)
+	addr := fs.String("addr", tripServiceAddr, "trip-service gRPC address")
+	userID := fs.String("user", "", "ID of the rider")
+	amount := fs.Int64("amount", 0, "amount to credit in cents")
+	reference := fs.String("reference", "", "unique reference, crediting twice with it has no effect")
+	description := fs.String("description", "goodwill credit", "description shown to the rider")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *userID == "" {
+		return ErrMissingUser
+	}
+	if *reference == "" {
+		return errors.New("missing --reference")
+	}
+
+	client, conn, err := newTripClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := client.CreditWallet(ctx, &pb.CreditWalletRequest{
+		UserID:      *userID,
+		AmountCents: *amount,
+		Reference:   *reference,
+		Description: *description,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "journal %d posted, wallet of %s holds %d cents\n", res.Entry.Sequence, *userID, res.BalanceCents)
+	return nil
+}
+
+func runWalletReconcile(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("wallet reconcile", flag.ContinueOnError)
+	addr := fs.String("addr", tripServiceAddr, "trip-service gRPC address")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, conn, err := newTripClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	r := newReconciliation()
+	for {
+		res, err := client.ListJournalEntries(ctx, &pb.ListJournalEntriesRequest{
+			AfterSequence: r.lastSequence,
+			Limit:         reconcilePageSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range res.Entries {
+			r.check(entry)
+		}
+		if len(res.Entries) < reconcilePageSize {
+			break
+		}
+	}
+
+	r.finish()
+	if err := r.write(os.Stdout); err != nil {
+		return err
+	}
+
+	if len(r.problems) > 0 {
+		return fmt.Errorf("%w: %d problems found", ErrUnreconciled, len(r.problems))
+	}
+	return nil
+}
+
+// reconciliation replays the ledger on its own, so it does not trust the balances kept by trip-service.
+type reconciliation struct {
+	lastSequence int64
+	journals     int
+	keys         map[string]int64
+	balances     map[string]int64
+	problems     []string
+}
+
+func newReconciliation() *reconciliation {
+	return &reconciliation{
+		keys:     make(map[string]int64),
+		balances: make(map[string]int64),
+	}
+}
+
+func (r *reconciliation) check(entry *pb.JournalEntry) {
+	r.journals++
+
+	if entry.Sequence != r.lastSequence+1 {
+		r.problemf("journal %d follows %d, the sequence has a gap", entry.Sequence, r.lastSequence)
+	}
+	r.lastSequence = entry.Sequence
+
+	if previous, ok := r.keys[entry.Key]; ok {
+		r.problemf("journal %d reuses the key %s of journal %d", entry.Sequence, entry.Key, previous)
+	}
+	r.keys[entry.Key] = entry.Sequence
+
+	var sum int64
+	for _, posting := range entry.Postings {
+		sum += posting.AmountCents
+		r.balances[posting.Account] += posting.AmountCents
+
+		if strings.HasPrefix(posting.Account, "wallet:") && r.balances[posting.Account] < 0 {
+			r.problemf("journal %d leaves %s at %d cents", entry.Sequence, posting.Account, r.balances[posting.Account])
+		}
+	}
+	if sum != 0 {
+		r.problemf("journal %d (%s %s) sums to %d cents", entry.Sequence, entry.Kind, entry.Key, sum)
+	}
+}
+
+func (r *reconciliation) finish() {
+	var total int64
+	for _, balance := range r.balances {
+		total += balance
+	}
+	if total != 0 {
+		r.problemf("the accounts sum to %d cents", total)
+	}
+}
+
+func (r *reconciliation) problemf(format string, args ...any) {
+	r.problems = append(r.problems, fmt.Sprintf(format, args...))
+}
+
+func (r *reconciliation) write(w io.Writer) error {
+	var wallets int
+	var walletTotal int64
+	platform := make([]string, 0)
+	for account, balance := range r.balances {
+		if strings.HasPrefix(account, "wallet:") {
+			wallets++
+			walletTotal += balance
+			continue
+		}
+		platform = append(platform, account)
+	}
+	slices.Sort(platform)
+
+	fmt.Fprintf(w, "checked %d journals over %d accounts\n\n", r.journals, len(r.balances))
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ACCOUNT\tBALANCE (CENTS)")
+	for _, account := range platform {
+		fmt.Fprintf(tw, "%s\t%d\n", account, r.balances[account])
+	}
+	fmt.Fprintf(tw, "%d rider wallets\t%d\n", wallets, walletTotal)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(r.problems) == 0 {
+		fmt.Fprintln(w, "\nevery journal balances to zero")
+		return nil
+	}
+
+	fmt.Fprintln(w, "\nproblems:")
+	for _, problem := range r.problems {
+		fmt.Fprintf(w, "  %s\n", problem)
+	}
+	return nil
+}
@@ -1,9 +1,24 @@
 package controllers
 
 import (
+	"context"
+	"go-ride/services/api-gateway/internal/dto"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
 	pd "go-ride/shared/proto/driver"
 
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultNearbyRadiusKm = 2.0
+	defaultNearbyLimit    = 10
 )
 
 type DriverController struct {
@@ -17,3 +32,70 @@ func NewDriverController(v *validator.Validate, ts pd.DriverServiceClient) *Driv
 		driverService: ts,
 	}
 }
+
+func (s *DriverController) HandleNearbyDrivers(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	query := r.URL.Query()
+	req := dto.NearbyDriversQuery{
+		RadiusKm:    defaultNearbyRadiusKm,
+		PackageSlug: query.Get("package"),
+		Limit:       defaultNearbyLimit,
+	}
+
+	var err error
+	req.Latitude, err = strconv.ParseFloat(query.Get("lat"), 64)
+	if err == nil {
+		req.Longitude, err = strconv.ParseFloat(query.Get("lng"), 64)
+	}
+	if err == nil && query.Has("radius") {
+		req.RadiusKm, err = strconv.ParseFloat(query.Get("radius"), 64)
+	}
+	if err == nil && query.Has("limit") {
+		req.Limit, err = strconv.Atoi(query.Get("limit"))
+	}
+
+	if err != nil {
+		responses.WriteJSON(w, http.StatusBadRequest, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusBadRequest,
+				Message: "invalid query parameters",
+			},
+		})
+		return
+	}
+
+	if err := s.validator.Struct(req); err != nil {
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "validation failed",
+				Details: responses.ParseValidationErrors(err),
+			},
+		})
+		return
+	}
+
+	grpcRes, err := s.driverService.GetNearbyDrivers(ctx, &pd.GetNearbyDriversRequest{
+		Location: &pd.Coordinate{
+			Latitude:  req.Latitude,
+			Longitude: req.Longitude,
+		},
+		RadiusKm:    req.RadiusKm,
+		PackageSlug: req.PackageSlug,
+		Limit:       int32(req.Limit),
+	}, grpc.WaitForReady(true))
+
+	if err != nil {
+		log.Printf("failed to call get nearby drivers: %v", err)
+		responses.WriteJSON(w, http.StatusInternalServerError, contracts.APIResponse{
+			Error: &contracts.APIError{Message: "failed to contact driver service"},
+		})
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"go-ride/services/api-gateway/internal/dto"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc"
+)
+
+// HandleGetEarnings returns the earnings of the driver by day or week. The range defaults to
+// the last 7 days, or the last 4 weeks, and both dates are inclusive.
+func (s *DriverController) HandleGetEarnings(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	req := dto.EarningsQuery{
+		Period: strings.ToLower(query.Get("period")),
+		From:   query.Get("from"),
+		To:     query.Get("to"),
+	}
+	if req.Period == "" {
+		req.Period = "weekly"
+	}
+
+	if err := s.validator.Struct(req); err != nil {
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "validation failed",
+				Details: responses.ParseValidationErrors(err),
+			},
+		})
+		return
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	to := today
+	if req.To != "" {
+		to, _ = time.Parse(time.DateOnly, req.To)
+	}
+
+	from := to.AddDate(0, 0, -6)
+	if req.Period == "weekly" {
+		from = to.AddDate(0, 0, -27)
+	}
+	if req.From != "" {
+		from, _ = time.Parse(time.DateOnly, req.From)
+	}
+
+	grpcRes, err := s.driverService.GetEarnings(ctx, &pd.GetEarningsRequest{
+		DriverID: driverID,
+		From:     from.UnixMilli(),
+		To:       to.AddDate(0, 0, 1).UnixMilli(),
+		Period:   pd.EarningsPeriod(pd.EarningsPeriod_value[strings.ToUpper(req.Period)]),
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "get earnings")
+}
+
+// HandleGetStatement downloads the CSV statement of the weekly payout period containing
+// the given date, the current period by default.
+func (s *DriverController) HandleGetStatement(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	at := time.Now()
+	if raw := r.URL.Query().Get("date"); raw != "" {
+		var err error
+		at, err = time.Parse(time.DateOnly, raw)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid query parameters")
+			return
+		}
+	}
+
+	grpcRes, err := s.driverService.GetPayoutStatement(ctx, &pd.GetPayoutStatementRequest{
+		DriverID: driverID,
+		At:       at.UnixMilli(),
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to call get payout statement: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact driver service")
+		return
+	}
+
+	periodStart := time.UnixMilli(grpcRes.Total.PeriodStart).UTC().Format(time.DateOnly)
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="earnings-%s.csv"`, periodStart))
+
+	if err := writeStatement(w, grpcRes); err != nil {
+		log.Printf("failed to write the statement of driver %s: %v", driverID, err)
+	}
+}
+
+func writeStatement(w http.ResponseWriter, statement *pd.GetPayoutStatementResponse) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"date", "trip_id", "kind", "package", "fare", "commission_rate", "commission", "earnings"})
+
+	for _, entry := range statement.Entries {
+		cw.Write([]string{
+			time.UnixMilli(entry.CreatedAt).UTC().Format(time.RFC3339),
+			entry.TripID,
+			entry.Kind,
+			entry.PackageSlug,
+			formatCents(entry.FareCents),
+			strconv.FormatFloat(entry.CommissionRate, 'f', -1, 64),
+			formatCents(entry.CommissionCents),
+			formatCents(entry.EarningsCents),
+		})
+	}
+
+	total := statement.Total
+	cw.Write([]string{
+		"",
+		"",
+		"total",
+		"",
+		formatCents(total.FareCents),
+		"",
+		formatCents(total.CommissionCents),
+		formatCents(total.EarningsCents),
+	})
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"go-ride/services/api-gateway/internal/dto"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc"
+)
+
+// maxDocumentUploadBytes leaves room for the multipart envelope around the 3MB driver-service limit
+const maxDocumentUploadBytes = 3<<20 + 64<<10
+
+func (s *DriverController) HandleGetOnboarding(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.driverService.GetOnboarding(ctx, &pd.GetOnboardingRequest{
+		DriverID: driverID,
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "get onboarding")
+}
+
+// HandleUploadDocument receives a multipart form with the document "file" and its "expires_at" date.
+func (s *DriverController) HandleUploadDocument(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 15*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxDocumentUploadBytes)
+	if err := r.ParseMultipartForm(maxDocumentUploadBytes); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "document is too large")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "invalid multipart payload")
+		return
+	}
+
+	form := dto.UploadDocumentForm{
+		Type:      strings.ToUpper(r.PathValue("type")),
+		ExpiresAt: r.FormValue("expires_at"),
+	}
+	if err := s.validator.Struct(form); err != nil {
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "validation failed",
+				Details: responses.ParseValidationErrors(err),
+			},
+		})
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "missing document file")
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid multipart payload")
+		return
+	}
+
+	// The document is valid until the end of its expiry day
+	expiresAt, _ := time.Parse(time.DateOnly, form.ExpiresAt)
+	expiresAt = expiresAt.Add(24*time.Hour - time.Millisecond)
+
+	grpcRes, err := s.driverService.UploadDocument(ctx, &pd.UploadDocumentRequest{
+		DriverID:    driverID,
+		Type:        pd.DocumentType(pd.DocumentType_value[form.Type]),
+		ContentType: header.Header.Get("Content-Type"),
+		Content:     content,
+		ExpiresAt:   expiresAt.UnixMilli(),
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "upload document")
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	responses.WriteJSON(w, code, contracts.APIResponse{
+		Error: &contracts.APIError{
+			Code:    int64(code),
+			Message: message,
+		},
+	})
+}
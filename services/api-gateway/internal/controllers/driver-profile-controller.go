@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/api-gateway/internal/dto"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"time"
+
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc"
+)
+
+func (s *DriverController) HandleGetProfile(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.driverService.GetDriverProfile(ctx, &pd.GetDriverProfileRequest{
+		DriverID: driverID,
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "get driver profile")
+}
+
+func (s *DriverController) HandleUpsertProfile(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.UpsertDriverProfileRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	grpcRes, err := s.driverService.UpsertDriverProfile(ctx, &pd.UpsertDriverProfileRequest{
+		DriverID:       driverID,
+		Name:           req.Name,
+		ProfilePicture: req.ProfilePicture,
+		PhoneNumber:    req.PhoneNumber,
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "upsert driver profile")
+}
+
+func (s *DriverController) HandleDeleteProfile(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.driverService.DeleteDriverProfile(ctx, &pd.DeleteDriverProfileRequest{
+		DriverID: driverID,
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "delete driver profile")
+}
+
+func (s *DriverController) HandleAddVehicle(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.VehicleRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	grpcRes, err := s.driverService.AddVehicle(ctx, &pd.AddVehicleRequest{
+		DriverID: driverID,
+		Vehicle:  toProtoVehicle("", req),
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusCreated, grpcRes, err, "add vehicle")
+}
+
+func (s *DriverController) HandleUpdateVehicle(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.VehicleRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	grpcRes, err := s.driverService.UpdateVehicle(ctx, &pd.UpdateVehicleRequest{
+		DriverID: driverID,
+		Vehicle:  toProtoVehicle(r.PathValue("id"), req),
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "update vehicle")
+}
+
+func (s *DriverController) HandleDeleteVehicle(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.driverService.DeleteVehicle(ctx, &pd.DeleteVehicleRequest{
+		DriverID:  driverID,
+		VehicleID: r.PathValue("id"),
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "delete vehicle")
+}
+
+func (s *DriverController) HandleSetActiveVehicle(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.driverService.SetActiveVehicle(ctx, &pd.SetActiveVehicleRequest{
+		DriverID:  driverID,
+		VehicleID: r.PathValue("id"),
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "set active vehicle")
+}
+
+// decode reads and validates the JSON body, answering the error itself when it fails.
+func (s *DriverController) decode(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		responses.WriteJSON(w, http.StatusBadRequest, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusBadRequest,
+				Message: "invalid JSON payload",
+			},
+		})
+		return false
+	}
+
+	if err := s.validator.Struct(req); err != nil {
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "validation failed",
+				Details: responses.ParseValidationErrors(err),
+			},
+		})
+		return false
+	}
+
+	return true
+}
+
+func (s *DriverController) writeResult(w http.ResponseWriter, successStatus int, data any, err error, operation string) {
+	if err != nil {
+		log.Printf("failed to call %s: %v", operation, err)
+		responses.WriteGRPCError(w, err, "failed to contact driver service")
+		return
+	}
+
+	responses.WriteJSON(w, successStatus, contracts.APIResponse{
+		Data: data,
+	})
+}
+
+func toProtoVehicle(id string, req dto.VehicleRequest) *pd.Vehicle {
+	return &pd.Vehicle{
+		ID:       id,
+		Make:     req.Make,
+		Model:    req.Model,
+		Colour:   req.Colour,
+		Plate:    req.Plate,
+		Year:     req.Year,
+		Seats:    req.Seats,
+		Packages: req.Packages,
+	}
+}
@@ -0,0 +1,41 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc"
+)
+
+// HandleListShifts returns the shift history of the driver and its driving time over the last 24 hours.
+func (s *DriverController) HandleListShifts(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var limit int
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			writeError(w, http.StatusBadRequest, "invalid query parameters")
+			return
+		}
+	}
+
+	grpcRes, err := s.driverService.ListShifts(ctx, &pd.ListShiftsRequest{
+		DriverID: driverID,
+		Limit:    int32(limit),
+	}, grpc.WaitForReady(true))
+
+	s.writeResult(w, http.StatusOK, grpcRes, err, "list shifts")
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/api-gateway/internal/dto"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"time"
+
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc"
+)
+
+// HandleAddPaymentMethod stores a card of the rider, the token is issued by the payment provider to the app.
+func (s *TripController) HandleAddPaymentMethod(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.AddPaymentMethodRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		responses.WriteJSON(w, http.StatusBadRequest, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusBadRequest,
+				Message: "invalid JSON payload",
+			},
+		})
+		return
+	}
+
+	if err := s.validator.Struct(req); err != nil {
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "validation failed",
+				Details: responses.ParseValidationErrors(err),
+			},
+		})
+		return
+	}
+
+	grpcRes, err := s.tripService.AddPaymentMethod(ctx, &pb.AddPaymentMethodRequest{
+		UserID: userID,
+		Token:  req.Token,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to add payment method: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusCreated, contracts.APIResponse{
+		Data: grpcRes.GetPaymentMethod(),
+	})
+}
+
+func (s *TripController) HandleListPaymentMethods(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.tripService.ListPaymentMethods(ctx, &pb.ListPaymentMethodsRequest{
+		UserID: userID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to list payment methods: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes.GetPaymentMethods(),
+	})
+}
+
+func (s *TripController) HandleDeletePaymentMethod(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.tripService.DeletePaymentMethod(ctx, &pb.DeletePaymentMethodRequest{
+		UserID:          userID,
+		PaymentMethodID: r.PathValue("id"),
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to delete payment method: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/api-gateway/internal/dto"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"time"
+
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc"
+)
+
+// HandleRateTrip rates the other side of a completed trip, it is called by the passenger and by the driver alike.
+func (s *TripController) HandleRateTrip(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.RateTripRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		responses.WriteJSON(w, http.StatusBadRequest, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusBadRequest,
+				Message: "invalid JSON payload",
+			},
+		})
+		return
+	}
+
+	if err := s.validator.Struct(req); err != nil {
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "validation failed",
+				Details: responses.ParseValidationErrors(err),
+			},
+		})
+		return
+	}
+
+	grpcRes, err := s.tripService.RateTrip(ctx, &pb.RateTripRequest{
+		TripID:  r.PathValue("id"),
+		UserID:  userID,
+		Stars:   req.Stars,
+		Tags:    req.Tags,
+		Comment: req.Comment,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to rate trip: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusCreated, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
+
+// HandleGetRating returns the average of the latest ratings the user received.
+func (s *TripController) HandleGetRating(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.tripService.GetRating(ctx, &pb.GetRatingRequest{
+		UserID: userID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to get rating: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"time"
+
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc"
+)
+
+// HandleGetReceipt downloads the receipt of a completed trip of the passenger, as PDF unless ?format=html.
+func (s *TripController) HandleGetReceipt(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	switch format {
+	case "":
+		format = "pdf"
+	case "pdf", "html":
+	default:
+		writeError(w, http.StatusBadRequest, "format must be pdf or html")
+		return
+	}
+
+	grpcRes, err := s.tripService.GetReceipt(ctx, &pb.GetReceiptRequest{
+		TripID: r.PathValue("id"),
+		UserID: userID,
+		Format: format,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to get receipt: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	disposition := "attachment"
+	if format == "html" {
+		disposition = "inline"
+	}
+	w.Header().Set("Content-Type", grpcRes.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`%s; filename="%s"`, disposition, grpcRes.FileName))
+
+	if _, err := w.Write(grpcRes.Content); err != nil {
+		log.Printf("failed to write the receipt of trip %s: %v", r.PathValue("id"), err)
+	}
+}
@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/api-gateway/internal/dto"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"time"
+
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc"
+)
+
+// HandleAddTip charges the passenger a tip for the driver of a completed trip, the maximum is enforced by trip-service.
+func (s *TripController) HandleAddTip(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.AddTipRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		responses.WriteJSON(w, http.StatusBadRequest, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusBadRequest,
+				Message: "invalid JSON payload",
+			},
+		})
+		return
+	}
+
+	if err := s.validator.Struct(req); err != nil {
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "validation failed",
+				Details: responses.ParseValidationErrors(err),
+			},
+		})
+		return
+	}
+
+	grpcRes, err := s.tripService.AddTip(ctx, &pb.AddTipRequest{
+		TripID:          r.PathValue("id"),
+		UserID:          userID,
+		AmountCents:     req.AmountCents,
+		PaymentMethodID: req.PaymentMethodID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to add tip: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusCreated, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
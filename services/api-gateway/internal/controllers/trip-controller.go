@@ -66,13 +66,13 @@ func (s *TripController) HandleTripPreview(w http.ResponseWriter, r *http.Reques
 			Latitude:  req.Destination.Latitude,
 			Longitude: req.Destination.Longitude,
 		},
+		PromoCode: req.PromoCode,
 	}, grpc.WaitForReady(true))
 
 	if err != nil {
 		log.Printf("failed to call preview trip: %v", err)
-		responses.WriteJSON(w, http.StatusInternalServerError, contracts.APIResponse{
-			Error: &contracts.APIError{Message: "failed to contact trip service"},
-		})
+		// A promo code the passenger cannot use is reported as such
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
 		return
 	}
 
@@ -108,15 +108,15 @@ func (s *TripController) HandleCreateTrip(w http.ResponseWriter, r *http.Request
 	}
 
 	grpcRes, err := s.tripService.CreateTrip(ctx, &pb.CreateTripRequest{
-		RideFareID: req.RideFareID,
-		UserID:     req.UserID,
+		RideFareID:      req.RideFareID,
+		UserID:          req.UserID,
+		PaymentMethodID: req.PaymentMethodID,
 	}, grpc.WaitForReady(true))
 
 	if err != nil {
+		// A declined payment or a missing payment method is for the passenger to fix
 		log.Printf("failed to call create trip: %v", err)
-		responses.WriteJSON(w, http.StatusInternalServerError, contracts.APIResponse{
-			Error: &contracts.APIError{Message: "failed to contact trip service"},
-		})
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
 		return
 	}
 
@@ -124,3 +124,34 @@ func (s *TripController) HandleCreateTrip(w http.ResponseWriter, r *http.Request
 		Data: grpcRes,
 	})
 }
+
+// HandleCancelTrip is called by the passenger, the response tells the cancellation fee charged.
+func (s *TripController) HandleCancelTrip(w http.ResponseWriter, r *http.Request) {
+	s.cancelTrip(w, r, false)
+}
+
+func (s *TripController) cancelTrip(w http.ResponseWriter, r *http.Request, byDriver bool) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.tripService.CancelTrip(ctx, &pb.CancelTripRequest{
+		TripID:   r.PathValue("id"),
+		UserID:   userID,
+		ByDriver: byDriver,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to cancel trip: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"context"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"time"
+
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc"
+)
+
+// HandleStartTrip is called by the assigned driver once the passenger is on board.
+func (s *TripController) HandleStartTrip(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.tripService.StartTrip(ctx, &pb.StartTripRequest{
+		TripID:   r.PathValue("id"),
+		DriverID: driverID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to start trip: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
+
+// HandleCompleteTrip is called by the assigned driver at the destination.
+func (s *TripController) HandleCompleteTrip(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.tripService.CompleteTrip(ctx, &pb.CompleteTripRequest{
+		TripID:   r.PathValue("id"),
+		DriverID: driverID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to complete trip: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
+
+// HandleArriveAtPickup is called by the assigned driver at the pickup, the passenger is told it is waiting.
+func (s *TripController) HandleArriveAtPickup(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	driverID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.tripService.ArriveAtPickup(ctx, &pb.ArriveAtPickupRequest{
+		TripID:   r.PathValue("id"),
+		DriverID: driverID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to arrive at pickup: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
+
+// HandleDriverCancelTrip is called by the assigned driver, the passenger pays the no-show fee
+// when the driver waited at the pickup past the grace period.
+func (s *TripController) HandleDriverCancelTrip(w http.ResponseWriter, r *http.Request) {
+	s.cancelTrip(w, r, true)
+}
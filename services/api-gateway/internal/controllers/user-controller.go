@@ -57,21 +57,47 @@ func (s *UserController) HandleCreateUser(w http.ResponseWriter, r *http.Request
 	grpcRes, err := s.userService.CreateUser(
 		ctx,
 		&pu.CreateUserRequest{
-			Name:     req.Name,
-			Email:    req.Email,
-			Password: req.Password,
-			Type:     types.MapUserTypeDomainToProto(req.UserType),
+			Name:         req.Name,
+			Email:        req.Email,
+			Password:     req.Password,
+			Type:         types.MapUserTypeDomainToProto(req.UserType),
+			ReferralCode: req.ReferralCode,
+			DeviceId:     req.DeviceID,
 		},
 	)
 
 	if err != nil {
 		log.Printf("failed to call create user: %v", err)
-		responses.WriteJSON(w, http.StatusInternalServerError, contracts.APIResponse{
-			Error: &contracts.APIError{Message: "failed to contact user service"},
+		// An unknown referral code is reported as such
+		responses.WriteGRPCError(w, err, "failed to contact user service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
+
+// HandleGetReferralStats returns the referral code of the user with how the invited friends are doing.
+func (s *UserController) HandleGetReferralStats(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		responses.WriteJSON(w, http.StatusUnauthorized, contracts.APIResponse{
+			Error: &contracts.APIError{Message: "unauthorized"},
 		})
 		return
 	}
 
+	grpcRes, err := s.userService.GetReferralStats(ctx, &pu.GetReferralStatsRequest{UserID: userID})
+	if err != nil {
+		log.Printf("failed to get referral stats: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact user service")
+		return
+	}
+
 	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
 		Data: grpcRes,
 	})
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/api-gateway/internal/dto"
+	"go-ride/shared/contracts"
+	"go-ride/shared/responses"
+	"log"
+	"net/http"
+	"time"
+
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc"
+)
+
+// HandleGetWallet returns the balance of the rider with the latest entries of the wallet.
+func (s *TripController) HandleGetWallet(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcRes, err := s.tripService.GetWallet(ctx, &pb.GetWalletRequest{
+		UserID: userID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to get wallet: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusOK, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
+
+// HandleTopUpWallet charges a payment method of the rider into the wallet.
+// The Idempotency-Key header is required, so a retried request never charges twice.
+func (s *TripController) HandleTopUpWallet(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		responses.WriteJSON(w, http.StatusBadRequest, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusBadRequest,
+				Message: "missing Idempotency-Key header",
+			},
+		})
+		return
+	}
+
+	var req dto.TopUpWalletRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		responses.WriteJSON(w, http.StatusBadRequest, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusBadRequest,
+				Message: "invalid JSON payload",
+			},
+		})
+		return
+	}
+
+	if err := s.validator.Struct(req); err != nil {
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, contracts.APIResponse{
+			Error: &contracts.APIError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "validation failed",
+				Details: responses.ParseValidationErrors(err),
+			},
+		})
+		return
+	}
+
+	grpcRes, err := s.tripService.TopUpWallet(ctx, &pb.TopUpWalletRequest{
+		UserID:          userID,
+		PaymentMethodID: req.PaymentMethodID,
+		AmountCents:     req.AmountCents,
+		IdempotencyKey:  idempotencyKey,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		log.Printf("failed to top up wallet: %v", err)
+		responses.WriteGRPCError(w, err, "failed to contact trip service")
+		return
+	}
+
+	responses.WriteJSON(w, http.StatusCreated, contracts.APIResponse{
+		Data: grpcRes,
+	})
+}
@@ -0,0 +1,37 @@
+package dto
+
+type NearbyDriversQuery struct {
+	Latitude    float64 `validate:"min=-90,max=90"`
+	Longitude   float64 `validate:"min=-180,max=180"`
+	RadiusKm    float64 `validate:"gt=0,max=5"`
+	PackageSlug string
+	Limit       int `validate:"min=1,max=20"`
+}
+
+type UpsertDriverProfileRequest struct {
+	Name           string `json:"name" validate:"required"`
+	ProfilePicture string `json:"profile_picture" validate:"omitempty,url"`
+	PhoneNumber    string `json:"phone_number" validate:"omitempty,e164"`
+}
+
+type VehicleRequest struct {
+	Make     string   `json:"make" validate:"required"`
+	Model    string   `json:"model" validate:"required"`
+	Colour   string   `json:"colour" validate:"required"`
+	Plate    string   `json:"plate" validate:"required"`
+	Year     int32    `json:"year" validate:"required"`
+	Seats    int32    `json:"seats" validate:"required"`
+	Packages []string `json:"packages" validate:"required,min=1,dive,oneof=UBERX BLACK"`
+}
+
+// UploadDocumentForm holds the fields of the multipart document upload, besides the file.
+type UploadDocumentForm struct {
+	Type      string `validate:"required,oneof=DRIVER_LICENSE VEHICLE_REGISTRATION"`
+	ExpiresAt string `validate:"required,datetime=2006-01-02"`
+}
+
+type EarningsQuery struct {
+	Period string `validate:"oneof=daily weekly"`
+	From   string `validate:"omitempty,datetime=2006-01-02"`
+	To     string `validate:"omitempty,datetime=2006-01-02"`
+}
@@ -6,9 +6,31 @@ type PreviewTripRequest struct {
 	PassengerID string            `json:"passenger_id" validate:"required,uuid4"`
 	Origin      *types.Coordinate `json:"origin" validate:"required"`
 	Destination *types.Coordinate `json:"destination" validate:"required"`
+	PromoCode   string            `json:"promo_code" validate:"omitempty,max=32"`
 }
 
 type CreateTripRequest struct {
-	RideFareID string `json:"ride_fare_id"`
-	UserID     string `json:"user_id"`
+	RideFareID      string `json:"ride_fare_id"`
+	UserID          string `json:"user_id"`
+	PaymentMethodID string `json:"payment_method_id"` // the oldest payment method of the user when empty
+}
+
+type AddPaymentMethodRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type TopUpWalletRequest struct {
+	PaymentMethodID string `json:"payment_method_id"` // the oldest payment method of the user when empty
+	AmountCents     int64  `json:"amount_cents" validate:"required,min=100,max=50000"`
+}
+
+type RateTripRequest struct {
+	Stars   int32    `json:"stars" validate:"required,min=1,max=5"`
+	Tags    []string `json:"tags" validate:"max=5"`
+	Comment string   `json:"comment" validate:"max=500"`
+}
+
+type AddTipRequest struct {
+	AmountCents     int64  `json:"amount_cents" validate:"required,min=1"`
+	PaymentMethodID string `json:"payment_method_id"` // the card that paid for the trip when empty
 }
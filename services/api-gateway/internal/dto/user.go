@@ -3,10 +3,12 @@ package dto
 import "go-ride/shared/types"
 
 type CreateUserRequest struct {
-	Name     string         `json:"name" validate:"required"`
-	Email    string         `json:"email" validate:"required,email"`
-	Password string         `json:"password" validate:"required"`
-	UserType types.UserType `json:"user_type" validate:"required"`
+	Name         string         `json:"name" validate:"required"`
+	Email        string         `json:"email" validate:"required,email"`
+	Password     string         `json:"password" validate:"required"`
+	UserType     types.UserType `json:"user_type" validate:"required"`
+	ReferralCode string         `json:"referral_code" validate:"omitempty,alphanum,max=16"` // the code of the user who invited this one
+	DeviceID     string         `json:"device_id" validate:"omitempty,max=128"`
 }
 
 type LoginRequest struct {
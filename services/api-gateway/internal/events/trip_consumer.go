@@ -0,0 +1,199 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+	"log"
+)
+
+// TripEventConsumer pushes the trip events addressed to riders and drivers to their WebSocket.
+type TripEventConsumer struct {
+	bus         messaging.MessageBus
+	connManager *messaging.ConnectionManager
+}
+
+func NewTripEventConsumer(bus messaging.MessageBus, connManager *messaging.ConnectionManager) *TripEventConsumer {
+	return &TripEventConsumer{
+		bus:         bus,
+		connManager: connManager,
+	}
+}
+
+// Listen starts consuming the notification queues of the gateway.
+func (c *TripEventConsumer) Listen(ctx context.Context) error {
+	if err := c.bus.ConsumeMessages(ctx, messaging.NotifyDriverAssignQueue, c.handleDriverAssigned); err != nil {
+		return err
+	}
+
+	if err := c.bus.ConsumeMessages(ctx, messaging.NotifyDriverLocationQueue, c.handleDriverLocation); err != nil {
+		return err
+	}
+
+	if err := c.bus.ConsumeMessages(ctx, messaging.NotifyDriverDocumentQueue, c.handleDriverDocument); err != nil {
+		return err
+	}
+
+	if err := c.bus.ConsumeMessages(ctx, messaging.NotifyDriverShiftQueue, c.handleDriverShift); err != nil {
+		return err
+	}
+
+	if err := c.bus.ConsumeMessages(ctx, messaging.NotifyDriverTipQueue, c.handleDriverTip); err != nil {
+		return err
+	}
+
+	return c.bus.ConsumeMessages(ctx, messaging.NotifyTripStatusQueue, c.handleTripStatus)
+}
+
+func (c *TripEventConsumer) handleDriverAssigned(_ context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.TripEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip event: %v", err)
+	}
+
+	// Buffered until acknowledged, so a rider that is offline gets it on reconnection
+	err := c.connManager.SendMessage(message.OwnerID, contracts.WSMessage{
+		Type:        contracts.WSTripDriverAssigned,
+		Data:        payload,
+		AckRequired: true,
+	})
+	if err != nil && !errors.Is(err, messaging.ErrConnectionNotFound) {
+		log.Printf("[WS] failed to notify the driver assigned to trip %s: %v", payload.Trip.GetId(), err)
+	}
+
+	// The driver is already on its way to the pickup in driver-service, it learns which trip here.
+	// Buffered until acknowledged as well, the driver must not miss the trip it has to drive
+	driverID := payload.Trip.GetDriver().GetId()
+	if driverID == "" {
+		return nil
+	}
+	err = c.connManager.SendMessage(driverID, contracts.WSMessage{
+		Type:        contracts.WSDriverTripAssigned,
+		Data:        payload,
+		AckRequired: true,
+	})
+	if err != nil && !errors.Is(err, messaging.ErrConnectionNotFound) {
+		log.Printf("[WS] failed to send trip %s to its driver %s: %v", payload.Trip.GetId(), driverID, err)
+	}
+
+	return nil
+}
+
+func (c *TripEventConsumer) handleTripStatus(_ context.Context, routingKey string, message contracts.AmqpMessage) error {
+	var payload messaging.TripEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip event: %v", err)
+	}
+
+	recipients := []string{message.OwnerID}
+	var messageType string
+	switch routingKey {
+	case contracts.TripEventDriverArrived:
+		messageType = contracts.WSTripDriverArrived
+	case contracts.TripEventStarted:
+		messageType = contracts.WSTripStarted
+	case contracts.TripEventCompleted:
+		messageType = contracts.WSTripCompleted
+	case contracts.TripEventCancelled:
+		// Either side may have canceled, both are told
+		messageType = contracts.WSTripCancelled
+		if driverID := payload.Trip.GetDriver().GetId(); driverID != "" {
+			recipients = append(recipients, driverID)
+		}
+	default:
+		return nil
+	}
+
+	for _, recipient := range recipients {
+		err := c.connManager.SendMessage(recipient, contracts.WSMessage{
+			Type:        messageType,
+			Data:        payload,
+			AckRequired: true,
+		})
+		if err != nil && !errors.Is(err, messaging.ErrConnectionNotFound) {
+			log.Printf("[WS] failed to notify %s of trip %s: %v", recipient, payload.Trip.GetId(), err)
+		}
+	}
+
+	return nil
+}
+
+func (c *TripEventConsumer) handleDriverLocation(_ context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.TripDriverLocationData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal driver location: %v", err)
+	}
+
+	err := c.connManager.SendTransient(message.OwnerID, contracts.WSMessage{
+		Type: contracts.WSTripDriverLocation,
+		Data: payload,
+	})
+	if errors.Is(err, messaging.ErrConnectionNotFound) || errors.Is(err, messaging.ErrSlowConsumer) {
+		// The rider is offline or lagging, the next location supersedes this one
+		return nil
+	}
+	if err != nil {
+		log.Printf("[WS] failed to relay driver location of trip %s: %v", payload.TripID, err)
+	}
+
+	return nil
+}
+
+func (c *TripEventConsumer) handleDriverDocument(_ context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.DriverDocumentEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal driver document event: %v", err)
+	}
+
+	// Buffered until acknowledged, the driver gets the reminder on its next connection
+	err := c.connManager.SendMessage(message.OwnerID, contracts.WSMessage{
+		Type:        contracts.WSDriverDocument,
+		Data:        payload,
+		AckRequired: true,
+	})
+	if err != nil && !errors.Is(err, messaging.ErrConnectionNotFound) {
+		log.Printf("[WS] failed to remind driver %s of its %s: %v", payload.DriverID, payload.DocumentType, err)
+	}
+
+	return nil
+}
+
+func (c *TripEventConsumer) handleDriverShift(_ context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.DriverShiftEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal driver shift event: %v", err)
+	}
+
+	err := c.connManager.SendMessage(message.OwnerID, contracts.WSMessage{
+		Type:        contracts.WSDriverShift,
+		Data:        payload,
+		AckRequired: true,
+	})
+	if err != nil && !errors.Is(err, messaging.ErrConnectionNotFound) {
+		log.Printf("[WS] failed to send a %s shift notice to driver %s: %v", payload.Kind, payload.DriverID, err)
+	}
+
+	return nil
+}
+
+func (c *TripEventConsumer) handleDriverTip(_ context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.TripTippedEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip tipped event: %v", err)
+	}
+
+	// Buffered until acknowledged, the driver hears of the tip on its next connection
+	err := c.connManager.SendMessage(message.OwnerID, contracts.WSMessage{
+		Type:        contracts.WSDriverTip,
+		Data:        payload,
+		AckRequired: true,
+	})
+	if err != nil && !errors.Is(err, messaging.ErrConnectionNotFound) {
+		log.Printf("[WS] failed to notify driver %s of the tip on trip %s: %v", payload.DriverID, payload.TripID, err)
+	}
+
+	return nil
+}
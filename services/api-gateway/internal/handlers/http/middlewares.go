@@ -2,9 +2,11 @@ package http
 
 import (
 	"context"
+	"fmt"
 	"go-ride/shared/jwt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -110,3 +112,36 @@ func AuthMiddleware(jwtSvc *jwt.JWTService, rdb *redis.Client) Middleware {
 		})
 	}
 }
+
+// RateLimit allows each authenticated user at most limit requests per window.
+// Counters live in Redis so the limit holds across gateway replicas.
+func RateLimit(rdb *redis.Client, name string, limit int64, window time.Duration) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, _ := r.Context().Value("user_id").(string)
+			if userID == "" {
+				userID = r.RemoteAddr
+			}
+
+			key := fmt.Sprintf("ratelimit:%s:%s:%d", name, userID, time.Now().Unix()/int64(window.Seconds()))
+
+			pipe := rdb.TxPipeline()
+			count := pipe.Incr(r.Context(), key)
+			pipe.Expire(r.Context(), key, window)
+			if _, err := pipe.Exec(r.Context()); err != nil {
+				// Do not block requests while Redis is unavailable
+				log.Printf("failed to check rate limit: %v", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if count.Val() > limit {
+				w.Header().Set("Retry-After", strconv.Itoa(int(window.Seconds())))
+				http.Error(w, "too many requests", http.StatusTooManyRequests)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"go-ride/services/api-gateway/internal/handlers/ws"
 	"go-ride/shared/jwt"
 	"net/http"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -30,9 +31,10 @@ func (h *Handler) RegisterRoutes(
 	tripController *controllers.TripController,
 	driverController *controllers.DriverController,
 	driverWSHandler *ws.DriverWSHandler,
+	riderWSHandler *ws.RiderWSHandler,
 ) {
 	h.registerUserRoutes(userController)
-	h.registerTripRoutes(tripController)
+	h.registerTripRoutes(tripController, riderWSHandler)
 	h.registerDriverRoutes(driverController, driverWSHandler)
 
 }
@@ -43,15 +45,54 @@ func (h *Handler) registerUserRoutes(userController *controllers.UserController)
 	h.Router.HandleFunc("POST /api/v1/refresh", userController.HandleRefreshToken)
 
 	h.Router.Handle("POST /api/v1/logout", h.withAuth(userController.HandleLogout))
+	h.Router.Handle("GET /api/v1/referrals", h.withAuth(userController.HandleGetReferralStats))
 }
 
-func (h *Handler) registerTripRoutes(tripController *controllers.TripController) {
+func (h *Handler) registerTripRoutes(tripController *controllers.TripController, riderWSHandler *ws.RiderWSHandler) {
 	h.Router.Handle("POST /api/v1/trip-preview", h.withAuth(tripController.HandleTripPreview))
 	h.Router.Handle("POST /api/v1/trip", h.withAuth(tripController.HandleCreateTrip))
+	h.Router.Handle("POST /api/v1/trip/{id}/cancel", h.withAuth(tripController.HandleCancelTrip))
+	h.Router.Handle("POST /api/v1/trip/{id}/rating", h.withAuth(tripController.HandleRateTrip))
+	h.Router.Handle("POST /api/v1/trip/{id}/tip", h.withAuth(tripController.HandleAddTip))
+	h.Router.Handle("GET /api/v1/trips/{id}/receipt", h.withAuth(tripController.HandleGetReceipt))
+	h.Router.Handle("GET /api/v1/rating", h.withAuth(tripController.HandleGetRating))
+	h.Router.Handle("GET /api/v1/rider/stream", h.withAuth(riderWSHandler.HandleConnection))
+
+	h.Router.Handle("POST /api/v1/payment-methods", h.withAuth(tripController.HandleAddPaymentMethod))
+	h.Router.Handle("GET /api/v1/payment-methods", h.withAuth(tripController.HandleListPaymentMethods))
+	h.Router.Handle("DELETE /api/v1/payment-methods/{id}", h.withAuth(tripController.HandleDeletePaymentMethod))
+	h.Router.Handle("GET /api/v1/wallet", h.withAuth(tripController.HandleGetWallet))
+	h.Router.Handle("POST /api/v1/wallet/top-ups", h.withAuth(tripController.HandleTopUpWallet))
+
+	h.Router.Handle("POST /api/v1/driver/trips/{id}/arrive", h.withAuth(tripController.HandleArriveAtPickup))
+	h.Router.Handle("POST /api/v1/driver/trips/{id}/start", h.withAuth(tripController.HandleStartTrip))
+	h.Router.Handle("POST /api/v1/driver/trips/{id}/complete", h.withAuth(tripController.HandleCompleteTrip))
+	h.Router.Handle("POST /api/v1/driver/trips/{id}/cancel", h.withAuth(tripController.HandleDriverCancelTrip))
 }
 
-func (h *Handler) registerDriverRoutes(_ *controllers.DriverController, driverWSHandler *ws.DriverWSHandler) {
+func (h *Handler) registerDriverRoutes(driverController *controllers.DriverController, driverWSHandler *ws.DriverWSHandler) {
 	h.Router.Handle("GET /api/v1/driver/stream", h.withAuth(driverWSHandler.HandleConnection))
+
+	h.Router.Handle("GET /api/v1/driver/profile", h.withAuth(driverController.HandleGetProfile))
+	h.Router.Handle("PUT /api/v1/driver/profile", h.withAuth(driverController.HandleUpsertProfile))
+	h.Router.Handle("DELETE /api/v1/driver/profile", h.withAuth(driverController.HandleDeleteProfile))
+	h.Router.Handle("POST /api/v1/driver/vehicles", h.withAuth(driverController.HandleAddVehicle))
+	h.Router.Handle("PUT /api/v1/driver/vehicles/{id}", h.withAuth(driverController.HandleUpdateVehicle))
+	h.Router.Handle("DELETE /api/v1/driver/vehicles/{id}", h.withAuth(driverController.HandleDeleteVehicle))
+	h.Router.Handle("PUT /api/v1/driver/vehicles/{id}/active", h.withAuth(driverController.HandleSetActiveVehicle))
+
+	h.Router.Handle("GET /api/v1/driver/onboarding", h.withAuth(driverController.HandleGetOnboarding))
+	h.Router.Handle("PUT /api/v1/driver/documents/{type}", h.withAuth(driverController.HandleUploadDocument))
+	h.Router.Handle("GET /api/v1/driver/shifts", h.withAuth(driverController.HandleListShifts))
+	h.Router.Handle("GET /api/v1/driver/earnings", h.withAuth(driverController.HandleGetEarnings))
+	h.Router.Handle("GET /api/v1/driver/earnings/statement", h.withAuth(driverController.HandleGetStatement))
+
+	// The rider map polls this endpoint, a few requests per second are plenty
+	nearbyLimit := RateLimit(h.rdb, "drivers-nearby", 30, 10*time.Second)
+	h.Router.Handle("GET /api/v1/drivers/nearby", Chain(
+		AuthMiddleware(h.jwtService, h.rdb),
+		nearbyLimit,
+	)(http.HandlerFunc(driverController.HandleNearbyDrivers)))
 }
 
 func (h *Handler) withAuth(next http.HandlerFunc) http.Handler {
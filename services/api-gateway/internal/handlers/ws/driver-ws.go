@@ -3,11 +3,13 @@ package ws
 import (
 	"context"
 	"encoding/json"
+	"go-ride/shared/contracts"
 	"go-ride/shared/messaging"
 	pd "go-ride/shared/proto/driver"
 	"go-ride/shared/types"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -30,6 +32,32 @@ func (h *DriverWSHandler) HandleConnection(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// Clients resuming a dropped session send the last sequence number they received
+	var lastSeq *uint64
+	if raw := r.URL.Query().Get("last_seq"); raw != "" {
+		seq, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid last_seq", http.StatusBadRequest)
+			return
+		}
+		lastSeq = &seq
+	}
+
+	// Only approved drivers can go ONLINE, the others are refused before the upgrade
+	onboarding, err := h.driverClient.GetOnboarding(r.Context(), &pd.GetOnboardingRequest{
+		DriverID: userID,
+	})
+	if err != nil {
+		log.Printf("[WS] failed to get the onboarding of driver %s: %v", userID, err)
+		http.Error(w, "failed to contact driver service", http.StatusServiceUnavailable)
+		return
+	}
+	if onboarding.State != pd.OnboardingState_APPROVED {
+		log.Printf("[WS] driver %s refused, onboarding is %s", userID, onboarding.State)
+		http.Error(w, "driver is not approved", http.StatusForbidden)
+		return
+	}
+
 	conn, err := h.connManager.Upgrade(w, r)
 	if err != nil {
 		log.Printf("[WS] Falha ao fazer upgrade da conexão: %v", err)
@@ -40,13 +68,46 @@ func (h *DriverWSHandler) HandleConnection(w http.ResponseWriter, r *http.Reques
 	h.connManager.Add(userID, conn)
 	log.Printf("[WS] driver %s connected", userID)
 
+	// Pongs answer the pings sent by the connection manager, a silent peer times out the read
+	conn.SetReadDeadline(time.Now().Add(messaging.PongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(messaging.PongWait))
+	})
+
+	_, err = h.driverClient.UpdateStatus(r.Context(), &pd.UpdateStatusRequest{
+		DriverID: userID,
+		Status:   pd.DriverStatusType_ONLINE,
+	})
+	if err != nil {
+		log.Printf("[WS] error while putting the driver with ID %s as ONLINE: %v", userID, err)
+	}
+
+	locations, err := newLocationStream(h.driverClient, userID)
+	if err != nil {
+		log.Printf("[WS] failed to open the location stream of driver %s: %v", userID, err)
+		h.connManager.Remove(userID, conn)
+		return
+	}
+
 	defer func() {
-		h.connManager.Remove(userID)
+		if !h.connManager.Remove(userID, conn) {
+			// The driver reconnected, the new connection owns the driver status
+			locations.Abort()
+			return
+		}
+
+		// Closing the stream puts the driver OFFLINE
+		err := locations.Close()
+		if err == nil {
+			log.Printf("[WS] driver with ID %s disconnected (OFFLINE)", userID)
+			return
+		}
+		log.Printf("[WS] failed to close the location stream of driver %s: %v", userID, err)
 
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		_, err := h.driverClient.UpdateStatus(ctx, &pd.UpdateStatusRequest{
+		_, err = h.driverClient.UpdateStatus(ctx, &pd.UpdateStatusRequest{
 			DriverID: userID,
 			Status:   pd.DriverStatusType_OFFLINE,
 		})
@@ -57,12 +118,8 @@ func (h *DriverWSHandler) HandleConnection(w http.ResponseWriter, r *http.Reques
 		}
 	}()
 
-	_, err = h.driverClient.UpdateStatus(r.Context(), &pd.UpdateStatusRequest{
-		DriverID: userID,
-		Status:   pd.DriverStatusType_ONLINE,
-	})
-	if err != nil {
-		log.Printf("[WS] error while putting the driver with ID %s as ONLINE: %v", userID, err)
+	if err := h.connManager.Resume(userID, lastSeq); err != nil {
+		log.Printf("[WS] failed to resume the session of driver %s: %v", userID, err)
 	}
 
 	for {
@@ -70,22 +127,57 @@ func (h *DriverWSHandler) HandleConnection(w http.ResponseWriter, r *http.Reques
 		if err != nil {
 			break
 		}
+		conn.SetReadDeadline(time.Now().Add(messaging.PongWait))
 
-		var payload types.Coordinate
-		if err := json.Unmarshal(message, &payload); err != nil {
+		var driverMsg contracts.WSDriverMessage
+		if err := json.Unmarshal(message, &driverMsg); err == nil && driverMsg.Type != "" {
+			h.handleDriverMessage(r.Context(), userID, driverMsg)
 			continue
 		}
 
-		_, err = h.driverClient.UpdateStatus(r.Context(), &pd.UpdateStatusRequest{
+		// Untyped messages are location updates, they keep the current status
+		var location contracts.WSDriverLocation
+		if err := json.Unmarshal(message, &location); err != nil {
+			continue
+		}
+
+		locations.Send(location)
+	}
+}
+
+func (h *DriverWSHandler) handleDriverMessage(ctx context.Context, userID string, message contracts.WSDriverMessage) {
+	switch message.Type {
+	case contracts.WSDriverStatus:
+		var data contracts.WSDriverStatusData
+		if err := json.Unmarshal(message.Data, &data); err != nil {
+			log.Printf("[WS] invalid status from driver %s: %v", userID, err)
+			return
+		}
+
+		driverStatus := types.DriverStatus(data.Status)
+		if driverStatus != types.ONLINE && driverStatus != types.PAUSED && driverStatus != types.BREAK {
+			log.Printf("[WS] driver %s cannot set the status %s", userID, data.Status)
+			return
+		}
+
+		_, err := h.driverClient.UpdateStatus(ctx, &pd.UpdateStatusRequest{
 			DriverID: userID,
-			Status:   pd.DriverStatusType_ONLINE,
-			ActualLocation: &pd.Coordinate{
-				Latitude:  payload.Latitude,
-				Longitude: payload.Longitude,
-			},
+			Status:   types.MapDriverStatusDomainToProto(driverStatus),
 		})
 		if err != nil {
-			log.Printf("[WS] failed to update driver location: %v", err)
+			log.Printf("[WS] failed to update the status of driver %s: %v", userID, err)
+		}
+	case contracts.WSClientAck:
+		var ack contracts.WSAckData
+		if err := json.Unmarshal(message.Data, &ack); err != nil {
+			log.Printf("[WS] invalid ack from driver %s: %v", userID, err)
+			return
+		}
+
+		if err := h.connManager.Ack(userID, ack.Seq); err != nil {
+			log.Printf("[WS] failed to ack message %d for driver %s: %v", ack.Seq, userID, err)
 		}
+	default:
+		log.Printf("[WS] unknown message type %s from driver %s", message.Type, userID)
 	}
 }
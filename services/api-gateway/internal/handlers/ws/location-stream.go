@@ -0,0 +1,171 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go-ride/shared/contracts"
+	pd "go-ride/shared/proto/driver"
+)
+
+var errLocationStreamBroken = errors.New("location stream is broken")
+
+const (
+	locationBatchInterval = 2 * time.Second
+	locationBatchSize     = 10
+	locationQueueSize     = 64
+)
+
+// locationStream forwards the location updates of a connected driver to driver-service
+// over a single client stream, batching updates received within locationBatchInterval.
+type locationStream struct {
+	driverClient pd.DriverServiceClient
+	driverID     string
+	updates      chan *pd.LocationUpdate
+	ctx          context.Context
+	cancel       context.CancelFunc
+	done         chan struct{}
+	err          error // set by run before done is closed
+}
+
+func newLocationStream(driverClient pd.DriverServiceClient, driverID string) (*locationStream, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream, err := driverClient.StreamLocation(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	ls := &locationStream{
+		driverClient: driverClient,
+		driverID:     driverID,
+		updates:      make(chan *pd.LocationUpdate, locationQueueSize),
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+
+	go ls.run(stream)
+	return ls, nil
+}
+
+// Send queues a location update, dropping it when driver-service cannot keep up.
+func (ls *locationStream) Send(location contracts.WSDriverLocation) {
+	timestamp := location.Timestamp
+	if timestamp == 0 {
+		timestamp = time.Now().UnixMilli()
+	}
+
+	update := &pd.LocationUpdate{
+		Location: &pd.Coordinate{
+			Latitude:  location.Latitude,
+			Longitude: location.Longitude,
+		},
+		Timestamp: timestamp,
+		Speed:     location.Speed,
+		Heading:   location.Heading,
+		Accuracy:  location.Accuracy,
+	}
+
+	select {
+	case ls.updates <- update:
+	default:
+		log.Printf("[WS] dropping location update of driver %s", ls.driverID)
+	}
+}
+
+// Close flushes the pending updates and closes the stream, which marks the driver OFFLINE.
+// An error means the driver could not be marked OFFLINE through the stream.
+func (ls *locationStream) Close() error {
+	close(ls.updates)
+	<-ls.done
+	ls.cancel()
+	return ls.err
+}
+
+// Abort drops the stream without marking the driver OFFLINE, used when a newer
+// connection of the same driver took over.
+func (ls *locationStream) Abort() {
+	ls.cancel()
+	close(ls.updates)
+	<-ls.done
+}
+
+func (ls *locationStream) run(stream pd.DriverService_StreamLocationClient) {
+	defer close(ls.done)
+
+	ticker := time.NewTicker(locationBatchInterval)
+	defer ticker.Stop()
+
+	var batch []*pd.LocationUpdate
+	sent := false // whether the stream carried a batch, and with it the driver ID
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		// A broken stream, e.g. after a driver-service restart, is reopened on the next flush
+		if stream == nil {
+			var err error
+			if stream, err = ls.driverClient.StreamLocation(ls.ctx); err != nil {
+				log.Printf("[WS] failed to reopen the location stream of driver %s: %v", ls.driverID, err)
+				return
+			}
+			sent = false
+		}
+
+		err := stream.Send(&pd.LocationBatch{
+			DriverID: ls.driverID,
+			Updates:  batch,
+		})
+		if err != nil {
+			log.Printf("[WS] failed to stream locations of driver %s: %v", ls.driverID, err)
+			stream = nil
+			return
+		}
+		batch = nil
+		sent = true
+	}
+
+	for {
+		select {
+		case update, ok := <-ls.updates:
+			if !ok {
+				if ls.ctx.Err() != nil {
+					// Aborted, the stream is cancelled without closing it
+					return
+				}
+
+				flush()
+				if stream == nil {
+					ls.err = errLocationStreamBroken
+					return
+				}
+				if !sent {
+					// driver-service only learns the driver from a batch, an empty one
+					// tells it which driver to mark OFFLINE
+					if err := stream.Send(&pd.LocationBatch{DriverID: ls.driverID}); err != nil {
+						ls.err = err
+						return
+					}
+				}
+				_, ls.err = stream.CloseAndRecv()
+				return
+			}
+
+			batch = append(batch, update)
+			if len(batch) >= locationBatchSize {
+				flush()
+			}
+			if len(batch) > locationQueueSize {
+				// The stream is down, keep only the most recent updates
+				batch = batch[len(batch)-locationQueueSize:]
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
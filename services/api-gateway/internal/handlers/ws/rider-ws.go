@@ -0,0 +1,92 @@
+package ws
+
+import (
+	"encoding/json"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RiderWSHandler keeps the connection of a passenger open to push the updates of its trips,
+// e.g. the position of the assigned driver.
+type RiderWSHandler struct {
+	connManager *messaging.ConnectionManager
+}
+
+func NewRiderWSHandler(cm *messaging.ConnectionManager) *RiderWSHandler {
+	return &RiderWSHandler{
+		connManager: cm,
+	}
+}
+
+func (h *RiderWSHandler) HandleConnection(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Clients resuming a dropped session send the last sequence number they received
+	var lastSeq *uint64
+	if raw := r.URL.Query().Get("last_seq"); raw != "" {
+		seq, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid last_seq", http.StatusBadRequest)
+			return
+		}
+		lastSeq = &seq
+	}
+
+	conn, err := h.connManager.Upgrade(w, r)
+	if err != nil {
+		log.Printf("[WS] failed to upgrade the rider connection: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	h.connManager.Add(userID, conn)
+	defer h.connManager.Remove(userID, conn)
+	log.Printf("[WS] rider %s connected", userID)
+
+	conn.SetReadDeadline(time.Now().Add(messaging.PongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(messaging.PongWait))
+	})
+
+	if err := h.connManager.Resume(userID, lastSeq); err != nil {
+		log.Printf("[WS] failed to resume the session of rider %s: %v", userID, err)
+	}
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			break
+		}
+		conn.SetReadDeadline(time.Now().Add(messaging.PongWait))
+
+		var riderMsg contracts.WSRiderMessage
+		if err := json.Unmarshal(message, &riderMsg); err != nil {
+			continue
+		}
+
+		switch riderMsg.Type {
+		case contracts.WSClientAck:
+			var ack contracts.WSAckData
+			if err := json.Unmarshal(riderMsg.Data, &ack); err != nil {
+				log.Printf("[WS] invalid ack from rider %s: %v", userID, err)
+				continue
+			}
+
+			if err := h.connManager.Ack(userID, ack.Seq); err != nil {
+				log.Printf("[WS] failed to ack message %d for rider %s: %v", ack.Seq, userID, err)
+			}
+		default:
+			log.Printf("[WS] unknown message type %s from rider %s", riderMsg.Type, userID)
+		}
+	}
+
+	log.Printf("[WS] rider %s disconnected", userID)
+}
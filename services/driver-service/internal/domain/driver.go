@@ -3,16 +3,56 @@ package domain
 import (
 	"context"
 	"go-ride/shared/types"
+	"time"
 )
 
+// DriverPosition is the last known position of a driver.
+type DriverPosition struct {
+	DriverID string
+	Location *types.Coordinate
+	Heading  float64
+}
+
+// LocationUpdate is a single position reported by the driver app.
+type LocationUpdate struct {
+	Location  *types.Coordinate
+	Timestamp time.Time
+	Speed     float64 // meters per second
+	Heading   float64
+	Accuracy  float64 // meters, zero when unknown
+}
+
 type DriverService interface {
 	UpdateDriverStatus(ctx context.Context, driverID string, status types.DriverStatus, location *types.Coordinate) error
+	GetDriverStatus(ctx context.Context, driverID string) (types.DriverStatus, error)
+	GetDriverLocation(ctx context.Context, driverID string) (*types.Coordinate, error)
+	FindAvailableDriver(ctx context.Context, pickup *types.Coordinate, packageSlug string) (string, error)
+	ApplyTripEvent(ctx context.Context, routingKey, driverID, tripID string) error
+	GetNearbyDrivers(ctx context.Context, location *types.Coordinate, radiusKm float64, packageSlug string, limit int) ([]*DriverPosition, error)
+	ApplyLocationUpdates(ctx context.Context, driverID string, updates []*LocationUpdate) error
+	GetTripTrace(ctx context.Context, tripID string) (*TripTrace, error)
 }
 
 type DriverRepository interface {
 	SetStatus(ctx context.Context, driverID string, status types.DriverStatus) error
+	GetStatus(ctx context.Context, driverID string) (types.DriverStatus, error)
+	CompareAndSetStatus(ctx context.Context, driverID string, from, to types.DriverStatus) (bool, error)
+	RefreshStatus(ctx context.Context, driverID string) (bool, error)
 	RemoveStatus(ctx context.Context, driverID string) error
 
 	UpdateLocation(ctx context.Context, driverID string, location *types.Coordinate) error
+	GetLocation(ctx context.Context, driverID string) (*types.Coordinate, error)
+	SetHeading(ctx context.Context, driverID string, heading float64) error
+	FindNearby(ctx context.Context, location *types.Coordinate, radiusKm float64, limit int) ([]string, error)
+	FindNearbyPositions(ctx context.Context, location *types.Coordinate, radiusKm float64, limit int) ([]*DriverPosition, error)
+	LocationUpdatedAt(ctx context.Context, driverIDs []string) (map[string]time.Time, error)
+	FindStaleLocations(ctx context.Context, before time.Time) ([]string, error)
 	RemoveLocation(ctx context.Context, driverID string) error
+	// AcquireRelaySlot reports whether the driver location can be relayed, at most once per interval
+	AcquireRelaySlot(ctx context.Context, driverID string, interval time.Duration) (bool, error)
+}
+
+// LocationPublisher relays the position of a driver to the passenger of the trip being served.
+type LocationPublisher interface {
+	PublishDriverLocation(ctx context.Context, tripID string, position *DriverPosition, timestamp time.Time) error
 }
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Kinds of LedgerEntry
+const (
+	LedgerTripEarning     = "trip_earning"
+	LedgerCancellationFee = "cancellation_fee"
+	LedgerTip             = "tip"
+)
+
+// LedgerEntry is an immutable line of the earnings ledger of a driver, amounts are in cents.
+type LedgerEntry struct {
+	ID              string    `json:"id"`
+	DriverID        string    `json:"driverId"`
+	TripID          string    `json:"tripId"`
+	Kind            string    `json:"kind"`
+	PackageSlug     string    `json:"packageSlug"`
+	FareCents       int64     `json:"fareCents"`
+	CommissionRate  float64   `json:"commissionRate"`
+	CommissionCents int64     `json:"commissionCents"`
+	EarningsCents   int64     `json:"earningsCents"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// EarningsSummary adds up the ledger entries of a period, from PeriodStart inclusive to PeriodEnd exclusive.
+type EarningsSummary struct {
+	PeriodStart     time.Time
+	PeriodEnd       time.Time
+	Trips           int
+	FareCents       int64
+	CommissionCents int64
+	EarningsCents   int64
+}
+
+// Add accounts the entry in the summary.
+func (s *EarningsSummary) Add(entry *LedgerEntry) {
+	if entry.Kind == LedgerTripEarning {
+		s.Trips++
+	}
+	s.FareCents += entry.FareCents
+	s.CommissionCents += entry.CommissionCents
+	s.EarningsCents += entry.EarningsCents
+}
+
+// EarningsRepository is append-only, entries are never updated nor deleted.
+type EarningsRepository interface {
+	// AppendEntry adds the entry to the ledger, it reports false if an entry of the same kind was already recorded for the trip
+	AppendEntry(ctx context.Context, entry *LedgerEntry) (bool, error)
+	// ListEntries returns the entries created from from inclusive to to exclusive, oldest first
+	ListEntries(ctx context.Context, driverID string, from, to time.Time) ([]*LedgerEntry, error)
+}
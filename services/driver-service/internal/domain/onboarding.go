@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type OnboardingState string
+
+const (
+	OnboardingApplied          OnboardingState = "APPLIED"
+	OnboardingDocumentsPending OnboardingState = "DOCUMENTS_PENDING"
+	OnboardingUnderReview      OnboardingState = "UNDER_REVIEW"
+	OnboardingApproved         OnboardingState = "APPROVED"
+	OnboardingSuspended        OnboardingState = "SUSPENDED"
+)
+
+var OnboardingStates = []OnboardingState{
+	OnboardingApplied,
+	OnboardingDocumentsPending,
+	OnboardingUnderReview,
+	OnboardingApproved,
+	OnboardingSuspended,
+}
+
+type DocumentType string
+
+const (
+	DocumentDriverLicense       DocumentType = "DRIVER_LICENSE"
+	DocumentVehicleRegistration DocumentType = "VEHICLE_REGISTRATION"
+)
+
+// RequiredDocuments must be uploaded, and not expired, before a driver can be reviewed.
+var RequiredDocuments = []DocumentType{DocumentDriverLicense, DocumentVehicleRegistration}
+
+// Document is a file uploaded by the driver, its content lives on the blob store.
+type Document struct {
+	Type        DocumentType `json:"type"`
+	BlobKey     string       `json:"blobKey"`
+	ContentType string       `json:"contentType"`
+	Size        int64        `json:"size"`
+	ExpiresAt   time.Time    `json:"expiresAt"`
+	UploadedAt  time.Time    `json:"uploadedAt"`
+	// RemindedAt is set once the driver was told the document is about to expire
+	RemindedAt time.Time `json:"remindedAt,omitzero"`
+}
+
+// Expired reports whether the document is no longer valid at the given time.
+func (d *Document) Expired(now time.Time) bool {
+	return !now.Before(d.ExpiresAt)
+}
+
+// DriverOnboarding tracks the documents and the approval of a driver.
+// Only APPROVED drivers can go ONLINE.
+type DriverOnboarding struct {
+	DriverID  string                     `json:"driverId"`
+	State     OnboardingState            `json:"state"`
+	Documents map[DocumentType]*Document `json:"documents"`
+	Reason    string                     `json:"reason,omitempty"`
+	UpdatedAt time.Time                  `json:"updatedAt"`
+}
+
+// HasValidDocuments reports whether every required document is uploaded and not expired.
+func (o *DriverOnboarding) HasValidDocuments(now time.Time) bool {
+	for _, documentType := range RequiredDocuments {
+		document, ok := o.Documents[documentType]
+		if !ok || document.Expired(now) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type OnboardingRepository interface {
+	SaveOnboarding(ctx context.Context, onboarding *DriverOnboarding) error
+	GetOnboarding(ctx context.Context, driverID string) (*DriverOnboarding, error)
+	ListOnboardings(ctx context.Context, state OnboardingState) ([]*DriverOnboarding, error)
+	// FindExpiringDocuments returns the IDs of the drivers holding a document that expires before the deadline
+	FindExpiringDocuments(ctx context.Context, before time.Time) ([]string, error)
+	// UnindexDocument stops tracking the expiry of a document, e.g. once it expired
+	UnindexDocument(ctx context.Context, driverID string, documentType DocumentType) error
+}
+
+// BlobStore keeps the files uploaded by drivers.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// DocumentPublisher tells drivers that one of their documents is expiring or expired.
+type DocumentPublisher interface {
+	PublishDocumentExpiring(ctx context.Context, driverID string, document *Document, expired bool) error
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Package slugs a vehicle can serve, they match the trip-service packages
+const (
+	PackageUberX = "UBERX"
+	PackageBlack = "BLACK"
+)
+
+var Packages = []string{PackageUberX, PackageBlack}
+
+type DriverProfile struct {
+	DriverID        string    `json:"driverId"`
+	Name            string    `json:"name"`
+	ProfilePicture  string    `json:"profilePicture"`
+	PhoneNumber     string    `json:"phoneNumber"`
+	ActiveVehicleID string    `json:"activeVehicleId"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type Vehicle struct {
+	ID       string   `json:"id"`
+	DriverID string   `json:"driverId"`
+	Make     string   `json:"make"`
+	Model    string   `json:"model"`
+	Colour   string   `json:"colour"`
+	Plate    string   `json:"plate"`
+	Year     int      `json:"year"`
+	Seats    int      `json:"seats"`
+	Packages []string `json:"packages"`
+}
+
+// Serves reports whether the vehicle is eligible for the package.
+func (v *Vehicle) Serves(packageSlug string) bool {
+	return slices.Contains(v.Packages, packageSlug)
+}
+
+type ProfileRepository interface {
+	SaveProfile(ctx context.Context, profile *DriverProfile) error
+	GetProfile(ctx context.Context, driverID string) (*DriverProfile, error)
+	DeleteProfile(ctx context.Context, driverID string) error
+
+	// ClaimPlate reserves the plate for the vehicle, it reports false if another vehicle holds it
+	ClaimPlate(ctx context.Context, plate, vehicleID string) (bool, error)
+	ReleasePlate(ctx context.Context, plate, vehicleID string) error
+	SaveVehicle(ctx context.Context, vehicle *Vehicle) error
+	GetVehicle(ctx context.Context, driverID, vehicleID string) (*Vehicle, error)
+	ListVehicles(ctx context.Context, driverID string) ([]*Vehicle, error)
+	DeleteVehicle(ctx context.Context, driverID, vehicleID string) error
+}
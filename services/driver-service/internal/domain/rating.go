@@ -0,0 +1,28 @@
+package domain
+
+import "context"
+
+// DriverRating is the rolling average of the latest stars given by passengers.
+type DriverRating struct {
+	Average float64
+	Count   int
+}
+
+// LowRatingPolicy tells which drivers matching offers trips to last.
+type LowRatingPolicy struct {
+	// Threshold is the average below which a driver is low rated
+	Threshold float64
+	// MinRatings spares new drivers, whose average is not meaningful yet
+	MinRatings int
+}
+
+func (p LowRatingPolicy) IsLow(rating *DriverRating) bool {
+	return rating != nil && rating.Count >= p.MinRatings && rating.Average < p.Threshold
+}
+
+type RatingRepository interface {
+	// AddRating keeps the latest window ratings of the driver, a trip is only counted once
+	AddRating(ctx context.Context, driverID, tripID string, stars, window int) (bool, error)
+	GetRating(ctx context.Context, driverID string) (*DriverRating, error)
+	GetRatings(ctx context.Context, driverIDs []string) (map[string]*DriverRating, error)
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Shift is an online session of a driver, from going ONLINE to going OFFLINE.
+type Shift struct {
+	ID        string    `json:"id"`
+	DriverID  string    `json:"driverId"`
+	StartedAt time.Time `json:"startedAt"`
+	EndedAt   time.Time `json:"endedAt,omitzero"`
+	// DrivingTime is the time spent ONLINE or serving a trip, BreakTime the time PAUSED or on BREAK
+	DrivingTime time.Duration `json:"drivingTime"`
+	BreakTime   time.Duration `json:"breakTime"`
+	// SampledAt is when the driving and break times were last accumulated
+	SampledAt time.Time `json:"sampledAt"`
+	// WarnedAt is set once the driver was told the driving limit is close
+	WarnedAt time.Time `json:"warnedAt,omitzero"`
+	// ForcedBreaks counts the times the driver was put on BREAK for exceeding the driving limit
+	ForcedBreaks int `json:"forcedBreaks"`
+}
+
+// Kinds of ShiftNotice
+const (
+	ShiftNoticeWarning     = "warning"
+	ShiftNoticeBreakForced = "break_forced"
+)
+
+// ShiftNotice tells a driver about its driving time over the last 24 hours.
+type ShiftNotice struct {
+	Kind        string
+	DrivingTime time.Duration
+	Limit       time.Duration
+}
+
+type ShiftRepository interface {
+	// StartShift stores the shift as current, it reports false if the driver already has one
+	StartShift(ctx context.Context, shift *Shift) (bool, error)
+	GetCurrentShift(ctx context.Context, driverID string) (*Shift, error)
+	// UpdateCurrentShift saves the current shift, unless it ended meanwhile
+	UpdateCurrentShift(ctx context.Context, shift *Shift) error
+	// EndShift moves the current shift to the history of the driver
+	EndShift(ctx context.Context, shift *Shift) error
+	ListOpenShifts(ctx context.Context) ([]string, error)
+	// ListShifts returns the ended shifts of the driver, newest first
+	ListShifts(ctx context.Context, driverID string, limit int) ([]*Shift, error)
+
+	AddDrivingTime(ctx context.Context, driverID string, at time.Time, duration time.Duration) error
+	DrivingTimeSince(ctx context.Context, driverID string, since time.Time) (time.Duration, error)
+}
+
+// ShiftPublisher warns drivers approaching the driving limit and tells them about forced breaks.
+type ShiftPublisher interface {
+	PublishShiftNotice(ctx context.Context, driverID string, notice *ShiftNotice) error
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"go-ride/shared/types"
+	"time"
+)
+
+// TracePoint is a position of the driver recorded while serving a trip.
+type TracePoint struct {
+	Location  *types.Coordinate `json:"location"`
+	Timestamp time.Time         `json:"timestamp"`
+	Speed     float64           `json:"speed"`
+}
+
+// TripTrace is the path a driver actually drove during a trip.
+type TripTrace struct {
+	TripID     string
+	DriverID   string
+	Points     []*TracePoint
+	DistanceKm float64
+}
+
+type TraceRepository interface {
+	// SetCurrentTrip records the trip the driver serves, with the busy status it is in for it
+	SetCurrentTrip(ctx context.Context, driverID, tripID string, status types.DriverStatus) error
+	GetCurrentTrip(ctx context.Context, driverID string) (string, types.DriverStatus, error)
+	ClearCurrentTrip(ctx context.Context, driverID, tripID string) error
+
+	AppendPoints(ctx context.Context, tripID, driverID string, points []*TracePoint) error
+	GetLastPoint(ctx context.Context, tripID string) (*TracePoint, error)
+	GetTrace(ctx context.Context, tripID string) (string, []*TracePoint, error)
+}
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+)
+
+type DocumentPublisher struct {
+	bus messaging.MessageBus
+}
+
+func NewDocumentPublisher(bus messaging.MessageBus) *DocumentPublisher {
+	return &DocumentPublisher{
+		bus: bus,
+	}
+}
+
+func (p *DocumentPublisher) PublishDocumentExpiring(ctx context.Context, driverID string, document *domain.Document, expired bool) error {
+	payload, err := json.Marshal(messaging.DriverDocumentEventData{
+		DriverID:     driverID,
+		DocumentType: string(document.Type),
+		ExpiresAt:    document.ExpiresAt.UnixMilli(),
+		Expired:      expired,
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, contracts.DriverEventDocumentExpiring, contracts.AmqpMessage{
+		OwnerID: driverID,
+		Data:    payload,
+	})
+}
@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+	"time"
+)
+
+type DriverLocationPublisher struct {
+	bus messaging.MessageBus
+}
+
+func NewDriverLocationPublisher(bus messaging.MessageBus) *DriverLocationPublisher {
+	return &DriverLocationPublisher{
+		bus: bus,
+	}
+}
+
+func (p *DriverLocationPublisher) PublishDriverLocation(ctx context.Context, tripID string, position *domain.DriverPosition, timestamp time.Time) error {
+	payload, err := json.Marshal(messaging.DriverLocationEventData{
+		TripID:    tripID,
+		DriverID:  position.DriverID,
+		Location:  position.Location,
+		Heading:   position.Heading,
+		Timestamp: timestamp.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, contracts.DriverEventLocation, contracts.AmqpMessage{
+		OwnerID: position.DriverID,
+		Data:    payload,
+	})
+}
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+)
+
+type ShiftPublisher struct {
+	bus messaging.MessageBus
+}
+
+func NewShiftPublisher(bus messaging.MessageBus) *ShiftPublisher {
+	return &ShiftPublisher{
+		bus: bus,
+	}
+}
+
+func (p *ShiftPublisher) PublishShiftNotice(ctx context.Context, driverID string, notice *domain.ShiftNotice) error {
+	payload, err := json.Marshal(messaging.DriverShiftEventData{
+		DriverID:       driverID,
+		Kind:           notice.Kind,
+		DrivingSeconds: int64(notice.DrivingTime.Seconds()),
+		LimitSeconds:   int64(notice.Limit.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, contracts.DriverEventShiftNotice, contracts.AmqpMessage{
+		OwnerID: driverID,
+		Data:    payload,
+	})
+}
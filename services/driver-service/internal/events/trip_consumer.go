@@ -0,0 +1,175 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-ride/services/driver-service/internal/service"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+	pbt "go-ride/shared/proto/trip"
+	"go-ride/shared/types"
+	"log"
+	"math"
+)
+
+type TripEventConsumer struct {
+	bus             messaging.MessageBus
+	driverService   *service.DriverService
+	profileService  *service.ProfileService
+	earningsService *service.EarningsService
+	ratingService   *service.RatingService
+}
+
+func NewTripEventConsumer(
+	bus messaging.MessageBus,
+	driverService *service.DriverService,
+	profileService *service.ProfileService,
+	earningsService *service.EarningsService,
+	ratingService *service.RatingService,
+) *TripEventConsumer {
+	return &TripEventConsumer{
+		bus:             bus,
+		driverService:   driverService,
+		profileService:  profileService,
+		earningsService: earningsService,
+		ratingService:   ratingService,
+	}
+}
+
+// Listen starts consuming the queues driver-service is responsible for.
+func (c *TripEventConsumer) Listen(ctx context.Context) error {
+	if err := c.bus.ConsumeMessages(ctx, messaging.FindAvailableDriversQueue, c.handleFindAvailableDrivers); err != nil {
+		return err
+	}
+
+	if err := c.bus.ConsumeMessages(ctx, messaging.DriverStatusUpdatesQueue, c.handleDriverStatusUpdate); err != nil {
+		return err
+	}
+
+	if err := c.bus.ConsumeMessages(ctx, messaging.DriverRatingsQueue, c.handleTripRated); err != nil {
+		return err
+	}
+
+	return c.bus.ConsumeMessages(ctx, messaging.DriverTipsQueue, c.handleTripTipped)
+}
+
+func (c *TripEventConsumer) handleTripTipped(ctx context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.TripTippedEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip tipped event: %v", err)
+	}
+
+	return c.earningsService.RecordTip(ctx, payload.DriverID, payload.TripID, payload.AmountCents)
+}
+
+// handleTripRated keeps the rolling average of the driver, passengers rated by drivers are left to trip-service.
+func (c *TripEventConsumer) handleTripRated(ctx context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.TripRatedEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip rated event: %v", err)
+	}
+
+	if payload.RaterRole != "passenger" || payload.RateeID == "" {
+		return nil
+	}
+
+	return c.ratingService.RecordRating(ctx, payload.RateeID, payload.TripID, payload.Stars)
+}
+
+func (c *TripEventConsumer) handleFindAvailableDrivers(ctx context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.TripEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip event: %v", err)
+	}
+
+	trip := payload.Trip
+	if trip.GetPickup() == nil {
+		return fmt.Errorf("trip %s has no pickup location", trip.GetId())
+	}
+
+	pickup := &types.Coordinate{
+		Latitude:  trip.Pickup.Latitude,
+		Longitude: trip.Pickup.Longitude,
+	}
+
+	var packageSlug string
+	if slug := trip.GetSelectedFare().GetPackageSlug(); slug != pbt.PackageSlug_PACKAGE_SLUG_UNSPECIFIED {
+		packageSlug = slug.String()
+	}
+
+	driverID, err := c.driverService.FindAvailableDriver(ctx, pickup, packageSlug)
+	if errors.Is(err, service.ErrNoDriversAvailable) {
+		log.Printf("no drivers available for trip %s", trip.Id)
+		return c.publish(ctx, contracts.TripEventNoDriversFound, message.OwnerID, trip)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("driver %s assigned to trip %s", driverID, trip.Id)
+	trip.Driver = c.tripDriver(ctx, driverID)
+	return c.publish(ctx, contracts.TripEventDriverAssigned, message.OwnerID, trip)
+}
+
+// tripDriver describes the assigned driver to the passenger, a missing profile only leaves the ID.
+func (c *TripEventConsumer) tripDriver(ctx context.Context, driverID string) *pbt.TripDriver {
+	driver := &pbt.TripDriver{Id: driverID}
+
+	profile, vehicle, err := c.profileService.GetActiveVehicle(ctx, driverID)
+	if err != nil {
+		log.Printf("failed to get the profile of driver %s: %v", driverID, err)
+		return driver
+	}
+
+	driver.Name = profile.Name
+	driver.ProfilePicture = profile.ProfilePicture
+	if vehicle != nil {
+		driver.CarPlate = vehicle.Plate
+	}
+
+	return driver
+}
+
+func (c *TripEventConsumer) handleDriverStatusUpdate(ctx context.Context, routingKey string, message contracts.AmqpMessage) error {
+	var payload messaging.TripEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip event: %v", err)
+	}
+
+	driverID := payload.Trip.GetDriver().GetId()
+	if driverID == "" {
+		return nil
+	}
+
+	if err := c.driverService.ApplyTripEvent(ctx, routingKey, driverID, payload.Trip.GetId()); err != nil {
+		return err
+	}
+
+	fare := payload.Trip.GetSelectedFare()
+	switch routingKey {
+	case contracts.TripEventCompleted:
+		// Promo discounts are paid by the platform, the driver earns on the full fare
+		fareCents := int64(math.Round(fare.GetTotalPriceInCents())) + fare.GetDiscountCents()
+		return c.earningsService.RecordTripEarnings(ctx, driverID, payload.Trip.GetId(), fare.GetPackageSlug().String(), fareCents)
+	case contracts.TripEventCancelled:
+		cancellation := payload.Trip.GetCancellation()
+		return c.earningsService.RecordCancellationFee(ctx, driverID, payload.Trip.GetId(), fare.GetPackageSlug().String(),
+			cancellation.GetFeeCents(), cancellation.GetDriverCompensationCents())
+	}
+
+	return nil
+}
+
+func (c *TripEventConsumer) publish(ctx context.Context, routingKey, ownerID string, trip *pbt.Trip) error {
+	tripEventJSON, err := json.Marshal(messaging.TripEventData{Trip: trip})
+	if err != nil {
+		return err
+	}
+
+	return c.bus.PublishMessage(ctx, routingKey, contracts.AmqpMessage{
+		OwnerID: ownerID,
+		Data:    tripEventJSON,
+	})
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/services/driver-service/internal/service"
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *gRPCHandler) GetEarnings(ctx context.Context, req *pd.GetEarningsRequest) (*pd.GetEarningsResponse, error) {
+	summaries, total, err := h.earningsService.GetEarnings(
+		ctx,
+		req.DriverID,
+		time.UnixMilli(req.From),
+		time.UnixMilli(req.To),
+		req.Period.String(),
+	)
+	if errors.Is(err, service.ErrInvalidEarningsRange) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		log.Printf("Failed to get earnings: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get earnings")
+	}
+
+	res := &pd.GetEarningsResponse{
+		Summaries: make([]*pd.EarningsSummary, len(summaries)),
+		Total:     toProtoEarningsSummary(total),
+	}
+	for i, summary := range summaries {
+		res.Summaries[i] = toProtoEarningsSummary(summary)
+	}
+
+	return res, nil
+}
+
+func (h *gRPCHandler) GetPayoutStatement(ctx context.Context, req *pd.GetPayoutStatementRequest) (*pd.GetPayoutStatementResponse, error) {
+	total, entries, err := h.earningsService.GetPayoutStatement(ctx, req.DriverID, time.UnixMilli(req.At))
+	if err != nil {
+		log.Printf("Failed to get payout statement: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get payout statement")
+	}
+
+	res := &pd.GetPayoutStatementResponse{
+		Total:   toProtoEarningsSummary(total),
+		Entries: make([]*pd.LedgerEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		res.Entries[i] = &pd.LedgerEntry{
+			ID:              entry.ID,
+			TripID:          entry.TripID,
+			Kind:            entry.Kind,
+			PackageSlug:     entry.PackageSlug,
+			FareCents:       entry.FareCents,
+			CommissionRate:  entry.CommissionRate,
+			CommissionCents: entry.CommissionCents,
+			EarningsCents:   entry.EarningsCents,
+			CreatedAt:       entry.CreatedAt.UnixMilli(),
+		}
+	}
+
+	return res, nil
+}
+
+func toProtoEarningsSummary(summary *domain.EarningsSummary) *pd.EarningsSummary {
+	return &pd.EarningsSummary{
+		PeriodStart:     summary.PeriodStart.UnixMilli(),
+		PeriodEnd:       summary.PeriodEnd.UnixMilli(),
+		Trips:           int32(summary.Trips),
+		FareCents:       summary.FareCents,
+		CommissionCents: summary.CommissionCents,
+		EarningsCents:   summary.EarningsCents,
+	}
+}
@@ -2,8 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log"
+	"time"
 
+	"go-ride/services/driver-service/internal/domain"
 	"go-ride/services/driver-service/internal/service"
 	pd "go-ride/shared/proto/driver"
 	"go-ride/shared/types"
@@ -15,14 +19,32 @@ import (
 
 type gRPCHandler struct {
 	pd.UnimplementedDriverServiceServer
-	server        *grpc.Server
-	driverService *service.DriverService
+	server            *grpc.Server
+	driverService     *service.DriverService
+	profileService    *service.ProfileService
+	onboardingService *service.OnboardingService
+	shiftService      *service.ShiftService
+	earningsService   *service.EarningsService
+	ratingService     *service.RatingService
 }
 
-func NewGRPCHandler(server *grpc.Server, driverService *service.DriverService) *gRPCHandler {
+func NewGRPCHandler(
+	server *grpc.Server,
+	driverService *service.DriverService,
+	profileService *service.ProfileService,
+	onboardingService *service.OnboardingService,
+	shiftService *service.ShiftService,
+	earningsService *service.EarningsService,
+	ratingService *service.RatingService,
+) *gRPCHandler {
 	handler := &gRPCHandler{
-		server:        server,
-		driverService: driverService,
+		server:            server,
+		driverService:     driverService,
+		profileService:    profileService,
+		onboardingService: onboardingService,
+		shiftService:      shiftService,
+		earningsService:   earningsService,
+		ratingService:     ratingService,
 	}
 
 	pd.RegisterDriverServiceServer(server, handler)
@@ -41,6 +63,9 @@ func (h *gRPCHandler) UpdateStatus(ctx context.Context, req *pd.UpdateStatusRequ
 	}
 
 	err := h.driverService.UpdateDriverStatus(ctx, req.DriverID, driverStatus, location)
+	if errors.Is(err, service.ErrDriverNotApproved) || errors.Is(err, service.ErrDrivingLimitReached) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		log.Printf("Failed to update driver status: %v", err)
 		return nil, status.Error(codes.Internal, "failed to update status")
@@ -50,3 +75,164 @@ func (h *gRPCHandler) UpdateStatus(ctx context.Context, req *pd.UpdateStatusRequ
 		Success: true,
 	}, nil
 }
+
+func (h *gRPCHandler) GetDriverStatus(ctx context.Context, req *pd.GetDriverStatusRequest) (*pd.GetDriverStatusResponse, error) {
+	driverStatus, err := h.driverService.GetDriverStatus(ctx, req.DriverID)
+	if err != nil {
+		log.Printf("Failed to get driver status: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get status")
+	}
+
+	return &pd.GetDriverStatusResponse{
+		DriverID: req.DriverID,
+		Status:   types.MapDriverStatusDomainToProto(driverStatus),
+	}, nil
+}
+
+func (h *gRPCHandler) GetDriverLocation(ctx context.Context, req *pd.GetDriverLocationRequest) (*pd.GetDriverLocationResponse, error) {
+	location, err := h.driverService.GetDriverLocation(ctx, req.DriverID)
+	if errors.Is(err, service.ErrLocationNotFound) {
+		return nil, status.Error(codes.NotFound, "driver location not found")
+	}
+	if err != nil {
+		log.Printf("Failed to get driver location: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get driver location")
+	}
+
+	return &pd.GetDriverLocationResponse{
+		DriverID: req.DriverID,
+		Location: &pd.Coordinate{
+			Latitude:  location.Latitude,
+			Longitude: location.Longitude,
+		},
+	}, nil
+}
+
+func (h *gRPCHandler) GetNearbyDrivers(ctx context.Context, req *pd.GetNearbyDriversRequest) (*pd.GetNearbyDriversResponse, error) {
+	if req.Location == nil {
+		return nil, status.Error(codes.InvalidArgument, "location is required")
+	}
+
+	location := &types.Coordinate{
+		Latitude:  req.Location.Latitude,
+		Longitude: req.Location.Longitude,
+	}
+
+	positions, err := h.driverService.GetNearbyDrivers(ctx, location, req.RadiusKm, req.PackageSlug, int(req.Limit))
+	if err != nil {
+		log.Printf("Failed to get nearby drivers: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get nearby drivers")
+	}
+
+	drivers := make([]*pd.NearbyDriver, len(positions))
+	for i, position := range positions {
+		drivers[i] = &pd.NearbyDriver{
+			ID: position.DriverID,
+			Location: &pd.Coordinate{
+				Latitude:  position.Location.Latitude,
+				Longitude: position.Location.Longitude,
+			},
+			Heading: position.Heading,
+		}
+	}
+
+	return &pd.GetNearbyDriversResponse{
+		Drivers: drivers,
+	}, nil
+}
+
+// StreamLocation applies the location batches of a driver until the gateway closes the stream.
+// A stream closed by the gateway marks the driver OFFLINE, while a broken stream leaves the
+// status to expire, so a gateway restart does not take every driver offline.
+func (h *gRPCHandler) StreamLocation(stream pd.DriverService_StreamLocationServer) error {
+	ctx := stream.Context()
+
+	var driverID string
+	var received int64
+	for {
+		batch, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Printf("Location stream of driver %s interrupted: %v", driverID, err)
+			return err
+		}
+
+		if driverID == "" {
+			driverID = batch.DriverID
+		}
+		if batch.DriverID != driverID {
+			return status.Error(codes.InvalidArgument, "a stream carries the locations of a single driver")
+		}
+
+		updates := make([]*domain.LocationUpdate, 0, len(batch.Updates))
+		for _, update := range batch.Updates {
+			if update.Location == nil {
+				continue
+			}
+
+			updates = append(updates, &domain.LocationUpdate{
+				Location: &types.Coordinate{
+					Latitude:  update.Location.Latitude,
+					Longitude: update.Location.Longitude,
+				},
+				Timestamp: time.UnixMilli(update.Timestamp),
+				Speed:     update.Speed,
+				Heading:   update.Heading,
+				Accuracy:  update.Accuracy,
+			})
+		}
+		received += int64(len(updates))
+		if len(updates) == 0 {
+			// The gateway names the driver of a stream closed before any update
+			continue
+		}
+
+		if err := h.driverService.ApplyLocationUpdates(ctx, driverID, updates); err != nil {
+			log.Printf("Failed to apply location updates of driver %s: %v", driverID, err)
+			return status.Error(codes.Internal, "failed to apply location updates")
+		}
+	}
+
+	if driverID != "" {
+		if err := h.driverService.UpdateDriverStatus(ctx, driverID, types.OFFLINE, nil); err != nil {
+			log.Printf("Failed to put driver %s OFFLINE: %v", driverID, err)
+			return status.Error(codes.Internal, "failed to update status")
+		}
+	}
+
+	return stream.SendAndClose(&pd.StreamLocationResponse{
+		Received: received,
+	})
+}
+
+func (h *gRPCHandler) GetTripTrace(ctx context.Context, req *pd.GetTripTraceRequest) (*pd.GetTripTraceResponse, error) {
+	trace, err := h.driverService.GetTripTrace(ctx, req.TripID)
+	if errors.Is(err, service.ErrTraceNotFound) {
+		return nil, status.Error(codes.NotFound, "trip trace not found")
+	}
+	if err != nil {
+		log.Printf("Failed to get trip trace: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get trip trace")
+	}
+
+	points := make([]*pd.TracePoint, len(trace.Points))
+	for i, point := range trace.Points {
+		points[i] = &pd.TracePoint{
+			Location: &pd.Coordinate{
+				Latitude:  point.Location.Latitude,
+				Longitude: point.Location.Longitude,
+			},
+			Timestamp: point.Timestamp.UnixMilli(),
+			Speed:     point.Speed,
+		}
+	}
+
+	return &pd.GetTripTraceResponse{
+		TripID:     trace.TripID,
+		DriverID:   trace.DriverID,
+		Points:     points,
+		DistanceKm: trace.DistanceKm,
+	}, nil
+}
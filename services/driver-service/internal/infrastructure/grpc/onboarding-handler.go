@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"sort"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/services/driver-service/internal/service"
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *gRPCHandler) GetOnboarding(ctx context.Context, req *pd.GetOnboardingRequest) (*pd.DriverOnboarding, error) {
+	onboarding, err := h.onboardingService.GetOnboarding(ctx, req.DriverID)
+	if err != nil {
+		return nil, onboardingError("get onboarding", err)
+	}
+
+	return toProtoOnboarding(onboarding), nil
+}
+
+func (h *gRPCHandler) UploadDocument(ctx context.Context, req *pd.UploadDocumentRequest) (*pd.DriverOnboarding, error) {
+	onboarding, err := h.onboardingService.UploadDocument(
+		ctx,
+		req.DriverID,
+		domain.DocumentType(req.Type.String()),
+		req.Content,
+		time.UnixMilli(req.ExpiresAt),
+	)
+	if err != nil {
+		return nil, onboardingError("upload document", err)
+	}
+
+	return toProtoOnboarding(onboarding), nil
+}
+
+func (h *gRPCHandler) GetDocument(ctx context.Context, req *pd.GetDocumentRequest) (*pd.DocumentContent, error) {
+	document, content, err := h.onboardingService.GetDocument(ctx, req.DriverID, domain.DocumentType(req.Type.String()))
+	if err != nil {
+		return nil, onboardingError("get document", err)
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, onboardingError("get document", err)
+	}
+
+	return &pd.DocumentContent{
+		Document: toProtoDocument(document),
+		Content:  data,
+	}, nil
+}
+
+func (h *gRPCHandler) ReviewDriver(ctx context.Context, req *pd.ReviewDriverRequest) (*pd.DriverOnboarding, error) {
+	onboarding, err := h.onboardingService.ReviewDriver(ctx, req.DriverID, domain.OnboardingState(req.State.String()), req.Reason)
+	if err != nil {
+		return nil, onboardingError("review driver", err)
+	}
+
+	return toProtoOnboarding(onboarding), nil
+}
+
+func (h *gRPCHandler) ListOnboardings(ctx context.Context, req *pd.ListOnboardingsRequest) (*pd.ListOnboardingsResponse, error) {
+	onboardings, err := h.onboardingService.ListOnboardings(ctx, domain.OnboardingState(req.State.String()))
+	if err != nil {
+		return nil, onboardingError("list onboardings", err)
+	}
+
+	res := &pd.ListOnboardingsResponse{
+		Onboardings: make([]*pd.DriverOnboarding, len(onboardings)),
+	}
+	for i, onboarding := range onboardings {
+		res.Onboardings[i] = toProtoOnboarding(onboarding)
+	}
+
+	return res, nil
+}
+
+func onboardingError(operation string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrDocumentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidDocument), errors.Is(err, service.ErrInvalidReview):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrDocumentsMissing):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		log.Printf("Failed to %s: %v", operation, err)
+		return status.Error(codes.Internal, "failed to "+operation)
+	}
+}
+
+func toProtoOnboarding(onboarding *domain.DriverOnboarding) *pd.DriverOnboarding {
+	documents := make([]*pd.DriverDocument, 0, len(onboarding.Documents))
+	for _, document := range onboarding.Documents {
+		documents = append(documents, toProtoDocument(document))
+	}
+	sort.Slice(documents, func(i, j int) bool {
+		return documents[i].Type < documents[j].Type
+	})
+
+	res := &pd.DriverOnboarding{
+		DriverID:  onboarding.DriverID,
+		State:     pd.OnboardingState(pd.OnboardingState_value[string(onboarding.State)]),
+		Documents: documents,
+		Reason:    onboarding.Reason,
+	}
+	if !onboarding.UpdatedAt.IsZero() {
+		res.UpdatedAt = onboarding.UpdatedAt.UnixMilli()
+	}
+
+	return res
+}
+
+func toProtoDocument(document *domain.Document) *pd.DriverDocument {
+	return &pd.DriverDocument{
+		Type:        pd.DocumentType(pd.DocumentType_value[string(document.Type)]),
+		ContentType: document.ContentType,
+		Size:        document.Size,
+		ExpiresAt:   document.ExpiresAt.UnixMilli(),
+		UploadedAt:  document.UploadedAt.UnixMilli(),
+	}
+}
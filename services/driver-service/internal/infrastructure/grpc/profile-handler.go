@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/services/driver-service/internal/service"
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *gRPCHandler) UpsertDriverProfile(ctx context.Context, req *pd.UpsertDriverProfileRequest) (*pd.DriverProfile, error) {
+	_, err := h.profileService.UpsertProfile(ctx, &domain.DriverProfile{
+		DriverID:       req.DriverID,
+		Name:           req.Name,
+		ProfilePicture: req.ProfilePicture,
+		PhoneNumber:    req.PhoneNumber,
+	})
+	if err != nil {
+		return nil, profileError("upsert driver profile", err)
+	}
+
+	return h.GetDriverProfile(ctx, &pd.GetDriverProfileRequest{DriverID: req.DriverID})
+}
+
+func (h *gRPCHandler) GetDriverProfile(ctx context.Context, req *pd.GetDriverProfileRequest) (*pd.DriverProfile, error) {
+	profile, vehicles, err := h.profileService.GetProfile(ctx, req.DriverID)
+	if err != nil {
+		return nil, profileError("get driver profile", err)
+	}
+
+	rating, err := h.ratingService.GetRating(ctx, req.DriverID)
+	if err != nil {
+		return nil, profileError("get driver profile", err)
+	}
+
+	return toProtoProfile(profile, vehicles, rating), nil
+}
+
+func (h *gRPCHandler) DeleteDriverProfile(ctx context.Context, req *pd.DeleteDriverProfileRequest) (*pd.DeleteDriverProfileResponse, error) {
+	if err := h.profileService.DeleteProfile(ctx, req.DriverID); err != nil {
+		return nil, profileError("delete driver profile", err)
+	}
+
+	return &pd.DeleteDriverProfileResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *gRPCHandler) AddVehicle(ctx context.Context, req *pd.AddVehicleRequest) (*pd.Vehicle, error) {
+	if req.Vehicle == nil {
+		return nil, status.Error(codes.InvalidArgument, "vehicle is required")
+	}
+
+	vehicle, err := h.profileService.AddVehicle(ctx, req.DriverID, toDomainVehicle(req.Vehicle))
+	if err != nil {
+		return nil, profileError("add vehicle", err)
+	}
+
+	return toProtoVehicle(vehicle), nil
+}
+
+func (h *gRPCHandler) UpdateVehicle(ctx context.Context, req *pd.UpdateVehicleRequest) (*pd.Vehicle, error) {
+	if req.Vehicle == nil {
+		return nil, status.Error(codes.InvalidArgument, "vehicle is required")
+	}
+
+	vehicle, err := h.profileService.UpdateVehicle(ctx, req.DriverID, toDomainVehicle(req.Vehicle))
+	if err != nil {
+		return nil, profileError("update vehicle", err)
+	}
+
+	return toProtoVehicle(vehicle), nil
+}
+
+func (h *gRPCHandler) DeleteVehicle(ctx context.Context, req *pd.DeleteVehicleRequest) (*pd.DeleteVehicleResponse, error) {
+	if err := h.profileService.DeleteVehicle(ctx, req.DriverID, req.VehicleID); err != nil {
+		return nil, profileError("delete vehicle", err)
+	}
+
+	return &pd.DeleteVehicleResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *gRPCHandler) SetActiveVehicle(ctx context.Context, req *pd.SetActiveVehicleRequest) (*pd.DriverProfile, error) {
+	if _, err := h.profileService.SetActiveVehicle(ctx, req.DriverID, req.VehicleID); err != nil {
+		return nil, profileError("set active vehicle", err)
+	}
+
+	return h.GetDriverProfile(ctx, &pd.GetDriverProfileRequest{DriverID: req.DriverID})
+}
+
+func profileError(operation string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrProfileNotFound), errors.Is(err, service.ErrVehicleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrPlateTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrInvalidProfile), errors.Is(err, service.ErrInvalidVehicle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		log.Printf("Failed to %s: %v", operation, err)
+		return status.Error(codes.Internal, "failed to "+operation)
+	}
+}
+
+func toDomainVehicle(vehicle *pd.Vehicle) *domain.Vehicle {
+	return &domain.Vehicle{
+		ID:       vehicle.ID,
+		Make:     vehicle.Make,
+		Model:    vehicle.Model,
+		Colour:   vehicle.Colour,
+		Plate:    vehicle.Plate,
+		Year:     int(vehicle.Year),
+		Seats:    int(vehicle.Seats),
+		Packages: vehicle.Packages,
+	}
+}
+
+func toProtoVehicle(vehicle *domain.Vehicle) *pd.Vehicle {
+	return &pd.Vehicle{
+		ID:       vehicle.ID,
+		Make:     vehicle.Make,
+		Model:    vehicle.Model,
+		Colour:   vehicle.Colour,
+		Plate:    vehicle.Plate,
+		Year:     int32(vehicle.Year),
+		Seats:    int32(vehicle.Seats),
+		Packages: vehicle.Packages,
+	}
+}
+
+func toProtoProfile(profile *domain.DriverProfile, vehicles []*domain.Vehicle, rating *domain.DriverRating) *pd.DriverProfile {
+	protoVehicles := make([]*pd.Vehicle, len(vehicles))
+	for i, vehicle := range vehicles {
+		protoVehicles[i] = toProtoVehicle(vehicle)
+	}
+
+	return &pd.DriverProfile{
+		DriverID:        profile.DriverID,
+		Name:            profile.Name,
+		ProfilePicture:  profile.ProfilePicture,
+		PhoneNumber:     profile.PhoneNumber,
+		ActiveVehicleID: profile.ActiveVehicleID,
+		Vehicles:        protoVehicles,
+		RatingAverage:   rating.Average,
+		RatingCount:     int32(rating.Count),
+	}
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	pd "go-ride/shared/proto/driver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultShiftsLimit = 20
+	maxShiftsLimit     = 100
+)
+
+func (h *gRPCHandler) ListShifts(ctx context.Context, req *pd.ListShiftsRequest) (*pd.ListShiftsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultShiftsLimit
+	}
+	limit = min(limit, maxShiftsLimit)
+
+	shifts, driving, err := h.shiftService.ListShifts(ctx, req.DriverID, limit)
+	if err != nil {
+		log.Printf("Failed to list shifts: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list shifts")
+	}
+
+	res := &pd.ListShiftsResponse{
+		Shifts:                make([]*pd.Shift, len(shifts)),
+		DrivingSecondsLast24H: int64(driving.Seconds()),
+		DrivingLimitSeconds:   int64(h.shiftService.Limit().Seconds()),
+	}
+	for i, shift := range shifts {
+		res.Shifts[i] = &pd.Shift{
+			ID:             shift.ID,
+			StartedAt:      shift.StartedAt.UnixMilli(),
+			DrivingSeconds: int64(shift.DrivingTime.Seconds()),
+			BreakSeconds:   int64(shift.BreakTime.Seconds()),
+			ForcedBreaks:   int32(shift.ForcedBreaks),
+		}
+		if !shift.EndedAt.IsZero() {
+			res.Shifts[i].EndedAt = shift.EndedAt.UnixMilli()
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	driversLedgerKey = "drivers:ledger"          // drivers:ledger:<driverID> -> sorted set of entries JSON by creation unix milliseconds
+	ledgerRecordKey  = "drivers:ledger_recorded" // set of <kind>:<tripID>, keeps a trip from being recorded twice
+)
+
+// appendEntryScript records the entry only once per trip and kind.
+var appendEntryScript = redis.NewScript(`
+if redis.call("SADD", KEYS[2], ARGV[1]) == 0 then
+	return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[2], ARGV[3])
+return 1
+`)
+
+type redisEarningsRepository struct {
+	client *redis.Client
+}
+
+func NewRedisEarningsRepository(client *redis.Client) domain.EarningsRepository {
+	return &redisEarningsRepository{
+		client: client,
+	}
+}
+
+func (r *redisEarningsRepository) AppendEntry(ctx context.Context, entry *domain.LedgerEntry) (bool, error) {
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal ledger entry: %w", err)
+	}
+
+	keys := []string{
+		fmt.Sprintf("%s:%s", driversLedgerKey, entry.DriverID),
+		ledgerRecordKey,
+	}
+	record := fmt.Sprintf("%s:%s", entry.Kind, entry.TripID)
+
+	appended, err := appendEntryScript.Run(ctx, r.client, keys, record, entry.CreatedAt.UnixMilli(), payload).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return appended == 1, nil
+}
+
+func (r *redisEarningsRepository) ListEntries(ctx context.Context, driverID string, from, to time.Time) ([]*domain.LedgerEntry, error) {
+	key := fmt.Sprintf("%s:%s", driversLedgerKey, driverID)
+
+	payloads, err := r.client.ZRangeByScore(ctx, key, &redis.ZRangeBy{
+		Min: strconv.FormatInt(from.UnixMilli(), 10),
+		Max: "(" + strconv.FormatInt(to.UnixMilli(), 10),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domain.LedgerEntry, len(payloads))
+	for i, payload := range payloads {
+		var entry domain.LedgerEntry
+		if err := json.Unmarshal([]byte(payload), &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode ledger entry: %w", err)
+		}
+		entries[i] = &entry
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	driversOnboardingKey      = "drivers:onboarding"       // drivers:onboarding:<driverID> -> onboarding JSON
+	driversOnboardingStateKey = "drivers:onboarding_state" // drivers:onboarding_state:<state> -> set of driver IDs
+	documentsExpiryKey        = "drivers:documents:expiry" // sorted set of <driverID>:<documentType> by expiry unix time
+)
+
+type redisOnboardingRepository struct {
+	client *redis.Client
+}
+
+func NewRedisOnboardingRepository(client *redis.Client) domain.OnboardingRepository {
+	return &redisOnboardingRepository{
+		client: client,
+	}
+}
+
+// SaveOnboarding stores the onboarding, moves the driver to the set of its state and
+// indexes the expiry of the documents that are still valid.
+func (r *redisOnboardingRepository) SaveOnboarding(ctx context.Context, onboarding *domain.DriverOnboarding) error {
+	payload, err := json.Marshal(onboarding)
+	if err != nil {
+		return fmt.Errorf("failed to marshal onboarding: %w", err)
+	}
+
+	now := time.Now()
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, fmt.Sprintf("%s:%s", driversOnboardingKey, onboarding.DriverID), payload, 0)
+
+		for _, state := range domain.OnboardingStates {
+			key := fmt.Sprintf("%s:%s", driversOnboardingStateKey, state)
+			if state == onboarding.State {
+				pipe.SAdd(ctx, key, onboarding.DriverID)
+			} else {
+				pipe.SRem(ctx, key, onboarding.DriverID)
+			}
+		}
+
+		for _, document := range onboarding.Documents {
+			if document.Expired(now) {
+				continue
+			}
+			pipe.ZAdd(ctx, documentsExpiryKey, redis.Z{
+				Score:  float64(document.ExpiresAt.Unix()),
+				Member: documentMember(onboarding.DriverID, document.Type),
+			})
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (r *redisOnboardingRepository) GetOnboarding(ctx context.Context, driverID string) (*domain.DriverOnboarding, error) {
+	key := fmt.Sprintf("%s:%s", driversOnboardingKey, driverID)
+
+	payload, err := r.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var onboarding domain.DriverOnboarding
+	if err := json.Unmarshal([]byte(payload), &onboarding); err != nil {
+		return nil, fmt.Errorf("failed to decode onboarding: %w", err)
+	}
+
+	return &onboarding, nil
+}
+
+func (r *redisOnboardingRepository) ListOnboardings(ctx context.Context, state domain.OnboardingState) ([]*domain.DriverOnboarding, error) {
+	driverIDs, err := r.client.SMembers(ctx, fmt.Sprintf("%s:%s", driversOnboardingStateKey, state)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	onboardings := make([]*domain.DriverOnboarding, 0, len(driverIDs))
+	for _, driverID := range driverIDs {
+		onboarding, err := r.GetOnboarding(ctx, driverID)
+		if err != nil {
+			return nil, err
+		}
+		if onboarding != nil {
+			onboardings = append(onboardings, onboarding)
+		}
+	}
+
+	// Oldest first, it is the order reviewers work in
+	sort.Slice(onboardings, func(i, j int) bool {
+		return onboardings[i].UpdatedAt.Before(onboardings[j].UpdatedAt)
+	})
+
+	return onboardings, nil
+}
+
+func (r *redisOnboardingRepository) FindExpiringDocuments(ctx context.Context, before time.Time) ([]string, error) {
+	members, err := r.client.ZRangeByScore(ctx, documentsExpiryKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(before.Unix(), 10),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(members))
+	driverIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		i := strings.LastIndex(member, ":")
+		if i < 0 {
+			continue
+		}
+
+		driverID := member[:i]
+		if !seen[driverID] {
+			seen[driverID] = true
+			driverIDs = append(driverIDs, driverID)
+		}
+	}
+
+	return driverIDs, nil
+}
+
+func (r *redisOnboardingRepository) UnindexDocument(ctx context.Context, driverID string, documentType domain.DocumentType) error {
+	return r.client.ZRem(ctx, documentsExpiryKey, documentMember(driverID, documentType)).Err()
+}
+
+func documentMember(driverID string, documentType domain.DocumentType) string {
+	return fmt.Sprintf("%s:%s", driverID, documentType)
+}
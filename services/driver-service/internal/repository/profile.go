@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go-ride/services/driver-service/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	driversProfileKey  = "drivers:profile"  // drivers:profile:<driverID> -> profile JSON
+	driversVehiclesKey = "drivers:vehicles" // drivers:vehicles:<driverID> -> hash of vehicle JSON by vehicle ID
+	vehiclesPlatesKey  = "vehicles:plates"  // hash of vehicle ID by plate, keeps plates unique
+)
+
+// releasePlateScript frees the plate only if it is still held by the vehicle.
+var releasePlateScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], ARGV[1]) == ARGV[2] then
+	return redis.call("HDEL", KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+type redisProfileRepository struct {
+	client *redis.Client
+}
+
+func NewRedisProfileRepository(client *redis.Client) domain.ProfileRepository {
+	return &redisProfileRepository{
+		client: client,
+	}
+}
+
+func (r *redisProfileRepository) SaveProfile(ctx context.Context, profile *domain.DriverProfile) error {
+	payload, err := json.Marshal(profile)
+	if err != nil {
+		return fmt.Errorf("failed to marshal profile: %w", err)
+	}
+
+	key := fmt.Sprintf("%s:%s", driversProfileKey, profile.DriverID)
+	return r.client.Set(ctx, key, payload, 0).Err()
+}
+
+func (r *redisProfileRepository) GetProfile(ctx context.Context, driverID string) (*domain.DriverProfile, error) {
+	key := fmt.Sprintf("%s:%s", driversProfileKey, driverID)
+
+	payload, err := r.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var profile domain.DriverProfile
+	if err := json.Unmarshal([]byte(payload), &profile); err != nil {
+		return nil, fmt.Errorf("failed to decode profile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+func (r *redisProfileRepository) DeleteProfile(ctx context.Context, driverID string) error {
+	vehicles, err := r.ListVehicles(ctx, driverID)
+	if err != nil {
+		return err
+	}
+
+	for _, vehicle := range vehicles {
+		if err := r.ReleasePlate(ctx, vehicle.Plate, vehicle.ID); err != nil {
+			return err
+		}
+	}
+
+	return r.client.Del(ctx,
+		fmt.Sprintf("%s:%s", driversProfileKey, driverID),
+		fmt.Sprintf("%s:%s", driversVehiclesKey, driverID),
+	).Err()
+}
+
+func (r *redisProfileRepository) ClaimPlate(ctx context.Context, plate, vehicleID string) (bool, error) {
+	claimed, err := r.client.HSetNX(ctx, vehiclesPlatesKey, plate, vehicleID).Result()
+	if err != nil || claimed {
+		return claimed, err
+	}
+
+	// Saving a vehicle again with its own plate is not a conflict
+	owner, err := r.client.HGet(ctx, vehiclesPlatesKey, plate).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return owner == vehicleID, nil
+}
+
+func (r *redisProfileRepository) ReleasePlate(ctx context.Context, plate, vehicleID string) error {
+	return releasePlateScript.Run(ctx, r.client, []string{vehiclesPlatesKey}, plate, vehicleID).Err()
+}
+
+func (r *redisProfileRepository) SaveVehicle(ctx context.Context, vehicle *domain.Vehicle) error {
+	payload, err := json.Marshal(vehicle)
+	if err != nil {
+		return fmt.Errorf("failed to marshal vehicle: %w", err)
+	}
+
+	key := fmt.Sprintf("%s:%s", driversVehiclesKey, vehicle.DriverID)
+	return r.client.HSet(ctx, key, vehicle.ID, payload).Err()
+}
+
+func (r *redisProfileRepository) GetVehicle(ctx context.Context, driverID, vehicleID string) (*domain.Vehicle, error) {
+	key := fmt.Sprintf("%s:%s", driversVehiclesKey, driverID)
+
+	payload, err := r.client.HGet(ctx, key, vehicleID).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var vehicle domain.Vehicle
+	if err := json.Unmarshal([]byte(payload), &vehicle); err != nil {
+		return nil, fmt.Errorf("failed to decode vehicle: %w", err)
+	}
+
+	return &vehicle, nil
+}
+
+func (r *redisProfileRepository) ListVehicles(ctx context.Context, driverID string) ([]*domain.Vehicle, error) {
+	key := fmt.Sprintf("%s:%s", driversVehiclesKey, driverID)
+
+	payloads, err := r.client.HVals(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	vehicles := make([]*domain.Vehicle, len(payloads))
+	for i, payload := range payloads {
+		var vehicle domain.Vehicle
+		if err := json.Unmarshal([]byte(payload), &vehicle); err != nil {
+			return nil, fmt.Errorf("failed to decode vehicle: %w", err)
+		}
+		vehicles[i] = &vehicle
+	}
+
+	sort.Slice(vehicles, func(i, j int) bool {
+		return vehicles[i].Plate < vehicles[j].Plate
+	})
+
+	return vehicles, nil
+}
+
+func (r *redisProfileRepository) DeleteVehicle(ctx context.Context, driverID, vehicleID string) error {
+	key := fmt.Sprintf("%s:%s", driversVehiclesKey, driverID)
+	return r.client.HDel(ctx, key, vehicleID).Err()
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go-ride/services/driver-service/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	driversRatingsKey = "drivers:ratings" // drivers:ratings:<driverID> -> list of <tripID>:<stars>, newest first
+)
+
+// addRatingScript prepends the rating unless the trip is already in the window, then trims the window.
+var addRatingScript = redis.NewScript(`
+if redis.call("LPOS", KEYS[1], ARGV[1]) then
+	return 0
+end
+redis.call("LPUSH", KEYS[1], ARGV[1])
+redis.call("LTRIM", KEYS[1], 0, tonumber(ARGV[2]) - 1)
+return 1
+`)
+
+type redisRatingRepository struct {
+	client *redis.Client
+}
+
+func NewRedisRatingRepository(client *redis.Client) domain.RatingRepository {
+	return &redisRatingRepository{
+		client: client,
+	}
+}
+
+func (r *redisRatingRepository) AddRating(ctx context.Context, driverID, tripID string, stars, window int) (bool, error) {
+	key := fmt.Sprintf("%s:%s", driversRatingsKey, driverID)
+	member := fmt.Sprintf("%s:%d", tripID, stars)
+
+	added, err := addRatingScript.Run(ctx, r.client, []string{key}, member, window).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return added == 1, nil
+}
+
+func (r *redisRatingRepository) GetRating(ctx context.Context, driverID string) (*domain.DriverRating, error) {
+	ratings, err := r.GetRatings(ctx, []string{driverID})
+	if err != nil {
+		return nil, err
+	}
+	return ratings[driverID], nil
+}
+
+func (r *redisRatingRepository) GetRatings(ctx context.Context, driverIDs []string) (map[string]*domain.DriverRating, error) {
+	ratings := make(map[string]*domain.DriverRating, len(driverIDs))
+	if len(driverIDs) == 0 {
+		return ratings, nil
+	}
+
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.StringSliceCmd, len(driverIDs))
+	for i, driverID := range driverIDs {
+		cmds[i] = pipe.LRange(ctx, fmt.Sprintf("%s:%s", driversRatingsKey, driverID), 0, -1)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	for i, driverID := range driverIDs {
+		members, err := cmds[i].Result()
+		if err != nil {
+			return nil, err
+		}
+
+		rating := &domain.DriverRating{}
+		var total int
+		for _, member := range members {
+			_, value, _ := strings.Cut(member, ":")
+			stars, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode rating %q: %w", member, err)
+			}
+			total += stars
+			rating.Count++
+		}
+		if rating.Count > 0 {
+			rating.Average = float64(total) / float64(rating.Count)
+		}
+		ratings[driverID] = rating
+	}
+
+	return ratings, nil
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"go-ride/services/driver-service/internal/domain"
@@ -14,8 +16,46 @@ import (
 const (
 	driversLocationKey = "drivers:locations"
 	driversStatusKey   = "drivers:status"
+	driversHeadingKey  = "drivers:headings"
+	driversRelayKey    = "drivers:relay" // drivers:relay:<driverID> -> set while the last relayed location is recent
+	// driversSeenKey indexes drivers by the time of their last location update
+	driversSeenKey  = "drivers:locations:seen"
+	driverStatusTTL = 30 * time.Second
+	// busyStatusTTL rides out GPS gaps during a trip, yet frees a busy driver that vanished
+	busyStatusTTL = time.Hour
 )
 
+// compareAndSetStatusScript changes the status only when it still has the expected value,
+// so two trips cannot claim the same driver. ARGV[3] is the TTL in milliseconds, 0 for none.
+var compareAndSetStatusScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	if tonumber(ARGV[3]) > 0 then
+		redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+	else
+		redis.call("SET", KEYS[1], ARGV[2])
+	end
+	return 1
+end
+return 0
+`)
+
+// refreshStatusScript extends the TTL of a status, ARGV[1] is the TTL in milliseconds of an
+// available driver and ARGV[2] the one of the busy statuses that follow.
+var refreshStatusScript = redis.NewScript(`
+local status = redis.call("GET", KEYS[1])
+if not status then
+	return 0
+end
+local ttl = ARGV[1]
+for i = 3, #ARGV do
+	if status == ARGV[i] then
+		ttl = ARGV[2]
+	end
+end
+redis.call("PEXPIRE", KEYS[1], ttl)
+return 1
+`)
+
 type redisRepository struct {
 	client *redis.Client
 }
@@ -29,7 +69,54 @@ func NewRedisRepository(client *redis.Client) domain.DriverRepository {
 func (r *redisRepository) SetStatus(ctx context.Context, driverID string, status types.DriverStatus) error {
 	key := fmt.Sprintf("%s:%s", driversStatusKey, driverID)
 
-	return r.client.Set(ctx, key, string(status), 30*time.Second).Err()
+	return r.client.Set(ctx, key, string(status), statusTTL(status)).Err()
+}
+
+// statusTTL tells how long a status lasts without location updates. A driver serving a trip
+// stays busy through GPS gaps, but not forever when the end of its trip was lost.
+func statusTTL(status types.DriverStatus) time.Duration {
+	if status.IsBusy() {
+		return busyStatusTTL
+	}
+	return driverStatusTTL
+}
+
+func (r *redisRepository) GetStatus(ctx context.Context, driverID string) (types.DriverStatus, error) {
+	key := fmt.Sprintf("%s:%s", driversStatusKey, driverID)
+
+	status, err := r.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return types.OFFLINE, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return types.DriverStatus(status), nil
+}
+
+func (r *redisRepository) CompareAndSetStatus(ctx context.Context, driverID string, from, to types.DriverStatus) (bool, error) {
+	key := fmt.Sprintf("%s:%s", driversStatusKey, driverID)
+
+	swapped, err := compareAndSetStatusScript.Run(ctx, r.client, []string{key}, string(from), string(to), statusTTL(to).Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return swapped == 1, nil
+}
+
+// RefreshStatus extends the status TTL, it reports false when the status already expired.
+func (r *redisRepository) RefreshStatus(ctx context.Context, driverID string) (bool, error) {
+	key := fmt.Sprintf("%s:%s", driversStatusKey, driverID)
+
+	refreshed, err := refreshStatusScript.Run(ctx, r.client, []string{key}, driverStatusTTL.Milliseconds(), busyStatusTTL.Milliseconds(),
+		string(types.EN_ROUTE_TO_PICKUP), string(types.ON_TRIP)).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return refreshed == 1, nil
 }
 
 func (r *redisRepository) RemoveStatus(ctx context.Context, driverID string) error {
@@ -42,13 +129,137 @@ func (r *redisRepository) UpdateLocation(ctx context.Context, driverID string, l
 		return nil
 	}
 
-	return r.client.GeoAdd(ctx, driversLocationKey, &redis.GeoLocation{
+	pipe := r.client.TxPipeline()
+	pipe.GeoAdd(ctx, driversLocationKey, &redis.GeoLocation{
 		Name:      driverID,
 		Longitude: location.Longitude,
 		Latitude:  location.Latitude,
-	}).Err()
+	})
+	pipe.ZAdd(ctx, driversSeenKey, redis.Z{
+		Score:  float64(time.Now().UnixMilli()),
+		Member: driverID,
+	})
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *redisRepository) LocationUpdatedAt(ctx context.Context, driverIDs []string) (map[string]time.Time, error) {
+	if len(driverIDs) == 0 {
+		return nil, nil
+	}
+
+	scores, err := r.client.ZMScore(ctx, driversSeenKey, driverIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt := make(map[string]time.Time, len(driverIDs))
+	for i, score := range scores {
+		// Missing members have a zero score, they are treated as never updated
+		if score > 0 {
+			updatedAt[driverIDs[i]] = time.UnixMilli(int64(score))
+		}
+	}
+
+	return updatedAt, nil
+}
+
+func (r *redisRepository) FindStaleLocations(ctx context.Context, before time.Time) ([]string, error) {
+	return r.client.ZRangeByScore(ctx, driversSeenKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: "(" + strconv.FormatInt(before.UnixMilli(), 10),
+	}).Result()
+}
+
+func (r *redisRepository) GetLocation(ctx context.Context, driverID string) (*types.Coordinate, error) {
+	positions, err := r.client.GeoPos(ctx, driversLocationKey, driverID).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(positions) == 0 || positions[0] == nil {
+		return nil, nil
+	}
+
+	return &types.Coordinate{
+		Latitude:  positions[0].Latitude,
+		Longitude: positions[0].Longitude,
+	}, nil
+}
+
+func (r *redisRepository) SetHeading(ctx context.Context, driverID string, heading float64) error {
+	return r.client.HSet(ctx, driversHeadingKey, driverID, heading).Err()
+}
+
+func (r *redisRepository) FindNearby(ctx context.Context, location *types.Coordinate, radiusKm float64, limit int) ([]string, error) {
+	return r.client.GeoSearch(ctx, driversLocationKey, &redis.GeoSearchQuery{
+		Longitude:  location.Longitude,
+		Latitude:   location.Latitude,
+		Radius:     radiusKm,
+		RadiusUnit: "km",
+		Sort:       "ASC",
+		Count:      limit,
+	}).Result()
+}
+
+func (r *redisRepository) FindNearbyPositions(ctx context.Context, location *types.Coordinate, radiusKm float64, limit int) ([]*domain.DriverPosition, error) {
+	locations, err := r.client.GeoSearchLocation(ctx, driversLocationKey, &redis.GeoSearchLocationQuery{
+		GeoSearchQuery: redis.GeoSearchQuery{
+			Longitude:  location.Longitude,
+			Latitude:   location.Latitude,
+			Radius:     radiusKm,
+			RadiusUnit: "km",
+			Sort:       "ASC",
+			Count:      limit,
+		},
+		WithCoord: true,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(locations) == 0 {
+		return nil, nil
+	}
+
+	driverIDs := make([]string, len(locations))
+	for i, loc := range locations {
+		driverIDs[i] = loc.Name
+	}
+
+	headings, err := r.client.HMGet(ctx, driversHeadingKey, driverIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]*domain.DriverPosition, len(locations))
+	for i, loc := range locations {
+		positions[i] = &domain.DriverPosition{
+			DriverID: loc.Name,
+			Location: &types.Coordinate{
+				Latitude:  loc.Latitude,
+				Longitude: loc.Longitude,
+			},
+		}
+
+		if raw, ok := headings[i].(string); ok {
+			positions[i].Heading, _ = strconv.ParseFloat(raw, 64)
+		}
+	}
+
+	return positions, nil
 }
 
 func (r *redisRepository) RemoveLocation(ctx context.Context, driverID string) error {
-	return r.client.ZRem(ctx, driversLocationKey, driverID).Err()
+	pipe := r.client.TxPipeline()
+	pipe.ZRem(ctx, driversLocationKey, driverID)
+	pipe.HDel(ctx, driversHeadingKey, driverID)
+	pipe.ZRem(ctx, driversSeenKey, driverID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *redisRepository) AcquireRelaySlot(ctx context.Context, driverID string, interval time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s:%s", driversRelayKey, driverID)
+	return r.client.SetNX(ctx, key, 1, interval).Result()
 }
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	driversShiftKey   = "drivers:shift"       // drivers:shift:<driverID> -> current shift JSON
+	driversShiftsKey  = "drivers:shifts"      // drivers:shifts:<driverID> -> list of ended shifts JSON, newest first
+	shiftsOpenKey     = "drivers:shifts_open" // set of drivers with a current shift
+	driversDrivingKey = "drivers:driving"     // drivers:driving:<driverID> -> hash of driving seconds by bucket start
+	// drivingBucket is the granularity of the rolling driving time
+	drivingBucket = 5 * time.Minute
+	// drivingTTL keeps the buckets a bit longer than the rolling window
+	drivingTTL = 25 * time.Hour
+	// maxShiftHistory bounds the ended shifts kept per driver
+	maxShiftHistory = 100
+)
+
+type redisShiftRepository struct {
+	client *redis.Client
+}
+
+func NewRedisShiftRepository(client *redis.Client) domain.ShiftRepository {
+	return &redisShiftRepository{
+		client: client,
+	}
+}
+
+func (r *redisShiftRepository) StartShift(ctx context.Context, shift *domain.Shift) (bool, error) {
+	payload, err := json.Marshal(shift)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal shift: %w", err)
+	}
+
+	key := fmt.Sprintf("%s:%s", driversShiftKey, shift.DriverID)
+	started, err := r.client.SetNX(ctx, key, payload, 0).Result()
+	if err != nil || !started {
+		return false, err
+	}
+
+	return true, r.client.SAdd(ctx, shiftsOpenKey, shift.DriverID).Err()
+}
+
+func (r *redisShiftRepository) GetCurrentShift(ctx context.Context, driverID string) (*domain.Shift, error) {
+	key := fmt.Sprintf("%s:%s", driversShiftKey, driverID)
+
+	payload, err := r.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var shift domain.Shift
+	if err := json.Unmarshal([]byte(payload), &shift); err != nil {
+		return nil, fmt.Errorf("failed to decode shift: %w", err)
+	}
+
+	return &shift, nil
+}
+
+func (r *redisShiftRepository) UpdateCurrentShift(ctx context.Context, shift *domain.Shift) error {
+	payload, err := json.Marshal(shift)
+	if err != nil {
+		return fmt.Errorf("failed to marshal shift: %w", err)
+	}
+
+	key := fmt.Sprintf("%s:%s", driversShiftKey, shift.DriverID)
+	return r.client.SetXX(ctx, key, payload, redis.KeepTTL).Err()
+}
+
+func (r *redisShiftRepository) EndShift(ctx context.Context, shift *domain.Shift) error {
+	payload, err := json.Marshal(shift)
+	if err != nil {
+		return fmt.Errorf("failed to marshal shift: %w", err)
+	}
+
+	historyKey := fmt.Sprintf("%s:%s", driversShiftsKey, shift.DriverID)
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, fmt.Sprintf("%s:%s", driversShiftKey, shift.DriverID))
+		pipe.SRem(ctx, shiftsOpenKey, shift.DriverID)
+		pipe.LPush(ctx, historyKey, payload)
+		pipe.LTrim(ctx, historyKey, 0, maxShiftHistory-1)
+		return nil
+	})
+
+	return err
+}
+
+func (r *redisShiftRepository) ListOpenShifts(ctx context.Context) ([]string, error) {
+	return r.client.SMembers(ctx, shiftsOpenKey).Result()
+}
+
+func (r *redisShiftRepository) ListShifts(ctx context.Context, driverID string, limit int) ([]*domain.Shift, error) {
+	key := fmt.Sprintf("%s:%s", driversShiftsKey, driverID)
+
+	payloads, err := r.client.LRange(ctx, key, 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	shifts := make([]*domain.Shift, len(payloads))
+	for i, payload := range payloads {
+		var shift domain.Shift
+		if err := json.Unmarshal([]byte(payload), &shift); err != nil {
+			return nil, fmt.Errorf("failed to decode shift: %w", err)
+		}
+		shifts[i] = &shift
+	}
+
+	return shifts, nil
+}
+
+func (r *redisShiftRepository) AddDrivingTime(ctx context.Context, driverID string, at time.Time, duration time.Duration) error {
+	key := fmt.Sprintf("%s:%s", driversDrivingKey, driverID)
+	bucket := strconv.FormatInt(at.Truncate(drivingBucket).Unix(), 10)
+
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, key, bucket, int64(duration.Round(time.Second).Seconds()))
+		pipe.Expire(ctx, key, drivingTTL)
+		return nil
+	})
+
+	return err
+}
+
+// DrivingTimeSince sums the buckets that started at or after since, older buckets are dropped.
+func (r *redisShiftRepository) DrivingTimeSince(ctx context.Context, driverID string, since time.Time) (time.Duration, error) {
+	key := fmt.Sprintf("%s:%s", driversDrivingKey, driverID)
+
+	buckets, err := r.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	var outdated []string
+	for bucket, raw := range buckets {
+		start, err := strconv.ParseInt(bucket, 10, 64)
+		if err != nil {
+			continue
+		}
+		if time.Unix(start, 0).Before(since.Truncate(drivingBucket)) {
+			outdated = append(outdated, bucket)
+			continue
+		}
+
+		seconds, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			continue
+		}
+		total += seconds
+	}
+
+	if len(outdated) > 0 {
+		r.client.HDel(ctx, key, outdated...)
+	}
+
+	return time.Duration(total) * time.Second, nil
+}
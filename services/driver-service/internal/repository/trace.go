@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/shared/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	driversTripKey  = "drivers:current_trip" // drivers:current_trip:<driverID> -> hash of the trip served by the driver and its status
+	tripsTraceKey   = "trips:trace"          // trips:trace:<tripID> -> list of trace points
+	tripsTraceOwner = "trips:trace_driver"   // trips:trace_driver:<tripID> -> driver that drove the trip
+	// traceTTL keeps the traces long enough for support to replay a ride
+	traceTTL = 30 * 24 * time.Hour
+	// currentTripTTL outlives any trip, so a lost completion event does not keep the driver on it forever
+	currentTripTTL = 12 * time.Hour
+)
+
+// clearCurrentTripScript forgets the current trip only if the driver did not move on to another one.
+var clearCurrentTripScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "trip") == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type redisTraceRepository struct {
+	client *redis.Client
+}
+
+func NewRedisTraceRepository(client *redis.Client) domain.TraceRepository {
+	return &redisTraceRepository{
+		client: client,
+	}
+}
+
+func (r *redisTraceRepository) SetCurrentTrip(ctx context.Context, driverID, tripID string, status types.DriverStatus) error {
+	key := fmt.Sprintf("%s:%s", driversTripKey, driverID)
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, "trip", tripID, "status", string(status))
+	pipe.Expire(ctx, key, currentTripTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *redisTraceRepository) GetCurrentTrip(ctx context.Context, driverID string) (string, types.DriverStatus, error) {
+	key := fmt.Sprintf("%s:%s", driversTripKey, driverID)
+
+	values, err := r.client.HMGet(ctx, key, "trip", "status").Result()
+	if err != nil {
+		return "", "", err
+	}
+
+	tripID, _ := values[0].(string)
+	status, _ := values[1].(string)
+	return tripID, types.DriverStatus(status), nil
+}
+
+func (r *redisTraceRepository) ClearCurrentTrip(ctx context.Context, driverID, tripID string) error {
+	key := fmt.Sprintf("%s:%s", driversTripKey, driverID)
+	return clearCurrentTripScript.Run(ctx, r.client, []string{key}, tripID).Err()
+}
+
+func (r *redisTraceRepository) AppendPoints(ctx context.Context, tripID, driverID string, points []*domain.TracePoint) error {
+	if len(points) == 0 {
+		return nil
+	}
+
+	values := make([]any, len(points))
+	for i, point := range points {
+		payload, err := json.Marshal(point)
+		if err != nil {
+			return fmt.Errorf("failed to marshal trace point: %w", err)
+		}
+		values[i] = payload
+	}
+
+	traceKey := fmt.Sprintf("%s:%s", tripsTraceKey, tripID)
+	ownerKey := fmt.Sprintf("%s:%s", tripsTraceOwner, tripID)
+
+	pipe := r.client.TxPipeline()
+	pipe.RPush(ctx, traceKey, values...)
+	pipe.Expire(ctx, traceKey, traceTTL)
+	pipe.Set(ctx, ownerKey, driverID, traceTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *redisTraceRepository) GetLastPoint(ctx context.Context, tripID string) (*domain.TracePoint, error) {
+	key := fmt.Sprintf("%s:%s", tripsTraceKey, tripID)
+
+	payload, err := r.client.LIndex(ctx, key, -1).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var point domain.TracePoint
+	if err := json.Unmarshal([]byte(payload), &point); err != nil {
+		return nil, fmt.Errorf("failed to decode trace point: %w", err)
+	}
+
+	return &point, nil
+}
+
+func (r *redisTraceRepository) GetTrace(ctx context.Context, tripID string) (string, []*domain.TracePoint, error) {
+	driverID, err := r.client.Get(ctx, fmt.Sprintf("%s:%s", tripsTraceOwner, tripID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	payloads, err := r.client.LRange(ctx, fmt.Sprintf("%s:%s", tripsTraceKey, tripID), 0, -1).Result()
+	if err != nil {
+		return "", nil, err
+	}
+
+	points := make([]*domain.TracePoint, len(payloads))
+	for i, payload := range payloads {
+		var point domain.TracePoint
+		if err := json.Unmarshal([]byte(payload), &point); err != nil {
+			return "", nil, fmt.Errorf("failed to decode trace point: %w", err)
+		}
+		points[i] = &point
+	}
+
+	return driverID, points, nil
+}
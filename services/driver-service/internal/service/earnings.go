@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Periods of the earnings summaries
+const (
+	EarningsDaily  = "DAILY"
+	EarningsWeekly = "WEEKLY"
+)
+
+const (
+	// defaultCommissionRate applies to packages without a configured commission
+	defaultCommissionRate = 0.25
+	// maxEarningsRange bounds the summaries a single request can compute
+	maxEarningsRange = 366 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidEarningsRange = errors.New("invalid earnings range")
+)
+
+type EarningsService struct {
+	earnings domain.EarningsRepository
+	// commissionRates is the share of the fare kept by the platform, by package slug
+	commissionRates map[string]float64
+}
+
+func NewEarningsService(earnings domain.EarningsRepository, commissionRates map[string]float64) *EarningsService {
+	return &EarningsService{
+		earnings:        earnings,
+		commissionRates: commissionRates,
+	}
+}
+
+// RecordTripEarnings splits the final fare of a completed trip into the platform commission
+// and the driver earnings. A trip is recorded only once, so redelivered events are harmless.
+func (s *EarningsService) RecordTripEarnings(ctx context.Context, driverID, tripID, packageSlug string, fareCents int64) error {
+	rate, ok := s.commissionRates[packageSlug]
+	if !ok {
+		rate = defaultCommissionRate
+	}
+
+	commission := int64(math.Round(float64(fareCents) * rate))
+	entry := &domain.LedgerEntry{
+		ID:              uuid.NewString(),
+		DriverID:        driverID,
+		TripID:          tripID,
+		Kind:            domain.LedgerTripEarning,
+		PackageSlug:     packageSlug,
+		FareCents:       fareCents,
+		CommissionRate:  rate,
+		CommissionCents: commission,
+		EarningsCents:   fareCents - commission,
+		CreatedAt:       time.Now(),
+	}
+
+	appended, err := s.earnings.AppendEntry(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("failed to record the earnings of trip %s: %w", tripID, err)
+	}
+
+	if appended {
+		log.Printf("driver %s earned %d cents on trip %s", driverID, entry.EarningsCents, tripID)
+	}
+
+	return nil
+}
+
+// RecordCancellationFee credits the driver with its share of the fee the passenger paid for canceling,
+// or not showing up. The rest of the fee is the platform commission.
+func (s *EarningsService) RecordCancellationFee(ctx context.Context, driverID, tripID, packageSlug string, feeCents, compensationCents int64) error {
+	if feeCents <= 0 || compensationCents <= 0 {
+		return nil
+	}
+
+	entry := &domain.LedgerEntry{
+		ID:              uuid.NewString(),
+		DriverID:        driverID,
+		TripID:          tripID,
+		Kind:            domain.LedgerCancellationFee,
+		PackageSlug:     packageSlug,
+		FareCents:       feeCents,
+		CommissionRate:  1 - float64(compensationCents)/float64(feeCents),
+		CommissionCents: feeCents - compensationCents,
+		EarningsCents:   compensationCents,
+		CreatedAt:       time.Now(),
+	}
+
+	appended, err := s.earnings.AppendEntry(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("failed to record the cancellation fee of trip %s: %w", tripID, err)
+	}
+
+	if appended {
+		log.Printf("driver %s earned %d cents for the cancellation of trip %s", driverID, compensationCents, tripID)
+	}
+
+	return nil
+}
+
+// RecordTip credits the whole tip to the driver, the platform keeps no commission on tips.
+func (s *EarningsService) RecordTip(ctx context.Context, driverID, tripID string, amountCents int64) error {
+	if amountCents <= 0 {
+		return nil
+	}
+
+	entry := &domain.LedgerEntry{
+		ID:            uuid.NewString(),
+		DriverID:      driverID,
+		TripID:        tripID,
+		Kind:          domain.LedgerTip,
+		FareCents:     amountCents,
+		EarningsCents: amountCents,
+		CreatedAt:     time.Now(),
+	}
+
+	appended, err := s.earnings.AppendEntry(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("failed to record the tip of trip %s: %w", tripID, err)
+	}
+
+	if appended {
+		log.Printf("driver %s was tipped %d cents on trip %s", driverID, amountCents, tripID)
+	}
+
+	return nil
+}
+
+// GetEarnings sums the ledger of the driver by day or by week, every period of the range is
+// returned even without entries. Periods are in UTC and weeks start on Monday, like payouts.
+func (s *EarningsService) GetEarnings(ctx context.Context, driverID string, from, to time.Time, period string) ([]*domain.EarningsSummary, *domain.EarningsSummary, error) {
+	if period != EarningsDaily && period != EarningsWeekly {
+		return nil, nil, fmt.Errorf("%w: unknown period %q", ErrInvalidEarningsRange, period)
+	}
+
+	from = periodStart(from, period)
+	if !to.After(from) || to.Sub(from) > maxEarningsRange {
+		return nil, nil, fmt.Errorf("%w: the range must be positive and at most a year", ErrInvalidEarningsRange)
+	}
+
+	entries, err := s.earnings.ListEntries(ctx, driverID, from, to)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list the ledger of driver %s: %w", driverID, err)
+	}
+
+	var summaries []*domain.EarningsSummary
+	for start := from; start.Before(to); start = nextPeriod(start, period) {
+		summaries = append(summaries, &domain.EarningsSummary{
+			PeriodStart: start,
+			PeriodEnd:   nextPeriod(start, period),
+		})
+	}
+
+	total := &domain.EarningsSummary{PeriodStart: from, PeriodEnd: to}
+	i := 0
+	for _, entry := range entries {
+		for i < len(summaries)-1 && !entry.CreatedAt.Before(summaries[i].PeriodEnd) {
+			i++
+		}
+		summaries[i].Add(entry)
+		total.Add(entry)
+	}
+
+	return summaries, total, nil
+}
+
+// GetPayoutStatement returns the ledger entries of the weekly payout period containing at.
+func (s *EarningsService) GetPayoutStatement(ctx context.Context, driverID string, at time.Time) (*domain.EarningsSummary, []*domain.LedgerEntry, error) {
+	start := periodStart(at, EarningsWeekly)
+	total := &domain.EarningsSummary{
+		PeriodStart: start,
+		PeriodEnd:   nextPeriod(start, EarningsWeekly),
+	}
+
+	entries, err := s.earnings.ListEntries(ctx, driverID, total.PeriodStart, total.PeriodEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list the ledger of driver %s: %w", driverID, err)
+	}
+
+	for _, entry := range entries {
+		total.Add(entry)
+	}
+
+	return total, entries, nil
+}
+
+func periodStart(t time.Time, period string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if period == EarningsDaily {
+		return day
+	}
+
+	// Weeks start on Monday
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func nextPeriod(start time.Time, period string) time.Time {
+	if period == EarningsDaily {
+		return start.AddDate(0, 0, 1)
+	}
+	return start.AddDate(0, 0, 7)
+}
@@ -0,0 +1,342 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// maxDocumentSize keeps an upload under the 4MB default gRPC message size
+const maxDocumentSize = 3 << 20
+
+var (
+	ErrDriverNotApproved = errors.New("driver is not approved")
+	ErrInvalidDocument   = errors.New("invalid document")
+	ErrDocumentNotFound  = errors.New("document not found")
+	ErrDocumentsMissing  = errors.New("required documents are missing or expired")
+	ErrInvalidReview     = errors.New("invalid review")
+)
+
+var documentContentTypes = []string{"image/jpeg", "image/png", "application/pdf"}
+
+type OnboardingService struct {
+	onboardings domain.OnboardingRepository
+	drivers     domain.DriverRepository
+	blobs       domain.BlobStore
+	publisher   domain.DocumentPublisher
+	// reminderWindow is how long before expiry drivers are reminded to renew a document
+	reminderWindow time.Duration
+}
+
+func NewOnboardingService(
+	onboardings domain.OnboardingRepository,
+	drivers domain.DriverRepository,
+	blobs domain.BlobStore,
+	publisher domain.DocumentPublisher,
+	reminderWindow time.Duration,
+) *OnboardingService {
+	return &OnboardingService{
+		onboardings:    onboardings,
+		drivers:        drivers,
+		blobs:          blobs,
+		publisher:      publisher,
+		reminderWindow: reminderWindow,
+	}
+}
+
+// GetOnboarding returns the onboarding of the driver, a driver that never uploaded a document has APPLIED.
+func (s *OnboardingService) GetOnboarding(ctx context.Context, driverID string) (*domain.DriverOnboarding, error) {
+	onboarding, err := s.onboardings.GetOnboarding(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+
+	if onboarding == nil {
+		return &domain.DriverOnboarding{
+			DriverID:  driverID,
+			State:     domain.OnboardingApplied,
+			Documents: map[domain.DocumentType]*domain.Document{},
+		}, nil
+	}
+
+	if onboarding.Documents == nil {
+		onboarding.Documents = map[domain.DocumentType]*domain.Document{}
+	}
+
+	return onboarding, nil
+}
+
+// UploadDocument stores a document, replacing the previous one of the same type. The driver
+// goes UNDER_REVIEW once every required document is uploaded. Approved drivers renewing a
+// document stay APPROVED, and only a reviewer lifts a suspension.
+func (s *OnboardingService) UploadDocument(ctx context.Context, driverID string, documentType domain.DocumentType, content []byte, expiresAt time.Time) (*domain.DriverOnboarding, error) {
+	if !slices.Contains(domain.RequiredDocuments, documentType) {
+		return nil, fmt.Errorf("%w: unknown document type %q", ErrInvalidDocument, documentType)
+	}
+	if len(content) == 0 || len(content) > maxDocumentSize {
+		return nil, fmt.Errorf("%w: size must be between 1 byte and %d bytes", ErrInvalidDocument, maxDocumentSize)
+	}
+
+	// The content type sent by the client is not trusted
+	contentType := http.DetectContentType(content)
+	if !slices.Contains(documentContentTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s files are not accepted", ErrInvalidDocument, contentType)
+	}
+
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: the document is expired", ErrInvalidDocument)
+	}
+
+	onboarding, err := s.GetOnboarding(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("documents/%s/%s/%s", driverID, strings.ToLower(string(documentType)), uuid.NewString())
+	if err := s.blobs.Put(ctx, key, bytes.NewReader(content)); err != nil {
+		return nil, fmt.Errorf("failed to store document: %w", err)
+	}
+
+	previous := onboarding.Documents[documentType]
+	onboarding.Documents[documentType] = &domain.Document{
+		Type:        documentType,
+		BlobKey:     key,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		ExpiresAt:   expiresAt,
+		UploadedAt:  now,
+	}
+
+	switch onboarding.State {
+	case domain.OnboardingApplied, domain.OnboardingDocumentsPending:
+		if onboarding.HasValidDocuments(now) {
+			onboarding.State = domain.OnboardingUnderReview
+			onboarding.Reason = ""
+		} else {
+			onboarding.State = domain.OnboardingDocumentsPending
+		}
+	}
+	onboarding.UpdatedAt = now
+
+	if err := s.onboardings.SaveOnboarding(ctx, onboarding); err != nil {
+		s.deleteBlob(ctx, key)
+		return nil, fmt.Errorf("failed to save onboarding: %w", err)
+	}
+
+	if previous != nil {
+		s.deleteBlob(ctx, previous.BlobKey)
+	}
+
+	return onboarding, nil
+}
+
+// GetDocument opens the content of a document, the caller closes it.
+func (s *OnboardingService) GetDocument(ctx context.Context, driverID string, documentType domain.DocumentType) (*domain.Document, io.ReadCloser, error) {
+	onboarding, err := s.GetOnboarding(ctx, driverID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	document, ok := onboarding.Documents[documentType]
+	if !ok {
+		return nil, nil, ErrDocumentNotFound
+	}
+
+	content, err := s.blobs.Get(ctx, document.BlobKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read document: %w", err)
+	}
+
+	return document, content, nil
+}
+
+// ReviewDriver approves a driver, sends it back to DOCUMENTS_PENDING or suspends it.
+// A driver that is no longer approved is taken offline.
+func (s *OnboardingService) ReviewDriver(ctx context.Context, driverID string, state domain.OnboardingState, reason string) (*domain.DriverOnboarding, error) {
+	onboarding, err := s.GetOnboarding(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reason = strings.TrimSpace(reason)
+	switch state {
+	case domain.OnboardingApproved:
+		if !onboarding.HasValidDocuments(now) {
+			return nil, ErrDocumentsMissing
+		}
+		reason = ""
+	case domain.OnboardingDocumentsPending, domain.OnboardingSuspended:
+		if reason == "" {
+			return nil, fmt.Errorf("%w: a reason is required", ErrInvalidReview)
+		}
+	default:
+		return nil, fmt.Errorf("%w: a driver cannot be moved to %q", ErrInvalidReview, state)
+	}
+
+	onboarding.State = state
+	onboarding.Reason = reason
+	onboarding.UpdatedAt = now
+
+	if err := s.onboardings.SaveOnboarding(ctx, onboarding); err != nil {
+		return nil, fmt.Errorf("failed to save onboarding: %w", err)
+	}
+
+	if state != domain.OnboardingApproved {
+		if err := s.takeOffline(ctx, driverID); err != nil {
+			log.Printf("failed to take driver %s offline: %v", driverID, err)
+		}
+	}
+
+	log.Printf("driver %s onboarding reviewed: %s", driverID, state)
+	return onboarding, nil
+}
+
+func (s *OnboardingService) ListOnboardings(ctx context.Context, state domain.OnboardingState) ([]*domain.DriverOnboarding, error) {
+	if !slices.Contains(domain.OnboardingStates, state) {
+		return nil, fmt.Errorf("%w: unknown state %q", ErrInvalidReview, state)
+	}
+
+	return s.onboardings.ListOnboardings(ctx, state)
+}
+
+// CheckDocumentExpiry reminds drivers of the documents expiring within the reminder window,
+// and sends APPROVED or UNDER_REVIEW drivers with an expired document back to DOCUMENTS_PENDING.
+// It returns the number of drivers that were notified.
+func (s *OnboardingService) CheckDocumentExpiry(ctx context.Context) (int, error) {
+	now := time.Now()
+	remindBefore := now.Add(s.reminderWindow)
+	driverIDs, err := s.onboardings.FindExpiringDocuments(ctx, remindBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	notified := 0
+	for _, driverID := range driverIDs {
+		onboarding, err := s.onboardings.GetOnboarding(ctx, driverID)
+		if err != nil {
+			return notified, err
+		}
+		if onboarding == nil {
+			continue
+		}
+
+		var expired []domain.DocumentType
+		changed := false
+		for _, document := range onboarding.Documents {
+			switch {
+			case document.Expired(now):
+				expired = append(expired, document.Type)
+				if err := s.publisher.PublishDocumentExpiring(ctx, driverID, document, true); err != nil {
+					log.Printf("failed to notify driver %s of an expired %s: %v", driverID, document.Type, err)
+				}
+			case document.ExpiresAt.Before(remindBefore) && document.RemindedAt.IsZero():
+				if err := s.publisher.PublishDocumentExpiring(ctx, driverID, document, false); err != nil {
+					log.Printf("failed to remind driver %s to renew its %s: %v", driverID, document.Type, err)
+					continue
+				}
+				document.RemindedAt = now
+				changed = true
+			}
+		}
+
+		wasApproved := onboarding.State == domain.OnboardingApproved
+		if len(expired) > 0 && (wasApproved || onboarding.State == domain.OnboardingUnderReview) {
+			onboarding.State = domain.OnboardingDocumentsPending
+			onboarding.Reason = fmt.Sprintf("%s expired", expired[0])
+			onboarding.UpdatedAt = now
+			changed = true
+		}
+
+		if changed {
+			if err := s.onboardings.SaveOnboarding(ctx, onboarding); err != nil {
+				return notified, fmt.Errorf("failed to save onboarding of driver %s: %w", driverID, err)
+			}
+		}
+
+		// Expired documents are only reported once
+		for _, documentType := range expired {
+			if err := s.onboardings.UnindexDocument(ctx, driverID, documentType); err != nil {
+				return notified, err
+			}
+		}
+
+		if wasApproved && onboarding.State != domain.OnboardingApproved {
+			if err := s.takeOffline(ctx, driverID); err != nil {
+				log.Printf("failed to take driver %s offline: %v", driverID, err)
+			}
+		}
+
+		if changed || len(expired) > 0 {
+			notified++
+		}
+	}
+
+	return notified, nil
+}
+
+// RunDocumentChecker checks the expiry of documents every interval until ctx is cancelled.
+func (s *OnboardingService) RunDocumentChecker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			notified, err := s.CheckDocumentExpiry(ctx)
+			if err != nil {
+				log.Printf("failed to check document expiry: %v", err)
+				continue
+			}
+
+			if notified > 0 {
+				log.Printf("notified %d drivers of expiring documents", notified)
+			}
+		}
+	}
+}
+
+// takeOffline removes an idle driver from the map and matching. A driver serving a trip
+// finishes it and is not put back ONLINE afterwards. The shift sampler ends the shift.
+func (s *OnboardingService) takeOffline(ctx context.Context, driverID string) error {
+	driverStatus, err := s.drivers.GetStatus(ctx, driverID)
+	if err != nil || driverStatus.IsBusy() {
+		return err
+	}
+
+	if err := s.drivers.RemoveLocation(ctx, driverID); err != nil {
+		return err
+	}
+
+	return s.drivers.RemoveStatus(ctx, driverID)
+}
+
+func (s *OnboardingService) deleteBlob(ctx context.Context, key string) {
+	if err := s.blobs.Delete(ctx, key); err != nil {
+		log.Printf("failed to delete document %s: %v", key, err)
+	}
+}
+
+// isApproved reports whether the driver passed the onboarding review and can go ONLINE.
+func isApproved(ctx context.Context, onboardings domain.OnboardingRepository, driverID string) (bool, error) {
+	onboarding, err := onboardings.GetOnboarding(ctx, driverID)
+	if err != nil || onboarding == nil {
+		return false, err
+	}
+
+	return onboarding.State == domain.OnboardingApproved, nil
+}
@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	minVehicleYear  = 2000
+	minVehicleSeats = 2
+	maxVehicleSeats = 8
+)
+
+var (
+	ErrProfileNotFound = errors.New("driver profile not found")
+	ErrVehicleNotFound = errors.New("vehicle not found")
+	ErrPlateTaken      = errors.New("plate already registered")
+	ErrInvalidProfile  = errors.New("invalid driver profile")
+	ErrInvalidVehicle  = errors.New("invalid vehicle")
+)
+
+type ProfileService struct {
+	profiles domain.ProfileRepository
+}
+
+func NewProfileService(profiles domain.ProfileRepository) *ProfileService {
+	return &ProfileService{
+		profiles: profiles,
+	}
+}
+
+// UpsertProfile creates the profile of the driver or updates its personal details.
+func (s *ProfileService) UpsertProfile(ctx context.Context, profile *domain.DriverProfile) (*domain.DriverProfile, error) {
+	if strings.TrimSpace(profile.Name) == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidProfile)
+	}
+
+	current, err := s.profiles.GetProfile(ctx, profile.DriverID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if current == nil {
+		current = &domain.DriverProfile{
+			DriverID:  profile.DriverID,
+			CreatedAt: now,
+		}
+	}
+
+	current.Name = strings.TrimSpace(profile.Name)
+	current.ProfilePicture = profile.ProfilePicture
+	current.PhoneNumber = profile.PhoneNumber
+	current.UpdatedAt = now
+
+	if err := s.profiles.SaveProfile(ctx, current); err != nil {
+		return nil, fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	return current, nil
+}
+
+func (s *ProfileService) GetProfile(ctx context.Context, driverID string) (*domain.DriverProfile, []*domain.Vehicle, error) {
+	profile, err := s.profiles.GetProfile(ctx, driverID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if profile == nil {
+		return nil, nil, ErrProfileNotFound
+	}
+
+	vehicles, err := s.profiles.ListVehicles(ctx, driverID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return profile, vehicles, nil
+}
+
+func (s *ProfileService) DeleteProfile(ctx context.Context, driverID string) error {
+	profile, err := s.profiles.GetProfile(ctx, driverID)
+	if err != nil {
+		return err
+	}
+	if profile == nil {
+		return ErrProfileNotFound
+	}
+
+	return s.profiles.DeleteProfile(ctx, driverID)
+}
+
+// AddVehicle registers a vehicle of the driver, the first one becomes the active vehicle.
+func (s *ProfileService) AddVehicle(ctx context.Context, driverID string, vehicle *domain.Vehicle) (*domain.Vehicle, error) {
+	profile, err := s.profiles.GetProfile(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, ErrProfileNotFound
+	}
+
+	vehicle.ID = uuid.New().String()
+	vehicle.DriverID = driverID
+	if err := s.saveVehicle(ctx, vehicle, ""); err != nil {
+		return nil, err
+	}
+
+	if profile.ActiveVehicleID == "" {
+		profile.ActiveVehicleID = vehicle.ID
+		profile.UpdatedAt = time.Now()
+		if err := s.profiles.SaveProfile(ctx, profile); err != nil {
+			return nil, fmt.Errorf("failed to save profile: %w", err)
+		}
+	}
+
+	return vehicle, nil
+}
+
+func (s *ProfileService) UpdateVehicle(ctx context.Context, driverID string, vehicle *domain.Vehicle) (*domain.Vehicle, error) {
+	current, err := s.profiles.GetVehicle(ctx, driverID, vehicle.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, ErrVehicleNotFound
+	}
+
+	vehicle.DriverID = driverID
+	if err := s.saveVehicle(ctx, vehicle, current.Plate); err != nil {
+		return nil, err
+	}
+
+	return vehicle, nil
+}
+
+func (s *ProfileService) DeleteVehicle(ctx context.Context, driverID, vehicleID string) error {
+	vehicle, err := s.profiles.GetVehicle(ctx, driverID, vehicleID)
+	if err != nil {
+		return err
+	}
+	if vehicle == nil {
+		return ErrVehicleNotFound
+	}
+
+	if err := s.profiles.DeleteVehicle(ctx, driverID, vehicleID); err != nil {
+		return err
+	}
+
+	if err := s.profiles.ReleasePlate(ctx, vehicle.Plate, vehicle.ID); err != nil {
+		return err
+	}
+
+	profile, err := s.profiles.GetProfile(ctx, driverID)
+	if err != nil || profile == nil || profile.ActiveVehicleID != vehicleID {
+		return err
+	}
+
+	// Without an active vehicle the driver is not matched until choosing another one
+	profile.ActiveVehicleID = ""
+	profile.UpdatedAt = time.Now()
+	return s.profiles.SaveProfile(ctx, profile)
+}
+
+func (s *ProfileService) SetActiveVehicle(ctx context.Context, driverID, vehicleID string) (*domain.DriverProfile, error) {
+	profile, err := s.profiles.GetProfile(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, ErrProfileNotFound
+	}
+
+	vehicle, err := s.profiles.GetVehicle(ctx, driverID, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	if vehicle == nil {
+		return nil, ErrVehicleNotFound
+	}
+
+	profile.ActiveVehicleID = vehicleID
+	profile.UpdatedAt = time.Now()
+	if err := s.profiles.SaveProfile(ctx, profile); err != nil {
+		return nil, fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+// GetActiveVehicle returns the profile of the driver with the vehicle currently driven,
+// the vehicle is nil when the driver has not chosen one.
+func (s *ProfileService) GetActiveVehicle(ctx context.Context, driverID string) (*domain.DriverProfile, *domain.Vehicle, error) {
+	return activeVehicle(ctx, s.profiles, driverID)
+}
+
+// saveVehicle validates the vehicle and keeps its plate unique, previousPlate is the plate
+// the vehicle had before the update.
+func (s *ProfileService) saveVehicle(ctx context.Context, vehicle *domain.Vehicle, previousPlate string) error {
+	if err := normalizeVehicle(vehicle); err != nil {
+		return err
+	}
+
+	claimed, err := s.profiles.ClaimPlate(ctx, vehicle.Plate, vehicle.ID)
+	if err != nil {
+		return fmt.Errorf("failed to reserve plate: %w", err)
+	}
+	if !claimed {
+		return ErrPlateTaken
+	}
+
+	if err := s.profiles.SaveVehicle(ctx, vehicle); err != nil {
+		s.profiles.ReleasePlate(ctx, vehicle.Plate, vehicle.ID)
+		return fmt.Errorf("failed to save vehicle: %w", err)
+	}
+
+	if previousPlate != "" && previousPlate != vehicle.Plate {
+		return s.profiles.ReleasePlate(ctx, previousPlate, vehicle.ID)
+	}
+
+	return nil
+}
+
+func normalizeVehicle(vehicle *domain.Vehicle) error {
+	vehicle.Make = strings.TrimSpace(vehicle.Make)
+	vehicle.Model = strings.TrimSpace(vehicle.Model)
+	vehicle.Colour = strings.TrimSpace(vehicle.Colour)
+	vehicle.Plate = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(vehicle.Plate), " ", ""))
+
+	switch {
+	case vehicle.Make == "" || vehicle.Model == "" || vehicle.Colour == "" || vehicle.Plate == "":
+		return fmt.Errorf("%w: make, model, colour and plate are required", ErrInvalidVehicle)
+	case vehicle.Year < minVehicleYear || vehicle.Year > time.Now().Year()+1:
+		return fmt.Errorf("%w: year must be between %d and %d", ErrInvalidVehicle, minVehicleYear, time.Now().Year()+1)
+	case vehicle.Seats < minVehicleSeats || vehicle.Seats > maxVehicleSeats:
+		return fmt.Errorf("%w: seats must be between %d and %d", ErrInvalidVehicle, minVehicleSeats, maxVehicleSeats)
+	case len(vehicle.Packages) == 0:
+		return fmt.Errorf("%w: at least one package is required", ErrInvalidVehicle)
+	}
+
+	packages := make([]string, 0, len(vehicle.Packages))
+	for _, slug := range vehicle.Packages {
+		slug = strings.ToUpper(strings.TrimSpace(slug))
+		if !slices.Contains(domain.Packages, slug) {
+			return fmt.Errorf("%w: unknown package %s", ErrInvalidVehicle, slug)
+		}
+		if !slices.Contains(packages, slug) {
+			packages = append(packages, slug)
+		}
+	}
+	vehicle.Packages = packages
+
+	return nil
+}
+
+func activeVehicle(ctx context.Context, profiles domain.ProfileRepository, driverID string) (*domain.DriverProfile, *domain.Vehicle, error) {
+	profile, err := profiles.GetProfile(ctx, driverID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if profile == nil {
+		return nil, nil, ErrProfileNotFound
+	}
+
+	if profile.ActiveVehicleID == "" {
+		return profile, nil, nil
+	}
+
+	vehicle, err := profiles.GetVehicle(ctx, driverID, profile.ActiveVehicleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return profile, vehicle, nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go-ride/services/driver-service/internal/domain"
+)
+
+type RatingService struct {
+	ratings domain.RatingRepository
+	// averageOver is the number of latest ratings the average is computed on
+	averageOver int
+}
+
+func NewRatingService(ratings domain.RatingRepository, averageOver int) *RatingService {
+	return &RatingService{
+		ratings:     ratings,
+		averageOver: averageOver,
+	}
+}
+
+// RecordRating adds the stars a passenger gave the driver, a trip is only counted once
+// so redelivered events are harmless.
+func (s *RatingService) RecordRating(ctx context.Context, driverID, tripID string, stars int) error {
+	if stars < 1 || stars > 5 {
+		return fmt.Errorf("invalid rating of trip %s: %d stars", tripID, stars)
+	}
+
+	added, err := s.ratings.AddRating(ctx, driverID, tripID, stars, s.averageOver)
+	if err != nil {
+		return fmt.Errorf("failed to record the rating of trip %s: %w", tripID, err)
+	}
+	if !added {
+		log.Printf("rating of trip %s already recorded for driver %s", tripID, driverID)
+	}
+	return nil
+}
+
+func (s *RatingService) GetRating(ctx context.Context, driverID string) (*domain.DriverRating, error) {
+	rating, err := s.ratings.GetRating(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the rating of driver %s: %w", driverID, err)
+	}
+	return rating, nil
+}
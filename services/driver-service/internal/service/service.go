@@ -2,35 +2,599 @@ package service
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"go-ride/services/driver-service/internal/domain"
+	"go-ride/services/driver-service/pkg"
+	"go-ride/shared/contracts"
 	"go-ride/shared/types"
+	"log"
+	"math"
+	"time"
+)
+
+const (
+	matchingRadiusKm   = 5.0
+	matchingCandidates = 10
+
+	nearbyMaxRadiusKm = 5.0
+	nearbyMaxDrivers  = 20
+	// nearbyPrecision coarsens positions shown to riders to a grid of roughly 100 meters
+	nearbyPrecision = 3
+	// minHeadingDistanceKm ignores GPS jitter when computing the heading of a parked car
+	minHeadingDistanceKm = 0.01
+)
+
+var (
+	ErrNoDriversAvailable = errors.New("no drivers available")
+	ErrLocationNotFound   = errors.New("driver location not found")
 )
 
 type DriverService struct {
-	repo domain.DriverRepository
+	repo     domain.DriverRepository
+	traces   domain.TraceRepository
+	profiles domain.ProfileRepository
+	// onboardings gates the drivers that can go ONLINE and be offered trips
+	onboardings domain.OnboardingRepository
+	shifts      *ShiftService
+	publisher   domain.LocationPublisher
+	// ratings lets matching offer trips to low rated drivers last
+	ratings   domain.RatingRepository
+	lowRating domain.LowRatingPolicy
+	// locationTTL is how long a position is trusted without a new update from the driver
+	locationTTL time.Duration
+	// relayInterval throttles the locations relayed to the passenger of a trip
+	relayInterval time.Duration
+	// anonymousKey signs the IDs shown on the rider map, so they cannot be linked to drivers
+	anonymousKey []byte
 }
 
-func NewDriverService(repo domain.DriverRepository) *DriverService {
+func NewDriverService(
+	repo domain.DriverRepository,
+	traces domain.TraceRepository,
+	profiles domain.ProfileRepository,
+	onboardings domain.OnboardingRepository,
+	shifts *ShiftService,
+	publisher domain.LocationPublisher,
+	ratings domain.RatingRepository,
+	lowRating domain.LowRatingPolicy,
+	locationTTL, relayInterval time.Duration,
+	anonymousKey []byte,
+) *DriverService {
 	return &DriverService{
-		repo: repo,
+		repo:          repo,
+		traces:        traces,
+		profiles:      profiles,
+		onboardings:   onboardings,
+		shifts:        shifts,
+		publisher:     publisher,
+		ratings:       ratings,
+		lowRating:     lowRating,
+		locationTTL:   locationTTL,
+		relayInterval: relayInterval,
+		anonymousKey:  anonymousKey,
 	}
 }
 
+// UpdateDriverStatus stores the status and location reported by the driver app.
+// An empty status only refreshes the current one, and a driver serving a trip stays
+// busy when the app reports another status, e.g. ONLINE after reconnecting.
+// Only approved drivers can become available, and a driver over the driving limit is
+// put on BREAK instead of ONLINE. Going ONLINE starts a shift and going OFFLINE ends it.
 func (s *DriverService) UpdateDriverStatus(ctx context.Context, driverID string, status types.DriverStatus, location *types.Coordinate) error {
 	if status == types.OFFLINE {
-		s.repo.RemoveLocation(ctx, driverID)
-		return s.repo.RemoveStatus(ctx, driverID)
+		return s.goOffline(ctx, driverID)
 	}
 
-	if err := s.repo.SetStatus(ctx, driverID, status); err != nil {
+	current, err := s.repo.GetStatus(ctx, driverID)
+	if err != nil {
 		return err
 	}
 
+	if !current.IsBusy() {
+		approved, err := isApproved(ctx, s.onboardings, driverID)
+		if err != nil {
+			return fmt.Errorf("failed to check the onboarding of driver %s: %w", driverID, err)
+		}
+		if !approved {
+			return ErrDriverNotApproved
+		}
+	}
+
+	next := status
+	switch {
+	case status == "" && current == types.OFFLINE:
+		next = types.ONLINE
+	case status == "":
+		next = current
+	case current.IsBusy() && !status.IsBusy():
+		next = current
+	}
+
+	var limitErr error
+	if next == types.ONLINE {
+		reached, err := s.shifts.LimitReached(ctx, driverID)
+		if err != nil {
+			return err
+		}
+		if reached {
+			next, limitErr = types.BREAK, ErrDrivingLimitReached
+		}
+	}
+
+	if err := s.repo.SetStatus(ctx, driverID, next); err != nil {
+		return err
+	}
+
+	if current == types.OFFLINE {
+		if err := s.shifts.StartShift(ctx, driverID); err != nil {
+			log.Print(err)
+		}
+	}
+
 	if location != nil {
+		if err := s.updateHeading(ctx, driverID, location); err != nil {
+			log.Printf("failed to update heading of driver %s: %v", driverID, err)
+		}
+
 		if err := s.repo.UpdateLocation(ctx, driverID, location); err != nil {
 			return err
 		}
 	}
 
+	return limitErr
+}
+
+// goOffline removes the driver from the map and matching, and ends its shift.
+func (s *DriverService) goOffline(ctx context.Context, driverID string) error {
+	if err := s.repo.RemoveLocation(ctx, driverID); err != nil {
+		log.Printf("failed to remove the location of driver %s: %v", driverID, err)
+	}
+
+	if err := s.repo.RemoveStatus(ctx, driverID); err != nil {
+		return err
+	}
+
+	if err := s.shifts.EndShift(ctx, driverID); err != nil {
+		log.Print(err)
+	}
+
 	return nil
 }
+
+// ApplyLocationUpdates stores the latest position of a batch streamed by the driver app.
+// Unlike UpdateDriverStatus it only extends the status TTL. A driver with an expired status
+// gets back the busy status of the trip it still serves, or else comes back ONLINE, or on
+// BREAK over the driving limit, unless it is no longer approved. A driver left busy by a
+// trip whose end was lost is freed the same way once its current trip expired.
+func (s *DriverService) ApplyLocationUpdates(ctx context.Context, driverID string, updates []*domain.LocationUpdate) error {
+	if err := s.recordTrace(ctx, driverID, updates); err != nil {
+		log.Printf("failed to record trace of driver %s: %v", driverID, err)
+	}
+
+	var latest *domain.LocationUpdate
+	for _, update := range updates {
+		if update.Location == nil {
+			continue
+		}
+		if latest == nil || update.Timestamp.After(latest.Timestamp) {
+			latest = update
+		}
+	}
+
+	if latest == nil {
+		return nil
+	}
+
+	refreshed, err := s.repo.RefreshStatus(ctx, driverID)
+	if err != nil {
+		return err
+	}
+	if refreshed {
+		released, err := s.releaseEndedTrip(ctx, driverID)
+		if err != nil {
+			log.Printf("failed to release driver %s from its ended trip: %v", driverID, err)
+		}
+		if released == types.OFFLINE {
+			// Suspended during the trip, the driver stays off the map
+			return nil
+		}
+	} else {
+		next, err := s.restoredStatus(ctx, driverID)
+		if err != nil {
+			return err
+		}
+		if next == "" {
+			// Suspended while connected, the driver stays off the map
+			return nil
+		}
+		if err := s.repo.SetStatus(ctx, driverID, next); err != nil {
+			return err
+		}
+
+		if err := s.shifts.StartShift(ctx, driverID); err != nil {
+			log.Print(err)
+		}
+	}
+
+	// The heading reported by the device is only reliable while moving
+	heading := latest.Heading
+	if latest.Speed > 0 {
+		err = s.repo.SetHeading(ctx, driverID, heading)
+	} else {
+		err = s.updateHeading(ctx, driverID, latest.Location)
+	}
+	if err != nil {
+		log.Printf("failed to update heading of driver %s: %v", driverID, err)
+	}
+
+	if err := s.repo.UpdateLocation(ctx, driverID, latest.Location); err != nil {
+		return err
+	}
+
+	if err := s.relayLocation(ctx, driverID, latest); err != nil {
+		log.Printf("failed to relay the location of driver %s: %v", driverID, err)
+	}
+
+	return nil
+}
+
+// restoredStatus is the status of a driver coming back after its status expired, empty when it
+// must stay off the map. A driver still serving a trip gets the busy status of the trip back,
+// so it is never offered a second one.
+func (s *DriverService) restoredStatus(ctx context.Context, driverID string) (types.DriverStatus, error) {
+	tripID, tripStatus, err := s.traces.GetCurrentTrip(ctx, driverID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the current trip of driver %s: %w", driverID, err)
+	}
+	if tripID != "" && tripStatus.IsBusy() {
+		return tripStatus, nil
+	}
+
+	approved, err := isApproved(ctx, s.onboardings, driverID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check the onboarding of driver %s: %w", driverID, err)
+	}
+	if !approved {
+		return "", nil
+	}
+
+	reached, err := s.shifts.LimitReached(ctx, driverID)
+	if err != nil {
+		return "", err
+	}
+	if reached {
+		return types.BREAK, nil
+	}
+	return types.ONLINE, nil
+}
+
+// releaseEndedTrip frees a driver still busy although it no longer serves any trip, which
+// happens when the completed or cancelled event of its trip was lost. It returns the status
+// the driver was released to, empty when it was not.
+func (s *DriverService) releaseEndedTrip(ctx context.Context, driverID string) (types.DriverStatus, error) {
+	current, err := s.repo.GetStatus(ctx, driverID)
+	if err != nil || !current.IsBusy() {
+		return "", err
+	}
+
+	tripID, _, err := s.traces.GetCurrentTrip(ctx, driverID)
+	if err != nil || tripID != "" {
+		return "", err
+	}
+
+	next, err := s.restoredStatus(ctx, driverID)
+	if err != nil {
+		return "", err
+	}
+	log.Printf("driver %s is %s without a current trip, releasing it", driverID, current)
+	if next == "" {
+		return types.OFFLINE, s.goOffline(ctx, driverID)
+	}
+
+	swapped, err := s.repo.CompareAndSetStatus(ctx, driverID, current, next)
+	if err != nil || !swapped {
+		return "", err
+	}
+	return next, nil
+}
+
+// relayLocation publishes the position of a driver serving a trip, so the passenger
+// sees the car move. Updates are throttled to one per relay interval.
+func (s *DriverService) relayLocation(ctx context.Context, driverID string, update *domain.LocationUpdate) error {
+	driverStatus, err := s.repo.GetStatus(ctx, driverID)
+	if err != nil || !driverStatus.IsBusy() {
+		return err
+	}
+
+	tripID, _, err := s.traces.GetCurrentTrip(ctx, driverID)
+	if err != nil || tripID == "" {
+		return err
+	}
+
+	acquired, err := s.repo.AcquireRelaySlot(ctx, driverID, s.relayInterval)
+	if err != nil || !acquired {
+		return err
+	}
+
+	return s.publisher.PublishDriverLocation(ctx, tripID, &domain.DriverPosition{
+		DriverID: driverID,
+		Location: update.Location,
+		Heading:  update.Heading,
+	}, update.Timestamp)
+}
+
+// updateHeading derives the heading from the previous position of the driver.
+func (s *DriverService) updateHeading(ctx context.Context, driverID string, location *types.Coordinate) error {
+	previous, err := s.repo.GetLocation(ctx, driverID)
+	if err != nil || previous == nil {
+		return err
+	}
+
+	if pkg.DistanceKm(previous, location) < minHeadingDistanceKm {
+		return nil
+	}
+
+	return s.repo.SetHeading(ctx, driverID, pkg.Bearing(previous, location))
+}
+
+// GetNearbyDrivers returns the idle drivers around a location for the rider map.
+// Positions are coarsened and IDs anonymised so exact driver locations are not leaked.
+// With a package only the drivers whose active vehicle serves it are returned.
+func (s *DriverService) GetNearbyDrivers(ctx context.Context, location *types.Coordinate, radiusKm float64, packageSlug string, limit int) ([]*domain.DriverPosition, error) {
+	radiusKm = math.Min(radiusKm, nearbyMaxRadiusKm)
+	if limit <= 0 || limit > nearbyMaxDrivers {
+		limit = nearbyMaxDrivers
+	}
+
+	// Fetch extra candidates since busy drivers are filtered out
+	positions, err := s.repo.FindNearbyPositions(ctx, location, radiusKm, limit*2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search nearby drivers: %w", err)
+	}
+
+	driverIDs := make([]string, len(positions))
+	for i, position := range positions {
+		driverIDs[i] = position.DriverID
+	}
+
+	fresh, err := s.freshDrivers(ctx, driverIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	nearby := make([]*domain.DriverPosition, 0, limit)
+	for _, position := range positions {
+		if len(nearby) == limit {
+			break
+		}
+
+		if !fresh[position.DriverID] {
+			continue
+		}
+
+		driverStatus, err := s.repo.GetStatus(ctx, position.DriverID)
+		if err != nil {
+			return nil, err
+		}
+		if !driverStatus.IsIdle() {
+			continue
+		}
+
+		eligible, err := s.servesPackage(ctx, position.DriverID, packageSlug)
+		if err != nil {
+			return nil, err
+		}
+		if !eligible {
+			continue
+		}
+
+		nearby = append(nearby, &domain.DriverPosition{
+			DriverID: s.anonymize(position.DriverID),
+			Location: pkg.Coarsen(position.Location, nearbyPrecision),
+			Heading:  math.Round(position.Heading),
+		})
+	}
+
+	return nearby, nil
+}
+
+func (s *DriverService) anonymize(driverID string) string {
+	mac := hmac.New(sha256.New, s.anonymousKey)
+	mac.Write([]byte(driverID))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+func (s *DriverService) GetDriverStatus(ctx context.Context, driverID string) (types.DriverStatus, error) {
+	return s.repo.GetStatus(ctx, driverID)
+}
+
+func (s *DriverService) GetDriverLocation(ctx context.Context, driverID string) (*types.Coordinate, error) {
+	location, err := s.repo.GetLocation(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		return nil, ErrLocationNotFound
+	}
+
+	return location, nil
+}
+
+// FindAvailableDriver claims the nearest idle driver around the pickup location whose
+// active vehicle serves the package. Drivers that are paused, on a break or serving
+// another trip are skipped, and low rated drivers are only claimed when no other is.
+func (s *DriverService) FindAvailableDriver(ctx context.Context, pickup *types.Coordinate, packageSlug string) (string, error) {
+	candidates, err := s.repo.FindNearby(ctx, pickup, matchingRadiusKm, matchingCandidates)
+	if err != nil {
+		return "", fmt.Errorf("failed to search nearby drivers: %w", err)
+	}
+
+	fresh, err := s.freshDrivers(ctx, candidates)
+	if err != nil {
+		return "", err
+	}
+
+	eligible := make([]string, 0, len(candidates))
+	for _, driverID := range candidates {
+		if !fresh[driverID] {
+			// The app stopped reporting, the driver may not be there anymore
+			continue
+		}
+
+		serves, err := s.servesPackage(ctx, driverID, packageSlug)
+		if err != nil {
+			return "", err
+		}
+		if !serves {
+			continue
+		}
+
+		approved, err := isApproved(ctx, s.onboardings, driverID)
+		if err != nil {
+			return "", fmt.Errorf("failed to check the onboarding of driver %s: %w", driverID, err)
+		}
+		if !approved {
+			continue
+		}
+
+		eligible = append(eligible, driverID)
+	}
+
+	ordered, err := s.deprioritizeLowRated(ctx, eligible)
+	if err != nil {
+		return "", err
+	}
+
+	for _, driverID := range ordered {
+		claimed, err := s.repo.CompareAndSetStatus(ctx, driverID, types.ONLINE, types.EN_ROUTE_TO_PICKUP)
+		if err != nil {
+			return "", fmt.Errorf("failed to claim driver %s: %w", driverID, err)
+		}
+
+		if claimed {
+			return driverID, nil
+		}
+	}
+
+	return "", ErrNoDriversAvailable
+}
+
+// deprioritizeLowRated moves the low rated drivers after the others, keeping the distance order within each group.
+func (s *DriverService) deprioritizeLowRated(ctx context.Context, driverIDs []string) ([]string, error) {
+	ratings, err := s.ratings.GetRatings(ctx, driverIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get driver ratings: %w", err)
+	}
+
+	ordered := make([]string, 0, len(driverIDs))
+	var lowRated []string
+	for _, driverID := range driverIDs {
+		if s.lowRating.IsLow(ratings[driverID]) {
+			lowRated = append(lowRated, driverID)
+			continue
+		}
+		ordered = append(ordered, driverID)
+	}
+
+	return append(ordered, lowRated...), nil
+}
+
+// servesPackage reports whether the active vehicle of the driver is eligible for the package.
+// Any driver serves an empty package.
+func (s *DriverService) servesPackage(ctx context.Context, driverID, packageSlug string) (bool, error) {
+	if packageSlug == "" {
+		return true, nil
+	}
+
+	_, vehicle, err := activeVehicle(ctx, s.profiles, driverID)
+	if errors.Is(err, ErrProfileNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get the vehicle of driver %s: %w", driverID, err)
+	}
+
+	return vehicle != nil && vehicle.Serves(packageSlug), nil
+}
+
+// freshDrivers reports which drivers updated their location within the location TTL.
+func (s *DriverService) freshDrivers(ctx context.Context, driverIDs []string) (map[string]bool, error) {
+	updatedAt, err := s.repo.LocationUpdatedAt(ctx, driverIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check location freshness: %w", err)
+	}
+
+	cutoff := time.Now().Add(-s.locationTTL)
+	fresh := make(map[string]bool, len(updatedAt))
+	for driverID, at := range updatedAt {
+		fresh[driverID] = at.After(cutoff)
+	}
+
+	return fresh, nil
+}
+
+// ApplyTripEvent moves the driver status along with the trip lifecycle.
+func (s *DriverService) ApplyTripEvent(ctx context.Context, routingKey, driverID, tripID string) error {
+	current, err := s.repo.GetStatus(ctx, driverID)
+	if err != nil {
+		return err
+	}
+
+	switch routingKey {
+	case contracts.TripEventDriverAssigned:
+		err = s.traces.SetCurrentTrip(ctx, driverID, tripID, types.EN_ROUTE_TO_PICKUP)
+	case contracts.TripEventStarted:
+		err = s.traces.SetCurrentTrip(ctx, driverID, tripID, types.ON_TRIP)
+	case contracts.TripEventCompleted, contracts.TripEventCancelled:
+		err = s.traces.ClearCurrentTrip(ctx, driverID, tripID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to track the current trip of driver %s: %w", driverID, err)
+	}
+
+	var next types.DriverStatus
+	switch routingKey {
+	case contracts.TripEventDriverAssigned:
+		next = types.EN_ROUTE_TO_PICKUP
+	case contracts.TripEventStarted:
+		next = types.ON_TRIP
+	case contracts.TripEventCompleted, contracts.TripEventCancelled:
+		if !current.IsBusy() {
+			// The driver went offline or paused meanwhile, keep that status
+			return nil
+		}
+
+		approved, err := isApproved(ctx, s.onboardings, driverID)
+		if err != nil {
+			return fmt.Errorf("failed to check the onboarding of driver %s: %w", driverID, err)
+		}
+		if !approved {
+			// Suspended during the trip, the driver goes offline once it ends
+			log.Printf("driver %s is no longer approved, going OFFLINE (%s)", driverID, routingKey)
+			return s.goOffline(ctx, driverID)
+		}
+
+		// A driver who went over the driving limit during the trip takes the break once it ends
+		reached, err := s.shifts.LimitReached(ctx, driverID)
+		if err != nil {
+			return err
+		}
+		next = types.ONLINE
+		if reached {
+			next = types.BREAK
+		}
+	default:
+		return nil
+	}
+
+	if current == next {
+		return nil
+	}
+
+	log.Printf("driver %s status %s -> %s (%s)", driverID, current, next, routingKey)
+	return s.repo.SetStatus(ctx, driverID, next)
+}
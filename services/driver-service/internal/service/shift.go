@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/shared/types"
+
+	"github.com/google/uuid"
+)
+
+// drivingWindow is the rolling window the driving limit applies to
+const drivingWindow = 24 * time.Hour
+
+var (
+	ErrDrivingLimitReached = errors.New("driving limit reached, take a break")
+)
+
+// ShiftService tracks the online sessions of drivers and enforces the driving time limit.
+type ShiftService struct {
+	drivers   domain.DriverRepository
+	shifts    domain.ShiftRepository
+	publisher domain.ShiftPublisher
+	// limit is the driving time allowed per rolling 24 hours
+	limit time.Duration
+	// warning is how long before the limit drivers are warned
+	warning time.Duration
+	// sampleInterval is how often the driving time is accumulated
+	sampleInterval time.Duration
+}
+
+func NewShiftService(
+	drivers domain.DriverRepository,
+	shifts domain.ShiftRepository,
+	publisher domain.ShiftPublisher,
+	limit, warning, sampleInterval time.Duration,
+) *ShiftService {
+	return &ShiftService{
+		drivers:        drivers,
+		shifts:         shifts,
+		publisher:      publisher,
+		limit:          limit,
+		warning:        warning,
+		sampleInterval: sampleInterval,
+	}
+}
+
+// StartShift opens a shift for the driver, unless one is already open.
+func (s *ShiftService) StartShift(ctx context.Context, driverID string) error {
+	now := time.Now()
+	started, err := s.shifts.StartShift(ctx, &domain.Shift{
+		ID:        uuid.NewString(),
+		DriverID:  driverID,
+		StartedAt: now,
+		SampledAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start the shift of driver %s: %w", driverID, err)
+	}
+
+	if started {
+		log.Printf("driver %s started a shift", driverID)
+	}
+
+	return nil
+}
+
+// EndShift closes the current shift of the driver, if any.
+func (s *ShiftService) EndShift(ctx context.Context, driverID string) error {
+	shift, err := s.shifts.GetCurrentShift(ctx, driverID)
+	if err != nil || shift == nil {
+		return err
+	}
+
+	shift.EndedAt = time.Now()
+	if err := s.shifts.EndShift(ctx, shift); err != nil {
+		return fmt.Errorf("failed to end the shift of driver %s: %w", driverID, err)
+	}
+
+	log.Printf("driver %s ended a shift of %s driving", driverID, shift.DrivingTime.Round(time.Minute))
+	return nil
+}
+
+// LimitReached reports whether the driver used up its driving time over the last 24 hours.
+func (s *ShiftService) LimitReached(ctx context.Context, driverID string) (bool, error) {
+	driving, err := s.shifts.DrivingTimeSince(ctx, driverID, time.Now().Add(-drivingWindow))
+	if err != nil {
+		return false, fmt.Errorf("failed to get the driving time of driver %s: %w", driverID, err)
+	}
+
+	return driving >= s.limit, nil
+}
+
+// ListShifts returns the current shift of the driver followed by the ended ones, newest
+// first, and the driving time over the last 24 hours.
+func (s *ShiftService) ListShifts(ctx context.Context, driverID string, limit int) ([]*domain.Shift, time.Duration, error) {
+	current, err := s.shifts.GetCurrentShift(ctx, driverID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ended, err := s.shifts.ListShifts(ctx, driverID, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	shifts := ended
+	if current != nil {
+		shifts = append([]*domain.Shift{current}, ended...)
+		if len(shifts) > limit {
+			shifts = shifts[:limit]
+		}
+	}
+
+	driving, err := s.shifts.DrivingTimeSince(ctx, driverID, time.Now().Add(-drivingWindow))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return shifts, driving, nil
+}
+
+// Limit is the driving time allowed per rolling 24 hours.
+func (s *ShiftService) Limit() time.Duration {
+	return s.limit
+}
+
+// SampleShifts accumulates the driving and break time of every open shift, warns drivers
+// close to the limit and puts idle drivers over it on BREAK. A driver serving a trip is put
+// on BREAK once the trip ends. Shifts of drivers that went offline without telling are closed.
+func (s *ShiftService) SampleShifts(ctx context.Context) error {
+	driverIDs, err := s.shifts.ListOpenShifts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, driverID := range driverIDs {
+		if err := s.sampleShift(ctx, driverID); err != nil {
+			log.Printf("failed to sample the shift of driver %s: %v", driverID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *ShiftService) sampleShift(ctx context.Context, driverID string) error {
+	shift, err := s.shifts.GetCurrentShift(ctx, driverID)
+	if err != nil || shift == nil {
+		return err
+	}
+
+	driverStatus, err := s.drivers.GetStatus(ctx, driverID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if driverStatus == types.OFFLINE {
+		shift.EndedAt = shift.SampledAt
+		return s.shifts.EndShift(ctx, shift)
+	}
+
+	// A gap longer than a few samples, e.g. a restart, is not counted
+	elapsed := min(now.Sub(shift.SampledAt), 2*s.sampleInterval)
+	if driverStatus.IsIdle() || driverStatus.IsBusy() {
+		shift.DrivingTime += elapsed
+		if err := s.shifts.AddDrivingTime(ctx, driverID, now, elapsed); err != nil {
+			return err
+		}
+	} else {
+		shift.BreakTime += elapsed
+	}
+	shift.SampledAt = now
+
+	driving, err := s.shifts.DrivingTimeSince(ctx, driverID, now.Add(-drivingWindow))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case driving >= s.limit && driverStatus.IsIdle():
+		claimed, err := s.drivers.CompareAndSetStatus(ctx, driverID, types.ONLINE, types.BREAK)
+		if err != nil {
+			return err
+		}
+		if claimed {
+			shift.ForcedBreaks++
+			log.Printf("driver %s drove %s over the last 24 hours, forcing a BREAK", driverID, driving.Round(time.Minute))
+			s.notify(ctx, driverID, domain.ShiftNoticeBreakForced, driving)
+		}
+	case driving >= s.limit-s.warning && driving < s.limit && shift.WarnedAt.IsZero():
+		shift.WarnedAt = now
+		s.notify(ctx, driverID, domain.ShiftNoticeWarning, driving)
+	}
+
+	return s.shifts.UpdateCurrentShift(ctx, shift)
+}
+
+func (s *ShiftService) notify(ctx context.Context, driverID, kind string, driving time.Duration) {
+	err := s.publisher.PublishShiftNotice(ctx, driverID, &domain.ShiftNotice{
+		Kind:        kind,
+		DrivingTime: driving,
+		Limit:       s.limit,
+	})
+	if err != nil {
+		log.Printf("failed to send a %s shift notice to driver %s: %v", kind, driverID, err)
+	}
+}
+
+// RunShiftSampler samples the open shifts every sample interval until ctx is cancelled.
+func (s *ShiftService) RunShiftSampler(ctx context.Context) {
+	ticker := time.NewTicker(s.sampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.SampleShifts(ctx); err != nil {
+				log.Printf("failed to sample shifts: %v", err)
+			}
+		}
+	}
+}
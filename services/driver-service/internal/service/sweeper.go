@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// EvictStaleDrivers removes the location and status of every driver that has not
+// updated its location within the location TTL, e.g. because the app crashed.
+// Drivers serving a trip are kept, the end of the trip or the expiry of their busy
+// status frees them. A driver that fails to be evicted is logged and retried on the
+// next sweep.
+func (s *DriverService) EvictStaleDrivers(ctx context.Context) (int, error) {
+	stale, err := s.repo.FindStaleLocations(ctx, time.Now().Add(-s.locationTTL))
+	if err != nil {
+		return 0, err
+	}
+
+	evicted := 0
+	for _, driverID := range stale {
+		driverStatus, err := s.repo.GetStatus(ctx, driverID)
+		if err != nil {
+			log.Printf("failed to get the status of stale driver %s: %v", driverID, err)
+			continue
+		}
+		if driverStatus.IsBusy() {
+			continue
+		}
+
+		if err := s.goOffline(ctx, driverID); err != nil {
+			log.Printf("failed to evict stale driver %s: %v", driverID, err)
+			continue
+		}
+		evicted++
+	}
+
+	return evicted, nil
+}
+
+// RunLocationSweeper evicts stale drivers every interval until ctx is cancelled.
+func (s *DriverService) RunLocationSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			evicted, err := s.EvictStaleDrivers(ctx)
+			if err != nil {
+				log.Printf("failed to evict stale drivers: %v", err)
+				continue
+			}
+
+			if evicted > 0 {
+				log.Printf("evicted %d stale drivers", evicted)
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go-ride/services/driver-service/internal/domain"
+	"go-ride/services/driver-service/pkg"
+	"go-ride/shared/types"
+)
+
+const (
+	// traceMaxAccuracy rejects fixes the device itself reports as imprecise, in meters
+	traceMaxAccuracy = 50.0
+	// traceMaxSpeed rejects jumps no car can make between two fixes, in meters per second (~250 km/h)
+	traceMaxSpeed = 70.0
+	// A point is kept once the driver moved traceMinDistanceKm or traceMinInterval elapsed
+	traceMinDistanceKm = 0.025
+	traceMinInterval   = 5 * time.Second
+)
+
+var (
+	ErrTraceNotFound = errors.New("trip trace not found")
+)
+
+// recordTrace appends the location updates of a driver on a trip to the trip trace,
+// dropping imprecise fixes, impossible jumps and points too close to the previous one.
+func (s *DriverService) recordTrace(ctx context.Context, driverID string, updates []*domain.LocationUpdate) error {
+	driverStatus, err := s.repo.GetStatus(ctx, driverID)
+	if err != nil {
+		return err
+	}
+	if driverStatus != types.ON_TRIP {
+		return nil
+	}
+
+	tripID, _, err := s.traces.GetCurrentTrip(ctx, driverID)
+	if err != nil || tripID == "" {
+		return err
+	}
+
+	last, err := s.traces.GetLastPoint(ctx, tripID)
+	if err != nil {
+		return err
+	}
+
+	sorted := make([]*domain.LocationUpdate, len(updates))
+	copy(sorted, updates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	var points []*domain.TracePoint
+	for _, update := range sorted {
+		if update.Location == nil || update.Accuracy > traceMaxAccuracy {
+			continue
+		}
+
+		point := &domain.TracePoint{
+			Location:  update.Location,
+			Timestamp: update.Timestamp,
+			Speed:     update.Speed,
+		}
+
+		if last != nil && !acceptTracePoint(last, point) {
+			continue
+		}
+
+		points = append(points, point)
+		last = point
+	}
+
+	return s.traces.AppendPoints(ctx, tripID, driverID, points)
+}
+
+func acceptTracePoint(last, point *domain.TracePoint) bool {
+	elapsed := point.Timestamp.Sub(last.Timestamp)
+	if elapsed <= 0 {
+		// Out of order or duplicated fix
+		return false
+	}
+
+	distanceKm := pkg.DistanceKm(last.Location, point.Location)
+	if distanceKm*1000/elapsed.Seconds() > traceMaxSpeed {
+		return false
+	}
+
+	return distanceKm >= traceMinDistanceKm || elapsed >= traceMinInterval
+}
+
+// GetTripTrace returns the recorded path of a trip and the distance actually driven.
+func (s *DriverService) GetTripTrace(ctx context.Context, tripID string) (*domain.TripTrace, error) {
+	driverID, points, err := s.traces.GetTrace(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trace of trip %s: %w", tripID, err)
+	}
+	if driverID == "" {
+		return nil, ErrTraceNotFound
+	}
+
+	var distanceKm float64
+	for i := 1; i < len(points); i++ {
+		distanceKm += pkg.DistanceKm(points[i-1].Location, points[i].Location)
+	}
+
+	return &domain.TripTrace{
+		TripID:     tripID,
+		DriverID:   driverID,
+		Points:     points,
+		DistanceKm: distanceKm,
+	}, nil
+}
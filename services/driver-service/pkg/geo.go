@@ -0,0 +1,53 @@
+package pkg
+
+import (
+	"math"
+
+	"go-ride/shared/types"
+)
+
+const earthRadiusKm = 6371.0
+
+// Bearing returns the initial heading in degrees, clockwise from north, to go from a to b.
+func Bearing(a, b *types.Coordinate) float64 {
+	lat1 := toRadians(a.Latitude)
+	lat2 := toRadians(b.Latitude)
+	deltaLon := toRadians(b.Longitude - a.Longitude)
+
+	y := math.Sin(deltaLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(deltaLon)
+
+	return math.Mod(toDegrees(math.Atan2(y, x))+360, 360)
+}
+
+// DistanceKm returns the great-circle distance between two coordinates.
+func DistanceKm(a, b *types.Coordinate) float64 {
+	lat1 := toRadians(a.Latitude)
+	lat2 := toRadians(b.Latitude)
+	deltaLat := lat2 - lat1
+	deltaLon := toRadians(b.Longitude - a.Longitude)
+
+	h := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// Coarsen rounds the coordinate to the given number of decimal places,
+// 3 places is roughly a 100 meters grid.
+func Coarsen(c *types.Coordinate, decimals int) *types.Coordinate {
+	factor := math.Pow(10, float64(decimals))
+
+	return &types.Coordinate{
+		Latitude:  math.Round(c.Latitude*factor) / factor,
+		Longitude: math.Round(c.Longitude*factor) / factor,
+	}
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
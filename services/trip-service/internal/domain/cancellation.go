@@ -0,0 +1,92 @@
+package domain
+
+import (
+	pb "go-ride/shared/proto/trip"
+	"math"
+	"time"
+)
+
+type CanceledBy string
+
+const (
+	CanceledByPassenger CanceledBy = "passenger"
+	CanceledByDriver    CanceledBy = "driver"
+	CanceledBySystem    CanceledBy = "system"
+)
+
+// Reasons of a Cancellation
+const (
+	CancelReasonPassenger     = "passenger_canceled"
+	CancelReasonLatePassenger = "passenger_canceled_late"
+	CancelReasonDriverArrived = "passenger_canceled_driver_arrived"
+	CancelReasonDriver        = "driver_canceled"
+	CancelReasonNoShow        = "passenger_no_show"
+	CancelReasonNoDrivers     = "no_drivers_found"
+	CancelReasonPaymentFailed = "payment_failed"
+	CancelReasonPublishFailed = "request_failed"
+	CancelReasonPromoRejected = "promo_rejected"
+)
+
+// Cancellation tells who canceled a trip and what the passenger was charged for it.
+type Cancellation struct {
+	By                      CanceledBy
+	Reason                  string
+	FeeCents                int64
+	DriverCompensationCents int64
+	CanceledAt              time.Time
+}
+
+func (c *Cancellation) ToProto() *pb.Cancellation {
+	return &pb.Cancellation{
+		CanceledBy:              string(c.By),
+		Reason:                  c.Reason,
+		FeeCents:                c.FeeCents,
+		DriverCompensationCents: c.DriverCompensationCents,
+		CanceledAt:              c.CanceledAt.UnixMilli(),
+	}
+}
+
+// CancellationPolicy prices the cancellation of a trip a driver already accepted.
+type CancellationPolicy struct {
+	// FreeWindow is how long after the acceptance the passenger can cancel for free
+	FreeWindow time.Duration
+	// FeeCents is charged when the passenger cancels after the free window or once the driver arrived
+	FeeCents int64
+	// NoShowGrace is how long the driver waits at the pickup before canceling with the no-show fee
+	NoShowGrace    time.Duration
+	NoShowFeeCents int64
+	// DriverShare is the part of the fee paid to the driver, between 0 and 1
+	DriverShare float64
+}
+
+// Cancel prices the cancellation of a REQUESTED or ACCEPTED trip. The fee never exceeds the fare.
+func (p CancellationPolicy) Cancel(trip *TripModel, by CanceledBy, now time.Time) *Cancellation {
+	cancellation := &Cancellation{
+		By:         by,
+		CanceledAt: now,
+	}
+
+	switch {
+	case by == CanceledByDriver && !trip.ArrivedAt.IsZero() && now.Sub(trip.ArrivedAt) >= p.NoShowGrace:
+		cancellation.Reason = CancelReasonNoShow
+		cancellation.FeeCents = p.NoShowFeeCents
+	case by == CanceledByDriver:
+		cancellation.Reason = CancelReasonDriver
+	case trip.Status != ACCEPTED:
+		cancellation.Reason = CancelReasonPassenger
+	case !trip.ArrivedAt.IsZero():
+		cancellation.Reason = CancelReasonDriverArrived
+		cancellation.FeeCents = p.FeeCents
+	case now.Sub(trip.AcceptedAt) > p.FreeWindow:
+		cancellation.Reason = CancelReasonLatePassenger
+		cancellation.FeeCents = p.FeeCents
+	default:
+		cancellation.Reason = CancelReasonPassenger
+	}
+
+	fare := int64(math.Round(trip.RideFare.TotalPriceInCents))
+	cancellation.FeeCents = min(cancellation.FeeCents, fare)
+	cancellation.DriverCompensationCents = int64(math.Round(float64(cancellation.FeeCents) * p.DriverShare))
+
+	return cancellation
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCancellationPolicyPricesTheCancellation(t *testing.T) {
+	policy := CancellationPolicy{
+		FreeWindow:     2 * time.Minute,
+		FeeCents:       500,
+		NoShowGrace:    5 * time.Minute,
+		NoShowFeeCents: 700,
+		DriverShare:    0.8,
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		status       TripStatus
+		acceptedAgo  time.Duration
+		arrivedAgo   time.Duration // zero when the driver has not arrived
+		by           CanceledBy
+		fareCents    float64
+		reason       string
+		fee          int64
+		compensation int64
+	}{
+		{"requested", REQUESTED, 0, 0, CanceledByPassenger, 1800, CancelReasonPassenger, 0, 0},
+		{"within the free window", ACCEPTED, time.Minute, 0, CanceledByPassenger, 1800, CancelReasonPassenger, 0, 0},
+		{"after the free window", ACCEPTED, 3 * time.Minute, 0, CanceledByPassenger, 1800, CancelReasonLatePassenger, 500, 400},
+		{"driver arrived", ACCEPTED, time.Minute, time.Second, CanceledByPassenger, 1800, CancelReasonDriverArrived, 500, 400},
+		{"driver cancels", ACCEPTED, 3 * time.Minute, 0, CanceledByDriver, 1800, CancelReasonDriver, 0, 0},
+		{"driver cancels within the grace", ACCEPTED, 10 * time.Minute, time.Minute, CanceledByDriver, 1800, CancelReasonDriver, 0, 0},
+		{"no-show", ACCEPTED, 10 * time.Minute, 6 * time.Minute, CanceledByDriver, 1800, CancelReasonNoShow, 700, 560},
+		{"fee capped at the fare", ACCEPTED, 3 * time.Minute, 0, CanceledByPassenger, 350, CancelReasonLatePassenger, 350, 280},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trip := &TripModel{
+				Status:   tt.status,
+				RideFare: &RideFareModel{TotalPriceInCents: tt.fareCents},
+			}
+			if tt.status == ACCEPTED {
+				trip.AcceptedAt = now.Add(-tt.acceptedAgo)
+			}
+			if tt.arrivedAgo > 0 {
+				trip.ArrivedAt = now.Add(-tt.arrivedAgo)
+			}
+
+			cancellation := policy.Cancel(trip, tt.by, now)
+			if cancellation.Reason != tt.reason {
+				t.Errorf("reason = %s, want %s", cancellation.Reason, tt.reason)
+			}
+			if cancellation.FeeCents != tt.fee || cancellation.DriverCompensationCents != tt.compensation {
+				t.Errorf("fee = %d with %d to the driver, want %d with %d", cancellation.FeeCents, cancellation.DriverCompensationCents, tt.fee, tt.compensation)
+			}
+		})
+	}
+}
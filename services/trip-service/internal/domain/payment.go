@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	pb "go-ride/shared/proto/trip"
+	"time"
+)
+
+var (
+	ErrInvalidCard     = errors.New("invalid card")
+	ErrPaymentDeclined = errors.New("payment declined")
+	ErrPaymentTimeout  = errors.New("payment provider timed out")
+)
+
+type PaymentStatus string
+
+const (
+	PaymentAuthorized PaymentStatus = "AUTHORIZED"
+	PaymentCaptured   PaymentStatus = "CAPTURED"
+	PaymentVoided     PaymentStatus = "VOIDED"
+	PaymentRefunded   PaymentStatus = "REFUNDED"
+)
+
+// PaymentMethod is a card stored by a rider. Only the provider token is kept,
+// the card details never reach go-ride.
+type PaymentMethod struct {
+	ID          string
+	UserID      string
+	Token       string
+	Brand       string
+	Last4       string
+	Fingerprint string // the same for every token of the same card, across riders
+	CreatedAt   time.Time
+}
+
+func (m *PaymentMethod) ToProto() *pb.PaymentMethod {
+	return &pb.PaymentMethod{
+		Id:          m.ID,
+		Brand:       m.Brand,
+		Last4:       m.Last4,
+		Fingerprint: m.Fingerprint,
+		CreatedAt:   m.CreatedAt.UnixMilli(),
+	}
+}
+
+// CardDetails is what the provider tells about a tokenized card.
+type CardDetails struct {
+	Brand       string
+	Last4       string
+	Fingerprint string
+}
+
+// Payment is the money held for a trip, from the authorization at creation to its capture or void.
+// The wallet balance is spent first, only the rest of the fare is authorized on the card.
+type Payment struct {
+	TripID          string
+	UserID          string
+	PaymentMethodID string // empty when the wallet paid for the whole fare
+	AuthorizationID string
+	Status          PaymentStatus
+	// CardSettled is set once the card authorization was captured or voided, so a settlement
+	// retried after a later step failed never calls the provider again
+	CardSettled       bool
+	AmountCents       int64
+	WalletCents       int64
+	RefundedCents     int64
+	CardRefundedCents int64
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// CardCents is the part of the fare charged on the card.
+func (p *Payment) CardCents() int64 {
+	return p.AmountCents - p.WalletCents
+}
+
+func (p *Payment) ToProto() *pb.Payment {
+	return &pb.Payment{
+		TripID:          p.TripID,
+		PaymentMethodID: p.PaymentMethodID,
+		Status:          string(p.Status),
+		AmountCents:     p.AmountCents,
+		WalletCents:     p.WalletCents,
+		RefundedCents:   p.RefundedCents,
+	}
+}
+
+type TopUpStatus string
+
+const (
+	// TopUpPending holds the key while the card is charged, so a top-up is never charged twice
+	TopUpPending  TopUpStatus = "PENDING"
+	TopUpCaptured TopUpStatus = "CAPTURED"
+)
+
+// TopUp is the card charge of a wallet top-up, kept by its key until the wallet is credited.
+type TopUp struct {
+	UserID          string
+	Key             string
+	PaymentMethodID string
+	AuthorizationID string
+	Status          TopUpStatus
+	AmountCents     int64
+	CreatedAt       time.Time
+}
+
+// PaymentProvider is the gateway charging the riders. Authorizations are idempotent by key,
+// so a retried authorization never holds the amount twice.
+type PaymentProvider interface {
+	VerifyCard(ctx context.Context, token string) (*CardDetails, error)
+	Authorize(ctx context.Context, token string, amountCents int64, idempotencyKey string) (string, error)
+	Capture(ctx context.Context, authorizationID string, amountCents int64) error
+	Void(ctx context.Context, authorizationID string) error
+	Refund(ctx context.Context, authorizationID string, amountCents int64) error
+}
+
+type PaymentRepository interface {
+	SavePaymentMethod(ctx context.Context, method *PaymentMethod) error
+	GetPaymentMethod(ctx context.Context, userID, methodID string) (*PaymentMethod, error)
+	ListPaymentMethods(ctx context.Context, userID string) ([]*PaymentMethod, error)
+	DeletePaymentMethod(ctx context.Context, userID, methodID string) (bool, error)
+
+	SavePayment(ctx context.Context, payment *Payment) error
+	GetPayment(ctx context.Context, tripID string) (*Payment, error)
+	ListPaymentsByStatus(ctx context.Context, status PaymentStatus) ([]*Payment, error)
+	// UpdatePaymentStatus moves the payment to the status only if it is still in the expected one
+	UpdatePaymentStatus(ctx context.Context, tripID string, from, to PaymentStatus) (bool, error)
+	// MarkCardSettled records that the card of an AUTHORIZED payment was captured or voided
+	MarkCardSettled(ctx context.Context, tripID string) (bool, error)
+	// CapturePayment captures an AUTHORIZED payment for the given amounts, which may be lower than authorized
+	CapturePayment(ctx context.Context, tripID string, amountCents, walletCents int64) (bool, error)
+	// RecordRefund adds to the refunded amounts of a captured payment, never past the captured ones
+	RecordRefund(ctx context.Context, tripID string, amountCents, cardCents int64) (*Payment, error)
+
+	// CreateTopUp reports false when the key of the user was already used
+	CreateTopUp(ctx context.Context, topUp *TopUp) (bool, error)
+	GetTopUp(ctx context.Context, userID, key string) (*TopUp, error)
+	UpdateTopUp(ctx context.Context, topUp *TopUp) error
+	// DeleteTopUp frees the key of a top-up whose charge failed, so the rider can try again
+	DeleteTopUp(ctx context.Context, userID, key string) error
+}
+
+type PaymentService interface {
+	AddPaymentMethod(ctx context.Context, userID, token string) (*PaymentMethod, error)
+	ListPaymentMethods(ctx context.Context, userID string) ([]*PaymentMethod, error)
+	DeletePaymentMethod(ctx context.Context, userID, methodID string) error
+	AuthorizeTrip(ctx context.Context, trip *TripModel, methodID string) (*Payment, error)
+	CaptureTrip(ctx context.Context, tripID string) (*Payment, error)
+	VoidTrip(ctx context.Context, tripID string) (*Payment, error)
+	ChargeCancellation(ctx context.Context, tripID string, feeCents int64) (*Payment, error)
+	RefundTrip(ctx context.Context, tripID string, amountCents int64, toWallet bool) (*Payment, error)
+	TopUpWallet(ctx context.Context, userID, methodID string, amountCents int64, key string) (*JournalEntry, error)
+	ChargeTip(ctx context.Context, tip *Tip, methodID string) error
+}
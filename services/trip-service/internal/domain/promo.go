@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	pb "go-ride/shared/proto/trip"
+	"math"
+	"slices"
+	"time"
+)
+
+var (
+	ErrPromoExhausted     = errors.New("promo code has reached its usage limit")
+	ErrPromoUserLimit     = errors.New("promo code was already used the maximum number of times")
+	ErrPromoFirstRideOnly = errors.New("promo code is only valid on the first ride")
+)
+
+type DiscountType string
+
+const (
+	DiscountPercentage DiscountType = "PERCENTAGE"
+	DiscountFixed      DiscountType = "FIXED"
+)
+
+// PromoCode is a marketing discount on the fare. Zero limits mean no limit.
+type PromoCode struct {
+	Code             string
+	DiscountType     DiscountType
+	Value            int64 // percent for PERCENTAGE, cents for FIXED
+	MaxDiscountCents int64
+	MinFareCents     int64
+	Packages         []PackageSlug // every package when empty
+	MaxUsesPerUser   int
+	MaxUses          int
+	FirstRideOnly    bool
+	StartsAt         time.Time
+	EndsAt           time.Time
+	Active           bool
+	Redemptions      int
+	CreatedAt        time.Time
+}
+
+// Available reports whether the code can be used at the time, regardless of its usage.
+func (p *PromoCode) Available(now time.Time) bool {
+	if !p.Active {
+		return false
+	}
+	if !p.StartsAt.IsZero() && now.Before(p.StartsAt) {
+		return false
+	}
+	return p.EndsAt.IsZero() || now.Before(p.EndsAt)
+}
+
+// Discount returns the cents taken off the fare, zero when the fare does not qualify.
+func (p *PromoCode) Discount(fareCents float64, slug PackageSlug) int64 {
+	if fareCents < float64(p.MinFareCents) {
+		return 0
+	}
+	if len(p.Packages) > 0 && !slices.Contains(p.Packages, slug) {
+		return 0
+	}
+
+	var discount int64
+	switch p.DiscountType {
+	case DiscountPercentage:
+		discount = int64(math.Round(fareCents * float64(p.Value) / 100))
+	case DiscountFixed:
+		discount = p.Value
+	}
+
+	if p.MaxDiscountCents > 0 {
+		discount = min(discount, p.MaxDiscountCents)
+	}
+	return min(discount, int64(math.Floor(fareCents)))
+}
+
+func (p *PromoCode) ToProto() *pb.PromoCode {
+	packages := make([]pb.PackageSlug, len(p.Packages))
+	for i, slug := range p.Packages {
+		packages[i] = toProtoPackageSlug(slug)
+	}
+
+	return &pb.PromoCode{
+		Code:             p.Code,
+		DiscountType:     toProtoDiscountType(p.DiscountType),
+		Value:            p.Value,
+		MaxDiscountCents: p.MaxDiscountCents,
+		MinFareCents:     p.MinFareCents,
+		Packages:         packages,
+		MaxUsesPerUser:   int32(p.MaxUsesPerUser),
+		MaxUses:          int32(p.MaxUses),
+		FirstRideOnly:    p.FirstRideOnly,
+		StartsAt:         unixMilli(p.StartsAt),
+		EndsAt:           unixMilli(p.EndsAt),
+		Active:           p.Active,
+		Redemptions:      int32(p.Redemptions),
+		CreatedAt:        unixMilli(p.CreatedAt),
+	}
+}
+
+// PromoCodeFromProto reads the rules of a code to create, unknown packages and discount types are left empty.
+func PromoCodeFromProto(p *pb.PromoCode) *PromoCode {
+	packages := make([]PackageSlug, len(p.GetPackages()))
+	for i, slug := range p.GetPackages() {
+		packages[i] = FromProtoPackageSlug(slug)
+	}
+
+	promo := &PromoCode{
+		Code:             p.GetCode(),
+		Value:            p.GetValue(),
+		MaxDiscountCents: p.GetMaxDiscountCents(),
+		MinFareCents:     p.GetMinFareCents(),
+		Packages:         packages,
+		MaxUsesPerUser:   int(p.GetMaxUsesPerUser()),
+		MaxUses:          int(p.GetMaxUses()),
+		FirstRideOnly:    p.GetFirstRideOnly(),
+	}
+
+	switch p.GetDiscountType() {
+	case pb.DiscountType_PERCENTAGE:
+		promo.DiscountType = DiscountPercentage
+	case pb.DiscountType_FIXED:
+		promo.DiscountType = DiscountFixed
+	}
+	if p.GetStartsAt() != 0 {
+		promo.StartsAt = time.UnixMilli(p.GetStartsAt())
+	}
+	if p.GetEndsAt() != 0 {
+		promo.EndsAt = time.UnixMilli(p.GetEndsAt())
+	}
+
+	return promo
+}
+
+func toProtoDiscountType(t DiscountType) pb.DiscountType {
+	switch t {
+	case DiscountPercentage:
+		return pb.DiscountType_PERCENTAGE
+	case DiscountFixed:
+		return pb.DiscountType_FIXED
+	default:
+		return pb.DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+	}
+}
+
+// PromoRedemption is the use of a code on a trip.
+type PromoRedemption struct {
+	Code          string
+	UserID        string
+	TripID        string
+	DiscountCents int64
+	RedeemedAt    time.Time
+}
+
+type PromoRepository interface {
+	// CreatePromo reports false when a code with the same name exists
+	CreatePromo(ctx context.Context, promo *PromoCode) (bool, error)
+	GetPromo(ctx context.Context, code string) (*PromoCode, error)
+	ListPromos(ctx context.Context) ([]*PromoCode, error)
+	SetPromoActive(ctx context.Context, code string, active bool) (*PromoCode, error)
+	// CheckPromoUsage fails with the limit the user would exceed by redeeming the code on a new trip
+	CheckPromoUsage(ctx context.Context, promo *PromoCode, userID, tripID string) error
+	// RedeemPromo checks the usage limits and records the redemption at once, so concurrent trips cannot exceed them
+	RedeemPromo(ctx context.Context, promo *PromoCode, redemption *PromoRedemption) error
+	// ReleaseRedemption gives the use back when the trip did not happen, it reports false when there was none
+	ReleaseRedemption(ctx context.Context, tripID string) (bool, error)
+}
+
+type PromoService interface {
+	CreatePromo(ctx context.Context, promo *PromoCode) (*PromoCode, error)
+	DeactivatePromo(ctx context.Context, code string) (*PromoCode, error)
+	ListPromos(ctx context.Context) ([]*PromoCode, error)
+	ApplyToFares(ctx context.Context, code, userID string, fares []*RideFareModel) error
+	RedeemForTrip(ctx context.Context, trip *TripModel) error
+	ReleaseTrip(ctx context.Context, tripID string) error
+}
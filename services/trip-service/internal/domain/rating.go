@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	pb "go-ride/shared/proto/trip"
+	"time"
+)
+
+type RatingRole string
+
+const (
+	RatedByPassenger RatingRole = "passenger"
+	RatedByDriver    RatingRole = "driver"
+)
+
+// Tags each side can pick when rating the other
+var (
+	DriverRatingTags = []string{
+		"safe_driving", "clean_car", "friendly", "good_navigation", "on_time",
+		"unsafe_driving", "dirty_car", "rude", "wrong_route", "late",
+	}
+	PassengerRatingTags = []string{
+		"respectful", "on_time", "clean", "good_conversation",
+		"rude", "late", "messy", "no_show_at_pickup",
+	}
+)
+
+// Rating is what one side of a completed trip thought of the other.
+type Rating struct {
+	TripID    string
+	RaterID   string
+	RateeID   string
+	RaterRole RatingRole
+	Stars     int
+	Tags      []string
+	Comment   string
+	CreatedAt time.Time
+}
+
+func (r *Rating) ToProto() *pb.Rating {
+	return &pb.Rating{
+		TripID:    r.TripID,
+		RaterRole: string(r.RaterRole),
+		Stars:     int32(r.Stars),
+		Tags:      r.Tags,
+		Comment:   r.Comment,
+		CreatedAt: r.CreatedAt.UnixMilli(),
+	}
+}
+
+// RatingSummary is the rolling average of the latest ratings a user received.
+type RatingSummary struct {
+	Average float64
+	Count   int
+}
+
+func (s *RatingSummary) ToProto() *pb.RatingSummary {
+	return &pb.RatingSummary{
+		Average: s.Average,
+		Count:   int32(s.Count),
+	}
+}
+
+type RatingRepository interface {
+	// SaveRating reports false when the side already rated the trip
+	SaveRating(ctx context.Context, rating *Rating) (bool, error)
+	GetRating(ctx context.Context, tripID string, role RatingRole) (*Rating, error)
+	// GetRatingSummary averages the latest ratings received by the user, up to window
+	GetRatingSummary(ctx context.Context, userID string, window int) (*RatingSummary, error)
+}
+
+type RatingService interface {
+	RateTrip(ctx context.Context, tripID, userID string, stars int, tags []string, comment string) (*Rating, error)
+	GetRatingSummary(ctx context.Context, userID string) (*RatingSummary, error)
+}
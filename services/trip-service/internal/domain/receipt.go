@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"go-ride/shared/types"
+	"time"
+)
+
+type ReceiptFormat string
+
+const (
+	ReceiptHTML ReceiptFormat = "html"
+	ReceiptPDF  ReceiptFormat = "pdf"
+)
+
+func (f ReceiptFormat) ContentType() string {
+	if f == ReceiptPDF {
+		return "application/pdf"
+	}
+	return "text/html; charset=utf-8"
+}
+
+// Receipt is issued to the passenger when the trip is completed, and issued again when it is tipped.
+// Amounts are in cents, TotalCents is what the passenger paid in the end.
+type Receipt struct {
+	Number          string
+	TripID          string
+	PassengerID     string
+	DriverName      string
+	CarPlate        string
+	PackageSlug     PackageSlug
+	Pickup          *types.Coordinate
+	Destination     *types.Coordinate
+	Route           [][]float64 // the stored geometry, as [longitude, latitude] pairs
+	DistanceMeters  float64
+	DurationSeconds float64
+	FareCents       int64 // before the discount
+	DiscountCents   int64
+	PromoCode       string
+	TipCents        int64
+	RefundedCents   int64
+	TotalCents      int64
+	WalletCents     int64
+	PaymentMethod   string // e.g. "visa ending in 4242", empty when the wallet paid for everything
+	CompletedAt     time.Time
+	IssuedAt        time.Time
+}
+
+type ReceiptRepository interface {
+	SaveReceipt(ctx context.Context, receipt *Receipt) error
+	GetReceipt(ctx context.Context, tripID string) (*Receipt, error)
+}
+
+// ReceiptRenderer turns a receipt into a document the passenger can download or get by email.
+type ReceiptRenderer interface {
+	Render(receipt *Receipt, format ReceiptFormat) ([]byte, error)
+}
+
+type ReceiptService interface {
+	IssueReceipt(ctx context.Context, tripID string) (*Receipt, error)
+	RenderReceipt(ctx context.Context, tripID, userID string, format ReceiptFormat) ([]byte, error)
+}
@@ -5,6 +5,7 @@ import (
 
 	tripTypes "go-ride/services/trip-service/pkg/types"
 	pb "go-ride/shared/proto/trip"
+	"go-ride/shared/types"
 
 	"github.com/google/uuid"
 )
@@ -23,14 +24,39 @@ type RideFareModel struct {
 	TotalPriceInCents float64
 	ExpiresAt         time.Time
 	Route             *tripTypes.OSRMApiResponse
+	Pickup            *types.Coordinate
+	Destination       *types.Coordinate
+	PickupETA         *time.Duration // nil when no driver of the package is around
+	PromoCode         string
+	DiscountCents     int64 // already taken off TotalPriceInCents
 }
 
 func (r *RideFareModel) ToProto() *pb.RideFare {
-	return &pb.RideFare{
+	fare := &pb.RideFare{
 		Id:                r.ID.String(),
 		PassengerID:       r.PassengerID,
 		PackageSlug:       toProtoPackageSlug(r.PackageSlug),
 		TotalPriceInCents: r.TotalPriceInCents,
+		PromoCode:         r.PromoCode,
+		DiscountCents:     r.DiscountCents,
+	}
+
+	if r.PickupETA != nil {
+		seconds := int64(r.PickupETA.Seconds())
+		fare.PickupEtaSeconds = &seconds
+	}
+
+	return fare
+}
+
+func toProtoCoordinate(c *types.Coordinate) *pb.Coordinate {
+	if c == nil {
+		return nil
+	}
+
+	return &pb.Coordinate{
+		Latitude:  c.Latitude,
+		Longitude: c.Longitude,
 	}
 }
 
@@ -45,6 +71,18 @@ func toProtoPackageSlug(s PackageSlug) pb.PackageSlug {
 	}
 }
 
+// FromProtoPackageSlug returns an empty slug for an unknown package.
+func FromProtoPackageSlug(s pb.PackageSlug) PackageSlug {
+	switch s {
+	case pb.PackageSlug_UBERX:
+		return UBERX
+	case pb.PackageSlug_BLACK:
+		return BLACK
+	default:
+		return ""
+	}
+}
+
 func ToRideFaresProto(fares []*RideFareModel) []*pb.RideFare {
 	var protoFares []*pb.RideFare
 	for _, fare := range fares {
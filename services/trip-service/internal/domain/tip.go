@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	pb "go-ride/shared/proto/trip"
+	"time"
+)
+
+type TipStatus string
+
+const (
+	// TipPending holds the trip while the card is charged, so the tip is never charged twice
+	TipPending  TipStatus = "PENDING"
+	TipCaptured TipStatus = "CAPTURED"
+)
+
+// Tip is paid by the passenger on top of the fare of a completed trip, the driver gets all of it.
+type Tip struct {
+	ID              string
+	TripID          string
+	UserID          string
+	DriverID        string
+	PaymentMethodID string
+	AuthorizationID string
+	Status          TipStatus
+	AmountCents     int64
+	CreatedAt       time.Time
+}
+
+func (t *Tip) ToProto() *pb.Tip {
+	return &pb.Tip{
+		TripID:          t.TripID,
+		DriverID:        t.DriverID,
+		PaymentMethodID: t.PaymentMethodID,
+		AmountCents:     t.AmountCents,
+		CreatedAt:       t.CreatedAt.UnixMilli(),
+	}
+}
+
+type TipRepository interface {
+	// CreateTip reports false when the trip was already tipped
+	CreateTip(ctx context.Context, tip *Tip) (bool, error)
+	GetTip(ctx context.Context, tripID string) (*Tip, error)
+	UpdateTip(ctx context.Context, tip *Tip) error
+	// DeleteTip frees the trip of a tip whose charge failed, so the passenger can try again
+	DeleteTip(ctx context.Context, tripID string) error
+}
+
+type TipService interface {
+	AddTip(ctx context.Context, tripID, userID string, amountCents int64, methodID string) (*Tip, error)
+}
@@ -5,6 +5,7 @@ import (
 	tripTypes "go-ride/services/trip-service/pkg/types"
 	pb "go-ride/shared/proto/trip"
 	"go-ride/shared/types"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -20,41 +21,105 @@ const (
 )
 
 type TripModel struct {
-	ID          uuid.UUID
-	PassengerID uuid.UUID
-	Status      TripStatus
-	RideFare    *RideFareModel
-	Driver      *pb.TripDriver // Realmente eu devo usar o proto aqui para tipar o driver?
+	ID           uuid.UUID
+	PassengerID  uuid.UUID
+	Status       TripStatus
+	RideFare     *RideFareModel
+	Driver       *pb.TripDriver // Realmente eu devo usar o proto aqui para tipar o driver?
+	AcceptedAt   time.Time
+	ArrivedAt    time.Time // zero until the driver is at the pickup
+	CompletedAt  time.Time
+	Cancellation *Cancellation
+	// MatchTimeoutToken cancels the scheduled no drivers found event once a driver accepts
+	MatchTimeoutToken string
 }
 
 func (t *TripModel) ToProto() *pb.Trip {
-	return &pb.Trip{
+	trip := &pb.Trip{
 		Id:           t.ID.String(),
 		UserId:       t.PassengerID.String(),
 		SelectedFare: t.RideFare.ToProto(),
 		Status:       string(t.Status),
 		Driver:       t.Driver,
 		Route:        t.RideFare.Route.ToProto(),
+		Pickup:       toProtoCoordinate(t.RideFare.Pickup),
+		Destination:  toProtoCoordinate(t.RideFare.Destination),
+		AcceptedAt:   unixMilli(t.AcceptedAt),
+		ArrivedAt:    unixMilli(t.ArrivedAt),
+		CompletedAt:  unixMilli(t.CompletedAt),
 	}
+
+	if t.Cancellation != nil {
+		trip.Cancellation = t.Cancellation.ToProto()
+	}
+
+	return trip
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
 }
 
 type TripRepository interface {
 	CreateTrip(ctx context.Context, trip *TripModel) (*TripModel, error)
 	SaveRideFare(ctx context.Context, fare *RideFareModel) error
 	GetRideFareByID(ctx context.Context, fareID string) (*RideFareModel, error)
-	// GetTripByID(ctx context.Context, tripID string) (*TripModel, error)
-	// UpdateTrip(ctx context.Context, tripID, status string, driver *pbd.Driver) error
+	GetTripByID(ctx context.Context, tripID string) (*TripModel, error)
+	// AssignDriver accepts the trip for the driver, only if it is still REQUESTED
+	AssignDriver(ctx context.Context, tripID string, driver *pb.TripDriver, acceptedAt time.Time) (bool, error)
+	// UpdateTripStatus moves the trip to the status only if it is still in the expected one
+	UpdateTripStatus(ctx context.Context, tripID string, from, to TripStatus) (bool, error)
+	// CompleteTrip moves an IN_PROGRESS trip to COMPLETED
+	CompleteTrip(ctx context.Context, tripID string, completedAt time.Time) (bool, error)
+	// MarkArrived records the arrival of the driver at the pickup, only once and while the trip is ACCEPTED
+	MarkArrived(ctx context.Context, tripID string, arrivedAt time.Time) (bool, error)
+	// CancelTrip cancels the trip only if it is still in the expected status
+	CancelTrip(ctx context.Context, tripID string, from TripStatus, cancellation *Cancellation) (bool, error)
+	// WaiveCancellationFee clears the fee and the driver compensation of a CANCELED trip
+	WaiveCancellationFee(ctx context.Context, tripID string) error
+	SetMatchTimeoutToken(ctx context.Context, tripID, token string) error
 }
 
 type TripService interface {
 	CreateTrip(ctx context.Context, fare *RideFareModel) (*TripModel, error)
 	EstimatePackagesPriceWithRoute(route *tripTypes.OSRMApiResponse) []*RideFareModel
-	GenerateTripFares(ctx context.Context, fares []*RideFareModel, userID string, route *tripTypes.OSRMApiResponse) ([]*RideFareModel, error)
+	GenerateTripFares(ctx context.Context, fares []*RideFareModel, userID string, route *tripTypes.OSRMApiResponse, pickup, destination *types.Coordinate) ([]*RideFareModel, error)
 	GetAndValidateFare(ctx context.Context, fareID, userID string) (*RideFareModel, error)
-	// GetTripByID(ctx context.Context, tripID string) (*TripModel, error)
-	// UpdateTrip(ctx context.Context, tripID, status string, driver *pbd.Driver) error
+	GetTripByID(ctx context.Context, tripID string) (*TripModel, error)
+	AssignDriver(ctx context.Context, tripID string, driver *pb.TripDriver) error
+	StartTrip(ctx context.Context, tripID, driverID string) (*TripModel, error)
+	CompleteTrip(ctx context.Context, tripID, driverID string) (*TripModel, error)
+	ArriveAtPickup(ctx context.Context, tripID, driverID string) (*TripModel, error)
+	CancelTrip(ctx context.Context, tripID, userID string, by CanceledBy) (*TripModel, error)
+	CancelRequestedTrip(ctx context.Context, tripID, reason string) (*TripModel, error)
+	WaiveCancellationFee(ctx context.Context, trip *TripModel) error
+	SetMatchTimeoutToken(ctx context.Context, tripID, token string) error
+}
+
+// DriverLocationUpdate is the position of the driver of a trip with the estimated time to its target.
+type DriverLocationUpdate struct {
+	Trip     *TripModel
+	Location *types.Coordinate
+	Target   string // "pickup" or "destination"
+	ETA      time.Duration
+}
+
+// TripTracker follows the driver of an ongoing trip for its passenger.
+type TripTracker interface {
+	TrackDriverLocation(ctx context.Context, tripID string, location *types.Coordinate) (*DriverLocationUpdate, error)
 }
 
 type OSRMService interface {
 	GetRoute(ctx context.Context, pickup, destination *types.Coordinate) (*tripTypes.OSRMApiResponse, error)
+	GetDurations(ctx context.Context, sources []*types.Coordinate, destination *types.Coordinate) ([]*float64, error)
+}
+
+// ETACalculator estimates how long until a car arrives at the pickup.
+// A nil ETA means no driver is around.
+type ETACalculator interface {
+	PickupETA(ctx context.Context, pickup *types.Coordinate, slug PackageSlug) (*time.Duration, error)
+	DriverETA(ctx context.Context, driverID string, pickup *types.Coordinate) (*time.Duration, error)
 }
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	pb "go-ride/shared/proto/trip"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnbalancedJournal   = errors.New("journal entry does not balance")
+	ErrInsufficientBalance = errors.New("insufficient wallet balance")
+)
+
+// Platform accounts are the counterpart of the rider wallets, they go negative as they fund them.
+const (
+	AccountTopUps      = "platform:top_ups"
+	AccountCredits     = "platform:credits"
+	AccountRefunds     = "platform:refunds"
+	AccountWalletHolds = "platform:wallet_holds"
+	AccountTripRevenue = "platform:trip_revenue"
+
+	walletAccountPrefix = "wallet:"
+)
+
+// WalletAccount is the account holding the in-app balance of a rider.
+func WalletAccount(userID string) string {
+	return walletAccountPrefix + userID
+}
+
+// IsWalletAccount reports whether the account belongs to a rider, those can never go negative.
+func IsWalletAccount(account string) bool {
+	return strings.HasPrefix(account, walletAccountPrefix)
+}
+
+type JournalKind string
+
+const (
+	JournalTopUp       JournalKind = "TOP_UP"
+	JournalCredit      JournalKind = "CREDIT"
+	JournalRefund      JournalKind = "REFUND"
+	JournalTripHold    JournalKind = "TRIP_HOLD"
+	JournalTripRelease JournalKind = "TRIP_RELEASE"
+	JournalTripSettle  JournalKind = "TRIP_SETTLE"
+)
+
+// Posting moves cents in or out of an account, the postings of a journal entry sum to zero.
+type Posting struct {
+	Account     string
+	AmountCents int64
+}
+
+// JournalEntry is an immutable record of money moving between accounts.
+// The key makes posting idempotent, an entry with a key already posted is never applied twice.
+type JournalEntry struct {
+	ID          string
+	Sequence    int64
+	Key         string
+	Kind        JournalKind
+	Description string
+	Postings    []Posting
+	CreatedAt   time.Time
+}
+
+// Balanced reports whether the postings of the entry sum to zero.
+func (e *JournalEntry) Balanced() bool {
+	var sum int64
+	for _, posting := range e.Postings {
+		sum += posting.AmountCents
+	}
+	return sum == 0
+}
+
+func (e *JournalEntry) ToProto() *pb.JournalEntry {
+	entry := &pb.JournalEntry{
+		Id:          e.ID,
+		Sequence:    e.Sequence,
+		Key:         e.Key,
+		Kind:        string(e.Kind),
+		Description: e.Description,
+		Postings:    make([]*pb.Posting, len(e.Postings)),
+		CreatedAt:   e.CreatedAt.UnixMilli(),
+	}
+	for i, posting := range e.Postings {
+		entry.Postings[i] = &pb.Posting{
+			Account:     posting.Account,
+			AmountCents: posting.AmountCents,
+		}
+	}
+	return entry
+}
+
+type LedgerRepository interface {
+	// PostJournal appends the entry and reports true, or returns the entry already posted with its key and false.
+	// It fails with ErrInsufficientBalance when a wallet account would go negative.
+	PostJournal(ctx context.Context, entry *JournalEntry) (*JournalEntry, bool, error)
+	GetJournal(ctx context.Context, key string) (*JournalEntry, error)
+	GetBalance(ctx context.Context, account string) (int64, error)
+	// ListJournals returns the entries posted after the sequence, oldest first
+	ListJournals(ctx context.Context, afterSequence int64, limit int) ([]*JournalEntry, error)
+	// ListAccountJournals returns the latest entries touching the account, newest first
+	ListAccountJournals(ctx context.Context, account string, limit int) ([]*JournalEntry, error)
+}
+
+// WalletService keeps the in-app balance of the riders, spent before charging their card.
+type WalletService interface {
+	GetBalance(ctx context.Context, userID string) (int64, error)
+	ListEntries(ctx context.Context, userID string, limit int) ([]*JournalEntry, error)
+	ListJournals(ctx context.Context, afterSequence int64, limit int) ([]*JournalEntry, error)
+	Credit(ctx context.Context, userID string, amountCents int64, key, description string) (*JournalEntry, error)
+	TopUp(ctx context.Context, userID string, amountCents int64, key string) (*JournalEntry, error)
+	FindTopUp(ctx context.Context, userID, key string) (*JournalEntry, error)
+	Refund(ctx context.Context, userID, tripID string, amountCents int64, key string) (*JournalEntry, error)
+	HoldForTrip(ctx context.Context, userID, tripID string, maxCents int64) (int64, error)
+	ReleaseTrip(ctx context.Context, userID, tripID string, amountCents int64) error
+	SettleTrip(ctx context.Context, tripID string, amountCents int64) error
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"go-ride/services/trip-service/internal/service"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+	"log"
+)
+
+type TripEventConsumer struct {
+	bus            messaging.MessageBus
+	tripService    domain.TripService
+	paymentService domain.PaymentService
+	promoService   domain.PromoService
+	tracker        domain.TripTracker
+	etaCalc        domain.ETACalculator
+	publisher      *TripEventPublisher
+}
+
+func NewTripEventConsumer(
+	bus messaging.MessageBus,
+	tripService domain.TripService,
+	paymentService domain.PaymentService,
+	promoService domain.PromoService,
+	tracker domain.TripTracker,
+	etaCalc domain.ETACalculator,
+	publisher *TripEventPublisher,
+) *TripEventConsumer {
+	return &TripEventConsumer{
+		bus:            bus,
+		tripService:    tripService,
+		paymentService: paymentService,
+		promoService:   promoService,
+		tracker:        tracker,
+		etaCalc:        etaCalc,
+		publisher:      publisher,
+	}
+}
+
+// Listen starts consuming the queues trip-service is responsible for.
+func (c *TripEventConsumer) Listen(ctx context.Context) error {
+	if err := c.bus.ConsumeMessages(ctx, messaging.TripStatusUpdatesQueue, c.handleTripStatusUpdate); err != nil {
+		return err
+	}
+
+	return c.bus.ConsumeMessages(ctx, messaging.TripDriverLocationsQueue, c.handleDriverLocation)
+}
+
+func (c *TripEventConsumer) handleTripStatusUpdate(ctx context.Context, routingKey string, message contracts.AmqpMessage) error {
+	var payload messaging.TripEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip event: %v", err)
+	}
+
+	switch routingKey {
+	case contracts.TripEventDriverAssigned:
+		return c.handleDriverAssigned(ctx, payload)
+	case contracts.TripEventNoDriversFound:
+		return c.handleNoDriversFound(ctx, payload)
+	}
+
+	return nil
+}
+
+func (c *TripEventConsumer) handleDriverAssigned(ctx context.Context, payload messaging.TripEventData) error {
+	tripID := payload.Trip.GetId()
+	driverID := payload.Trip.GetDriver().GetId()
+
+	if err := c.tripService.AssignDriver(ctx, tripID, payload.Trip.GetDriver()); err != nil {
+		if !errors.Is(err, service.ErrInvalidTripStatus) {
+			return err
+		}
+		return c.releaseDriver(ctx, tripID, payload)
+	}
+	log.Printf("trip %s accepted by driver %s", tripID, driverID)
+
+	trip, err := c.tripService.GetTripByID(ctx, tripID)
+	if err != nil {
+		return err
+	}
+
+	if trip.MatchTimeoutToken != "" {
+		if err := c.publisher.CancelMatchTimeout(ctx, trip.MatchTimeoutToken); err != nil {
+			log.Printf("failed to cancel the match timeout of trip %s: %v", tripID, err)
+		}
+	}
+
+	// The passenger is notified even when the arrival cannot be estimated
+	driverETA, err := c.etaCalc.DriverETA(ctx, driverID, trip.RideFare.Pickup)
+	if err != nil {
+		log.Printf("failed to estimate the arrival of driver %s: %v", driverID, err)
+	}
+
+	return c.publisher.PublishTripAccepted(ctx, trip, driverETA)
+}
+
+// releaseDriver lets driver-service know the trip was canceled before the driver it found could accept it.
+func (c *TripEventConsumer) releaseDriver(ctx context.Context, tripID string, payload messaging.TripEventData) error {
+	trip, err := c.tripService.GetTripByID(ctx, tripID)
+	if err != nil {
+		return err
+	}
+	if trip.Status != domain.CANCELED {
+		return nil
+	}
+
+	log.Printf("trip %s was canceled before driver %s accepted it", tripID, payload.Trip.GetDriver().GetId())
+	trip.Driver = payload.Trip.GetDriver()
+	return c.publisher.PublishTripCancelled(ctx, trip)
+}
+
+func (c *TripEventConsumer) handleNoDriversFound(ctx context.Context, payload messaging.TripEventData) error {
+	tripID := payload.Trip.GetId()
+
+	if _, err := c.tripService.CancelRequestedTrip(ctx, tripID, domain.CancelReasonNoDrivers); err != nil {
+		if !errors.Is(err, service.ErrInvalidTripStatus) {
+			return err
+		}
+
+		// A redelivered event finds the trip already canceled, a match timeout whose
+		// cancellation was missed finds it accepted and must leave its payment alone
+		trip, err := c.tripService.GetTripByID(ctx, tripID)
+		if err != nil {
+			return err
+		}
+		if trip.Status != domain.CANCELED {
+			return nil
+		}
+	}
+
+	if err := c.promoService.ReleaseTrip(ctx, tripID); err != nil {
+		return err
+	}
+
+	if _, err := c.paymentService.VoidTrip(ctx, tripID); err != nil {
+		if errors.Is(err, service.ErrInvalidPaymentStatus) || errors.Is(err, service.ErrPaymentNotFound) {
+			return nil
+		}
+		return err
+	}
+	log.Printf("trip %s canceled, no drivers found", tripID)
+
+	return nil
+}
+
+func (c *TripEventConsumer) handleDriverLocation(ctx context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.DriverLocationEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal driver location: %v", err)
+	}
+
+	update, err := c.tracker.TrackDriverLocation(ctx, payload.TripID, payload.Location)
+	if err != nil {
+		// Locations are superseded every few seconds, a lost one is not worth a retry
+		log.Printf("failed to track driver %s on trip %s: %v", payload.DriverID, payload.TripID, err)
+		return nil
+	}
+
+	if update == nil {
+		return nil
+	}
+
+	return c.publisher.PublishDriverLocation(ctx, update, payload.Heading, payload.Timestamp)
+}
@@ -6,15 +6,16 @@ import (
 	"go-ride/services/trip-service/internal/domain"
 	"go-ride/shared/contracts"
 	"go-ride/shared/messaging"
+	"time"
 )
 
 type TripEventPublisher struct {
-	rabbitmq *messaging.RabbitMQ
+	bus messaging.MessageBus
 }
 
-func NewTripEventPublisher(rabbitmq *messaging.RabbitMQ) *TripEventPublisher {
+func NewTripEventPublisher(bus messaging.MessageBus) *TripEventPublisher {
 	return &TripEventPublisher{
-		rabbitmq: rabbitmq,
+		bus: bus,
 	}
 }
 
@@ -28,7 +29,158 @@ func (p *TripEventPublisher) PublishTripCreated(ctx context.Context, trip *domai
 		return err
 	}
 
-	return p.rabbitmq.PublishMessage(ctx, contracts.TripEventCreated, contracts.AmqpMessage{
+	return p.bus.PublishMessage(ctx, contracts.TripEventCreated, contracts.AmqpMessage{
+		OwnerID: trip.PassengerID.String(),
+		Data:    tripEventJSON,
+	})
+}
+
+// PublishTripStarted notifies the passenger and driver-service that the trip is in progress.
+func (p *TripEventPublisher) PublishTripStarted(ctx context.Context, trip *domain.TripModel) error {
+	return p.publishTrip(ctx, contracts.TripEventStarted, trip)
+}
+
+// PublishTripCompleted notifies the passenger and driver-service, which records the driver earnings.
+func (p *TripEventPublisher) PublishTripCompleted(ctx context.Context, trip *domain.TripModel) error {
+	return p.publishTrip(ctx, contracts.TripEventCompleted, trip)
+}
+
+// PublishDriverArrived tells the passenger the driver is waiting at the pickup.
+func (p *TripEventPublisher) PublishDriverArrived(ctx context.Context, trip *domain.TripModel) error {
+	return p.publishTrip(ctx, contracts.TripEventDriverArrived, trip)
+}
+
+// PublishTripCancelled notifies the passenger, the driver and driver-service, which pays the driver its share of the fee.
+func (p *TripEventPublisher) PublishTripCancelled(ctx context.Context, trip *domain.TripModel) error {
+	return p.publishTrip(ctx, contracts.TripEventCancelled, trip)
+}
+
+// PublishTripRated tells driver-service about the rating, so it updates the average used by matching.
+func (p *TripEventPublisher) PublishTripRated(ctx context.Context, rating *domain.Rating) error {
+	payload, err := json.Marshal(messaging.TripRatedEventData{
+		TripID:    rating.TripID,
+		RateeID:   rating.RateeID,
+		RaterRole: string(rating.RaterRole),
+		Stars:     rating.Stars,
+		Timestamp: rating.CreatedAt.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, contracts.TripEventRated, contracts.AmqpMessage{
+		OwnerID: rating.RateeID,
+		Data:    payload,
+	})
+}
+
+// PublishTripTipped tells driver-service to credit the tip to the driver, and the gateway to notify it.
+func (p *TripEventPublisher) PublishTripTipped(ctx context.Context, tip *domain.Tip) error {
+	payload, err := json.Marshal(messaging.TripTippedEventData{
+		TripID:      tip.TripID,
+		DriverID:    tip.DriverID,
+		AmountCents: tip.AmountCents,
+		Timestamp:   tip.CreatedAt.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, contracts.TripEventTipped, contracts.AmqpMessage{
+		OwnerID: tip.DriverID,
+		Data:    payload,
+	})
+}
+
+// PublishReceiptIssued tells user-service to email the receipt to the passenger.
+func (p *TripEventPublisher) PublishReceiptIssued(ctx context.Context, receipt *domain.Receipt) error {
+	payload, err := json.Marshal(messaging.ReceiptIssuedEventData{
+		TripID:      receipt.TripID,
+		PassengerID: receipt.PassengerID,
+		Number:      receipt.Number,
+		Timestamp:   receipt.IssuedAt.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, contracts.TripEventReceiptIssued, contracts.AmqpMessage{
+		OwnerID: receipt.PassengerID,
+		Data:    payload,
+	})
+}
+
+// ScheduleMatchTimeout publishes the no drivers found event of the trip once the timeout elapsed,
+// the trip is canceled if no driver accepted it by then. The returned token cancels it.
+func (p *TripEventPublisher) ScheduleMatchTimeout(ctx context.Context, trip *domain.TripModel, timeout time.Duration) (string, error) {
+	tripEventJSON, err := json.Marshal(messaging.TripEventData{
+		Trip: trip.ToProto(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return p.bus.PublishDelayed(ctx, contracts.TripEventNoDriversFound, contracts.AmqpMessage{
+		OwnerID: trip.PassengerID.String(),
+		Data:    tripEventJSON,
+	}, timeout)
+}
+
+// CancelMatchTimeout drops the scheduled no drivers found event of a trip that was accepted.
+func (p *TripEventPublisher) CancelMatchTimeout(ctx context.Context, token string) error {
+	return p.bus.CancelScheduled(ctx, token)
+}
+
+func (p *TripEventPublisher) publishTrip(ctx context.Context, routingKey string, trip *domain.TripModel) error {
+	tripEventJSON, err := json.Marshal(messaging.TripEventData{
+		Trip: trip.ToProto(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, routingKey, contracts.AmqpMessage{
+		OwnerID: trip.PassengerID.String(),
+		Data:    tripEventJSON,
+	})
+}
+
+func (p *TripEventPublisher) PublishDriverLocation(ctx context.Context, update *domain.DriverLocationUpdate, heading float64, timestamp int64) error {
+	payload, err := json.Marshal(messaging.TripDriverLocationData{
+		TripID:     update.Trip.ID.String(),
+		Location:   update.Location,
+		Heading:    heading,
+		Target:     update.Target,
+		ETASeconds: int64(update.ETA.Seconds()),
+		Timestamp:  timestamp,
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, contracts.TripEventDriverLocation, contracts.AmqpMessage{
+		OwnerID: update.Trip.PassengerID.String(),
+		Data:    payload,
+	})
+}
+
+// PublishTripAccepted notifies the passenger of the assigned driver and its arrival estimate.
+func (p *TripEventPublisher) PublishTripAccepted(ctx context.Context, trip *domain.TripModel, driverETA *time.Duration) error {
+	payload := messaging.TripEventData{
+		Trip: trip.ToProto(),
+	}
+
+	if driverETA != nil {
+		seconds := int64(driverETA.Seconds())
+		payload.DriverETASeconds = &seconds
+	}
+
+	tripEventJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return p.bus.PublishMessage(ctx, contracts.TripEventAccepted, contracts.AmqpMessage{
 		OwnerID: trip.PassengerID.String(),
 		Data:    tripEventJSON,
 	})
@@ -0,0 +1,115 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"go-ride/services/trip-service/internal/domain"
+	tripTypes "go-ride/services/trip-service/pkg/types"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+	"go-ride/shared/messaging/messagingtest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const receiveTimeout = time.Second
+
+func TestPublishTripCompletedReachesEveryConsumer(t *testing.T) {
+	h := messagingtest.NewHarness(t)
+	publisher := NewTripEventPublisher(h.Bus)
+
+	// driver-service frees the driver, the gateway notifies the passenger and user-service rewards referrals
+	consumers := []*messagingtest.Recorder{
+		h.Record(messaging.DriverStatusUpdatesQueue),
+		h.Record(messaging.NotifyTripStatusQueue),
+		h.Record(messaging.ReferralTripCompletedQueue),
+	}
+
+	trip := newTestTrip(t)
+	if err := publisher.PublishTripCompleted(context.Background(), trip); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, consumer := range consumers {
+		delivery := consumer.Next(receiveTimeout)
+		if delivery.RoutingKey != contracts.TripEventCompleted {
+			t.Errorf("routing key = %s, want %s", delivery.RoutingKey, contracts.TripEventCompleted)
+		}
+		if delivery.Message.OwnerID != trip.PassengerID.String() {
+			t.Errorf("owner = %s, want the passenger %s", delivery.Message.OwnerID, trip.PassengerID)
+		}
+
+		var payload messaging.TripEventData
+		if err := json.Unmarshal(delivery.Message.Data, &payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Trip.GetId() != trip.ID.String() {
+			t.Errorf("trip = %s, want %s", payload.Trip.GetId(), trip.ID)
+		}
+	}
+}
+
+func TestCancelMatchTimeoutDropsTheNoDriversFoundEvent(t *testing.T) {
+	h := messagingtest.NewHarness(t)
+	publisher := NewTripEventPublisher(h.Bus)
+
+	// trip-service cancels the trip and the gateway tells the passenger
+	tripStatus := h.Record(messaging.TripStatusUpdatesQueue)
+	notifications := h.Record(messaging.NotifyNoDriversFoundQueue)
+
+	accepted := newTestTrip(t)
+	token, err := publisher.ScheduleMatchTimeout(context.Background(), accepted, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := publisher.CancelMatchTimeout(context.Background(), token); err != nil {
+		t.Fatal(err)
+	}
+
+	unmatched := newTestTrip(t)
+	if _, err := publisher.ScheduleMatchTimeout(context.Background(), unmatched, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, consumer := range []*messagingtest.Recorder{tripStatus, notifications} {
+		delivery := consumer.Next(receiveTimeout)
+		if delivery.RoutingKey != contracts.TripEventNoDriversFound {
+			t.Errorf("routing key = %s, want %s", delivery.RoutingKey, contracts.TripEventNoDriversFound)
+		}
+
+		var payload messaging.TripEventData
+		if err := json.Unmarshal(delivery.Message.Data, &payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Trip.GetId() != unmatched.ID.String() {
+			t.Errorf("timed out trip = %s, want %s", payload.Trip.GetId(), unmatched.ID)
+		}
+		consumer.ExpectNone(50 * time.Millisecond)
+	}
+}
+
+func newTestTrip(t *testing.T) *domain.TripModel {
+	t.Helper()
+
+	var route tripTypes.OSRMApiResponse
+	err := json.Unmarshal([]byte(`{"routes":[{"distance":1200,"duration":300,"geometry":{"coordinates":[[-46.63,-23.55],[-46.64,-23.56]]}}]}`), &route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	passengerID := uuid.New()
+	return &domain.TripModel{
+		ID:          uuid.New(),
+		PassengerID: passengerID,
+		Status:      domain.COMPLETED,
+		RideFare: &domain.RideFareModel{
+			ID:                uuid.New(),
+			PassengerID:       passengerID.String(),
+			PackageSlug:       domain.UBERX,
+			TotalPriceInCents: 1850,
+			Route:             &route,
+		},
+	}
+}
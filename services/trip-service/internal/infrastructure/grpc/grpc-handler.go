@@ -2,11 +2,14 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"go-ride/services/trip-service/internal/domain"
 	"go-ride/services/trip-service/internal/events"
+	"go-ride/services/trip-service/internal/service"
 	pb "go-ride/shared/proto/trip"
 	"go-ride/shared/types"
 	"log"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -15,16 +18,33 @@ import (
 
 type gRPCHandler struct {
 	pb.UnimplementedTripServiceServer
-	tripService domain.TripService
-	OSRMService domain.OSRMService
-	publisher   *events.TripEventPublisher
+	tripService    domain.TripService
+	paymentService domain.PaymentService
+	walletService  domain.WalletService
+	promoService   domain.PromoService
+	ratingService  domain.RatingService
+	tipService     domain.TipService
+	receiptService domain.ReceiptService
+	OSRMService    domain.OSRMService
+	etaCalc        domain.ETACalculator
+	publisher      *events.TripEventPublisher
+	// matchTimeout is how long a trip waits for a driver before it is canceled
+	matchTimeout time.Duration
 }
 
-func NewGRPCHandler(server *grpc.Server, tripService domain.TripService, OSRMService domain.OSRMService, publisher *events.TripEventPublisher) *gRPCHandler {
+func NewGRPCHandler(server *grpc.Server, tripService domain.TripService, paymentService domain.PaymentService, walletService domain.WalletService, promoService domain.PromoService, ratingService domain.RatingService, tipService domain.TipService, receiptService domain.ReceiptService, OSRMService domain.OSRMService, etaCalc domain.ETACalculator, publisher *events.TripEventPublisher, matchTimeout time.Duration) *gRPCHandler {
 	handler := &gRPCHandler{
-		tripService: tripService,
-		OSRMService: OSRMService,
-		publisher:   publisher,
+		tripService:    tripService,
+		paymentService: paymentService,
+		walletService:  walletService,
+		promoService:   promoService,
+		ratingService:  ratingService,
+		tipService:     tipService,
+		receiptService: receiptService,
+		OSRMService:    OSRMService,
+		etaCalc:        etaCalc,
+		publisher:      publisher,
+		matchTimeout:   matchTimeout,
 	}
 
 	pb.RegisterTripServiceServer(server, handler)
@@ -52,7 +72,21 @@ func (h *gRPCHandler) PreviewTrip(ctx context.Context, req *pb.PreviewTripReques
 	}
 
 	estimatedFares := h.tripService.EstimatePackagesPriceWithRoute(route)
-	fares, err := h.tripService.GenerateTripFares(ctx, estimatedFares, req.PassengerID, route)
+	for _, fare := range estimatedFares {
+		// The preview is still useful without an ETA, so failures are only logged
+		fare.PickupETA, err = h.etaCalc.PickupETA(ctx, pickupCoord, fare.PackageSlug)
+		if err != nil {
+			log.Printf("failed to estimate the pickup ETA of %s: %v", fare.PackageSlug, err)
+		}
+	}
+
+	if code := req.GetPromoCode(); code != "" {
+		if err := h.promoService.ApplyToFares(ctx, code, req.PassengerID, estimatedFares); err != nil {
+			return nil, promoError("apply promo code", err)
+		}
+	}
+
+	fares, err := h.tripService.GenerateTripFares(ctx, estimatedFares, req.PassengerID, route, pickupCoord, destinationCoord)
 	if err != nil {
 		log.Println(err)
 		return nil, status.Errorf(codes.Internal, "failed to generate the ride fares: %v", err)
@@ -78,7 +112,33 @@ func (h *gRPCHandler) CreateTrip(ctx context.Context, req *pb.CreateTripRequest)
 		return nil, status.Errorf(codes.Internal, "failed to create trip: %v", err)
 	}
 
+	// The code may have run out since the preview, the passenger previews again without it
+	if err := h.promoService.RedeemForTrip(ctx, trip); err != nil {
+		h.cancelTrip(ctx, trip.ID.String(), domain.CancelReasonPromoRejected, false)
+		return nil, promoError("redeem promo code", err)
+	}
+
+	// No driver is searched for a trip the passenger cannot pay
+	if _, err := h.paymentService.AuthorizeTrip(ctx, trip, req.GetPaymentMethodID()); err != nil {
+		h.cancelTrip(ctx, trip.ID.String(), domain.CancelReasonPaymentFailed, false)
+		return nil, paymentError("authorize payment", err)
+	}
+
+	// Scheduled before the search starts, so a driver accepting right away finds the token to cancel
+	token, err := h.publisher.ScheduleMatchTimeout(ctx, trip, h.matchTimeout)
+	if err != nil {
+		log.Printf("failed to schedule the match timeout of trip %s: %v", trip.ID, err)
+	} else if err := h.tripService.SetMatchTimeoutToken(ctx, trip.ID.String(), token); err != nil {
+		log.Printf("failed to save the match timeout of trip %s: %v", trip.ID, err)
+	}
+
 	if err := h.publisher.PublishTripCreated(ctx, trip); err != nil {
+		h.cancelTrip(ctx, trip.ID.String(), domain.CancelReasonPublishFailed, true)
+		if token != "" {
+			if err := h.publisher.CancelMatchTimeout(ctx, token); err != nil {
+				log.Printf("failed to cancel the match timeout of trip %s: %v", trip.ID, err)
+			}
+		}
 		return nil, status.Errorf(codes.Internal, "failed to publish the trip created event message: %v", err)
 	}
 
@@ -86,3 +146,130 @@ func (h *gRPCHandler) CreateTrip(ctx context.Context, req *pb.CreateTripRequest)
 		TripID: trip.ID.String(),
 	}, nil
 }
+
+func (h *gRPCHandler) StartTrip(ctx context.Context, req *pb.StartTripRequest) (*pb.StartTripResponse, error) {
+	trip, err := h.tripService.StartTrip(ctx, req.GetTripID(), req.GetDriverID())
+	if err != nil {
+		return nil, tripError("start trip", err)
+	}
+
+	if err := h.publisher.PublishTripStarted(ctx, trip); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish the trip started event message: %v", err)
+	}
+
+	return &pb.StartTripResponse{
+		Trip: trip.ToProto(),
+	}, nil
+}
+
+func (h *gRPCHandler) CompleteTrip(ctx context.Context, req *pb.CompleteTripRequest) (*pb.CompleteTripResponse, error) {
+	trip, err := h.tripService.CompleteTrip(ctx, req.GetTripID(), req.GetDriverID())
+	if err != nil {
+		return nil, tripError("complete trip", err)
+	}
+
+	// The trip is over either way, a failed capture is left authorized for the payment settler.
+	// A retried completion finds the payment already captured.
+	if _, err := h.paymentService.CaptureTrip(ctx, trip.ID.String()); err != nil && !errors.Is(err, service.ErrInvalidPaymentStatus) {
+		log.Printf("failed to capture the payment of trip %s: %v", trip.ID, err)
+	}
+
+	// The consumers of the event are idempotent, the driver app retries until it is published
+	if err := h.publisher.PublishTripCompleted(ctx, trip); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "the trip is completed but the event could not be published, retry: %v", err)
+	}
+
+	// The receipt is issued again on demand when it is missing, so failures are only logged
+	receipt, err := h.receiptService.IssueReceipt(ctx, trip.ID.String())
+	if err != nil {
+		log.Printf("failed to issue the receipt of trip %s: %v", trip.ID, err)
+	} else if err := h.publisher.PublishReceiptIssued(ctx, receipt); err != nil {
+		log.Printf("failed to publish the receipt of trip %s: %v", trip.ID, err)
+	}
+
+	return &pb.CompleteTripResponse{
+		Trip: trip.ToProto(),
+	}, nil
+}
+
+func (h *gRPCHandler) ArriveAtPickup(ctx context.Context, req *pb.ArriveAtPickupRequest) (*pb.ArriveAtPickupResponse, error) {
+	trip, err := h.tripService.ArriveAtPickup(ctx, req.GetTripID(), req.GetDriverID())
+	if err != nil {
+		return nil, tripError("arrive at pickup", err)
+	}
+
+	if err := h.publisher.PublishDriverArrived(ctx, trip); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish the driver arrived event message: %v", err)
+	}
+
+	return &pb.ArriveAtPickupResponse{
+		Trip: trip.ToProto(),
+	}, nil
+}
+
+func (h *gRPCHandler) CancelTrip(ctx context.Context, req *pb.CancelTripRequest) (*pb.CancelTripResponse, error) {
+	by := domain.CanceledByPassenger
+	if req.GetByDriver() {
+		by = domain.CanceledByDriver
+	}
+
+	trip, err := h.tripService.CancelTrip(ctx, req.GetTripID(), req.GetUserID(), by)
+	if err != nil {
+		return nil, tripError("cancel trip", err)
+	}
+
+	// The trip is canceled either way. A fee the card declined is waived, so neither the passenger
+	// nor the driver are told about money that was not collected, and the payment settler voids
+	// what is left authorized. A failure after the card was charged is left to the settler at the same fee.
+	if _, err := h.paymentService.ChargeCancellation(ctx, trip.ID.String(), trip.Cancellation.FeeCents); err != nil {
+		log.Printf("failed to charge the cancellation fee of trip %s: %v", trip.ID, err)
+		if errors.Is(err, service.ErrFeeNotCharged) {
+			if err := h.tripService.WaiveCancellationFee(ctx, trip); err != nil {
+				log.Printf("failed to waive the cancellation fee of trip %s: %v", trip.ID, err)
+			}
+		}
+	}
+	if err := h.promoService.ReleaseTrip(ctx, trip.ID.String()); err != nil {
+		log.Printf("failed to release the promo code of trip %s: %v", trip.ID, err)
+	}
+
+	if err := h.publisher.PublishTripCancelled(ctx, trip); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish the trip cancelled event message: %v", err)
+	}
+
+	return &pb.CancelTripResponse{
+		Trip:     trip.ToProto(),
+		FeeCents: trip.Cancellation.FeeCents,
+	}, nil
+}
+
+// cancelTrip drops a trip that could not be requested, releasing its promo code and its payment when it was authorized.
+func (h *gRPCHandler) cancelTrip(ctx context.Context, tripID, reason string, authorized bool) {
+	if _, err := h.tripService.CancelRequestedTrip(ctx, tripID, reason); err != nil {
+		log.Printf("failed to cancel trip %s: %v", tripID, err)
+	}
+	if err := h.promoService.ReleaseTrip(ctx, tripID); err != nil {
+		log.Printf("failed to release the promo code of trip %s: %v", tripID, err)
+	}
+
+	if !authorized {
+		return
+	}
+	if _, err := h.paymentService.VoidTrip(ctx, tripID); err != nil {
+		log.Printf("failed to void the payment of trip %s: %v", tripID, err)
+	}
+}
+
+func tripError(operation string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrTripNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrNotTripDriver), errors.Is(err, service.ErrNotTripPassenger):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrInvalidTripStatus):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		log.Printf("failed to %s: %v", operation, err)
+		return status.Errorf(codes.Internal, "failed to %s", operation)
+	}
+}
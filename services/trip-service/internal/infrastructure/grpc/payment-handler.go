@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"go-ride/services/trip-service/internal/domain"
+	"go-ride/services/trip-service/internal/service"
+	pb "go-ride/shared/proto/trip"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *gRPCHandler) AddPaymentMethod(ctx context.Context, req *pb.AddPaymentMethodRequest) (*pb.AddPaymentMethodResponse, error) {
+	if req.GetUserID() == "" || req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "userID and token are required")
+	}
+
+	method, err := h.paymentService.AddPaymentMethod(ctx, req.GetUserID(), req.GetToken())
+	if err != nil {
+		return nil, paymentError("add payment method", err)
+	}
+
+	return &pb.AddPaymentMethodResponse{
+		PaymentMethod: method.ToProto(),
+	}, nil
+}
+
+func (h *gRPCHandler) ListPaymentMethods(ctx context.Context, req *pb.ListPaymentMethodsRequest) (*pb.ListPaymentMethodsResponse, error) {
+	methods, err := h.paymentService.ListPaymentMethods(ctx, req.GetUserID())
+	if err != nil {
+		return nil, paymentError("list payment methods", err)
+	}
+
+	res := &pb.ListPaymentMethodsResponse{
+		PaymentMethods: make([]*pb.PaymentMethod, len(methods)),
+	}
+	for i, method := range methods {
+		res.PaymentMethods[i] = method.ToProto()
+	}
+	return res, nil
+}
+
+func (h *gRPCHandler) DeletePaymentMethod(ctx context.Context, req *pb.DeletePaymentMethodRequest) (*pb.DeletePaymentMethodResponse, error) {
+	if err := h.paymentService.DeletePaymentMethod(ctx, req.GetUserID(), req.GetPaymentMethodID()); err != nil {
+		return nil, paymentError("delete payment method", err)
+	}
+
+	return &pb.DeletePaymentMethodResponse{}, nil
+}
+
+func (h *gRPCHandler) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	payment, err := h.paymentService.RefundTrip(ctx, req.GetTripID(), req.GetAmountCents(), req.GetToWallet())
+	if err != nil {
+		return nil, paymentError("refund payment", err)
+	}
+
+	return &pb.RefundPaymentResponse{
+		Payment: payment.ToProto(),
+	}, nil
+}
+
+func paymentError(operation string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidCard),
+		errors.Is(err, service.ErrInvalidRefund),
+		errors.Is(err, service.ErrInvalidAmount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrPaymentMethodNotFound), errors.Is(err, service.ErrPaymentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrPaymentDeclined),
+		errors.Is(err, service.ErrNoPaymentMethod),
+		errors.Is(err, service.ErrInvalidPaymentStatus),
+		errors.Is(err, domain.ErrInsufficientBalance):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrPaymentTimeout):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, service.ErrTopUpInProgress):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		log.Printf("failed to %s: %v", operation, err)
+		return status.Errorf(codes.Internal, "failed to %s", operation)
+	}
+}
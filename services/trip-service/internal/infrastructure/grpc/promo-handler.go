@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"go-ride/services/trip-service/internal/domain"
+	"go-ride/services/trip-service/internal/service"
+	pb "go-ride/shared/proto/trip"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *gRPCHandler) CreatePromoCode(ctx context.Context, req *pb.CreatePromoCodeRequest) (*pb.CreatePromoCodeResponse, error) {
+	if req.GetPromoCode() == nil {
+		return nil, status.Error(codes.InvalidArgument, "promoCode is required")
+	}
+
+	promo, err := h.promoService.CreatePromo(ctx, domain.PromoCodeFromProto(req.GetPromoCode()))
+	if err != nil {
+		return nil, promoError("create promo code", err)
+	}
+
+	return &pb.CreatePromoCodeResponse{
+		PromoCode: promo.ToProto(),
+	}, nil
+}
+
+func (h *gRPCHandler) DeactivatePromoCode(ctx context.Context, req *pb.DeactivatePromoCodeRequest) (*pb.DeactivatePromoCodeResponse, error) {
+	promo, err := h.promoService.DeactivatePromo(ctx, req.GetCode())
+	if err != nil {
+		return nil, promoError("deactivate promo code", err)
+	}
+
+	return &pb.DeactivatePromoCodeResponse{
+		PromoCode: promo.ToProto(),
+	}, nil
+}
+
+func (h *gRPCHandler) ListPromoCodes(ctx context.Context, req *pb.ListPromoCodesRequest) (*pb.ListPromoCodesResponse, error) {
+	promos, err := h.promoService.ListPromos(ctx)
+	if err != nil {
+		return nil, promoError("list promo codes", err)
+	}
+
+	protoPromos := make([]*pb.PromoCode, len(promos))
+	for i, promo := range promos {
+		protoPromos[i] = promo.ToProto()
+	}
+
+	return &pb.ListPromoCodesResponse{
+		PromoCodes: protoPromos,
+	}, nil
+}
+
+func promoError(operation string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPromo):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrPromoNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrPromoExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrPromoUnavailable),
+		errors.Is(err, service.ErrPromoNotApplicable),
+		errors.Is(err, domain.ErrPromoExhausted),
+		errors.Is(err, domain.ErrPromoUserLimit),
+		errors.Is(err, domain.ErrPromoFirstRideOnly):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		log.Printf("failed to %s: %v", operation, err)
+		return status.Errorf(codes.Internal, "failed to %s", operation)
+	}
+}
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"go-ride/services/trip-service/internal/service"
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *gRPCHandler) RateTrip(ctx context.Context, req *pb.RateTripRequest) (*pb.RateTripResponse, error) {
+	rating, err := h.ratingService.RateTrip(ctx, req.GetTripID(), req.GetUserID(), int(req.GetStars()), req.GetTags(), req.GetComment())
+	if err != nil {
+		return nil, ratingError("rate trip", err)
+	}
+
+	// driver-service records the rating once per trip, the rater retries until it is published
+	if err := h.publisher.PublishTripRated(ctx, rating); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "the rating is saved but the event could not be published, retry: %v", err)
+	}
+
+	return &pb.RateTripResponse{
+		Rating: rating.ToProto(),
+	}, nil
+}
+
+func (h *gRPCHandler) GetRating(ctx context.Context, req *pb.GetRatingRequest) (*pb.GetRatingResponse, error) {
+	summary, err := h.ratingService.GetRatingSummary(ctx, req.GetUserID())
+	if err != nil {
+		return nil, ratingError("get rating", err)
+	}
+
+	return &pb.GetRatingResponse{
+		Summary: summary.ToProto(),
+	}, nil
+}
+
+func ratingError(operation string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidRating):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrAlreadyRated):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrRatingClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return tripError(operation, err)
+	}
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"go-ride/services/trip-service/internal/service"
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *gRPCHandler) GetReceipt(ctx context.Context, req *pb.GetReceiptRequest) (*pb.GetReceiptResponse, error) {
+	format := domain.ReceiptFormat(req.GetFormat())
+	if format == "" {
+		format = domain.ReceiptPDF
+	}
+
+	content, err := h.receiptService.RenderReceipt(ctx, req.GetTripID(), req.GetUserID(), format)
+	if err != nil {
+		return nil, receiptError("get receipt", err)
+	}
+
+	return &pb.GetReceiptResponse{
+		Content:     content,
+		ContentType: format.ContentType(),
+		FileName:    fmt.Sprintf("receipt-%s.%s", req.GetTripID(), format),
+	}, nil
+}
+
+func receiptError(operation string, err error) error {
+	if errors.Is(err, service.ErrInvalidReceiptFormat) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return tripError(operation, err)
+}
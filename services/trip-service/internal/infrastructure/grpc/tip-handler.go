@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"go-ride/services/trip-service/internal/service"
+	pb "go-ride/shared/proto/trip"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *gRPCHandler) AddTip(ctx context.Context, req *pb.AddTipRequest) (*pb.AddTipResponse, error) {
+	tip, err := h.tipService.AddTip(ctx, req.GetTripID(), req.GetUserID(), req.GetAmountCents(), req.GetPaymentMethodID())
+	if err != nil {
+		return nil, tipError("add tip", err)
+	}
+
+	// driver-service credits the earnings of the driver once per trip and the gateway notifies it,
+	// the passenger retries the tip until it is published
+	if err := h.publisher.PublishTripTipped(ctx, tip); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "the tip is charged but the event could not be published, retry: %v", err)
+	}
+
+	// The passenger already got the receipt by email, the one to download now shows the tip
+	if _, err := h.receiptService.IssueReceipt(ctx, tip.TripID); err != nil {
+		log.Printf("failed to issue the receipt of trip %s: %v", tip.TripID, err)
+	}
+
+	return &pb.AddTipResponse{
+		Tip: tip.ToProto(),
+	}, nil
+}
+
+func tipError(operation string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidTip):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrAlreadyTipped):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrTipClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrTripNotFound),
+		errors.Is(err, service.ErrNotTripPassenger),
+		errors.Is(err, service.ErrInvalidTripStatus):
+		return tripError(operation, err)
+	default:
+		return paymentError(operation, err)
+	}
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"go-ride/services/trip-service/internal/domain"
+	pb "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultWalletEntries  = 20
+	maxWalletEntries      = 100
+	defaultJournalEntries = 100
+	maxJournalEntries     = 500
+)
+
+func (h *gRPCHandler) GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.GetWalletResponse, error) {
+	balance, err := h.walletService.GetBalance(ctx, req.GetUserID())
+	if err != nil {
+		return nil, paymentError("get wallet", err)
+	}
+
+	entries, err := h.walletService.ListEntries(ctx, req.GetUserID(), limitOf(req.GetLimit(), defaultWalletEntries, maxWalletEntries))
+	if err != nil {
+		return nil, paymentError("get wallet", err)
+	}
+
+	return &pb.GetWalletResponse{
+		BalanceCents: balance,
+		Entries:      journalsToProto(entries),
+	}, nil
+}
+
+func (h *gRPCHandler) TopUpWallet(ctx context.Context, req *pb.TopUpWalletRequest) (*pb.TopUpWalletResponse, error) {
+	if req.GetUserID() == "" || req.GetIdempotencyKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "userID and idempotencyKey are required")
+	}
+
+	entry, err := h.paymentService.TopUpWallet(ctx, req.GetUserID(), req.GetPaymentMethodID(), req.GetAmountCents(), req.GetIdempotencyKey())
+	if err != nil {
+		return nil, paymentError("top up wallet", err)
+	}
+
+	balance, err := h.walletService.GetBalance(ctx, req.GetUserID())
+	if err != nil {
+		return nil, paymentError("top up wallet", err)
+	}
+
+	return &pb.TopUpWalletResponse{
+		Entry:        entry.ToProto(),
+		BalanceCents: balance,
+	}, nil
+}
+
+func (h *gRPCHandler) CreditWallet(ctx context.Context, req *pb.CreditWalletRequest) (*pb.CreditWalletResponse, error) {
+	if req.GetUserID() == "" || req.GetReference() == "" {
+		return nil, status.Error(codes.InvalidArgument, "userID and reference are required")
+	}
+
+	entry, err := h.walletService.Credit(ctx, req.GetUserID(), req.GetAmountCents(), req.GetReference(), req.GetDescription())
+	if err != nil {
+		return nil, paymentError("credit wallet", err)
+	}
+
+	balance, err := h.walletService.GetBalance(ctx, req.GetUserID())
+	if err != nil {
+		return nil, paymentError("credit wallet", err)
+	}
+
+	return &pb.CreditWalletResponse{
+		Entry:        entry.ToProto(),
+		BalanceCents: balance,
+	}, nil
+}
+
+// ListJournalEntries pages through the whole ledger in posting order, for reconciliation.
+func (h *gRPCHandler) ListJournalEntries(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
+	entries, err := h.walletService.ListJournals(ctx, req.GetAfterSequence(), limitOf(req.GetLimit(), defaultJournalEntries, maxJournalEntries))
+	if err != nil {
+		return nil, paymentError("list journal entries", err)
+	}
+
+	return &pb.ListJournalEntriesResponse{
+		Entries: journalsToProto(entries),
+	}, nil
+}
+
+func journalsToProto(entries []*domain.JournalEntry) []*pb.JournalEntry {
+	protoEntries := make([]*pb.JournalEntry, len(entries))
+	for i, entry := range entries {
+		protoEntries[i] = entry.ToProto()
+	}
+	return protoEntries
+}
+
+func limitOf(requested int32, fallback, maximum int) int {
+	if requested <= 0 {
+		return fallback
+	}
+	return min(int(requested), maximum)
+}
@@ -0,0 +1,131 @@
+package payments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"sync"
+)
+
+// Test tokens understood by the fake provider, any other token is rejected as an invalid card.
+// The declined and timeout cards pass verification, they only fail when charged.
+const (
+	TokenVisa       = "tok_visa"
+	TokenMastercard = "tok_mastercard"
+	TokenDeclined   = "tok_declined"
+	TokenTimeout    = "tok_timeout"
+)
+
+var fakeCards = map[string]domain.CardDetails{
+	TokenVisa:       {Brand: "visa", Last4: "4242", Fingerprint: "fp_visa_4242"},
+	TokenMastercard: {Brand: "mastercard", Last4: "4444", Fingerprint: "fp_mastercard_4444"},
+	TokenDeclined:   {Brand: "visa", Last4: "0002", Fingerprint: "fp_visa_0002"},
+	TokenTimeout:    {Brand: "visa", Last4: "0119", Fingerprint: "fp_visa_0119"},
+}
+
+type fakeAuthorization struct {
+	amountCents   int64
+	capturedCents int64
+	refundedCents int64
+	voided        bool
+}
+
+// FakeProvider is a deterministic in-memory payment gateway for local runs and tests.
+// The authorization ID is derived from the idempotency key, so the same trip always gets the same ID.
+type FakeProvider struct {
+	authorizations map[string]*fakeAuthorization
+	mutex          sync.Mutex
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		authorizations: make(map[string]*fakeAuthorization),
+	}
+}
+
+func (p *FakeProvider) VerifyCard(_ context.Context, token string) (*domain.CardDetails, error) {
+	card, ok := fakeCards[token]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown token %q", domain.ErrInvalidCard, token)
+	}
+	return &card, nil
+}
+
+func (p *FakeProvider) Authorize(ctx context.Context, token string, amountCents int64, idempotencyKey string) (string, error) {
+	switch token {
+	case TokenDeclined:
+		return "", fmt.Errorf("%w: insufficient funds", domain.ErrPaymentDeclined)
+	case TokenTimeout:
+		// Hangs like an unresponsive gateway until the caller gives up
+		<-ctx.Done()
+		return "", fmt.Errorf("%w: %v", domain.ErrPaymentTimeout, ctx.Err())
+	}
+	if _, ok := fakeCards[token]; !ok {
+		return "", fmt.Errorf("%w: unknown token %q", domain.ErrInvalidCard, token)
+	}
+
+	sum := sha256.Sum256([]byte(idempotencyKey))
+	authorizationID := "auth_" + hex.EncodeToString(sum[:8])
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, ok := p.authorizations[authorizationID]; !ok {
+		p.authorizations[authorizationID] = &fakeAuthorization{amountCents: amountCents}
+	}
+	return authorizationID, nil
+}
+
+func (p *FakeProvider) Capture(_ context.Context, authorizationID string, amountCents int64) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	authorization, ok := p.authorizations[authorizationID]
+	switch {
+	case !ok:
+		return fmt.Errorf("authorization %s not found", authorizationID)
+	case authorization.voided:
+		return fmt.Errorf("authorization %s was voided", authorizationID)
+	case authorization.capturedCents > 0:
+		return fmt.Errorf("authorization %s was already captured", authorizationID)
+	case amountCents > authorization.amountCents:
+		return fmt.Errorf("capture of %d cents exceeds the authorized %d", amountCents, authorization.amountCents)
+	}
+
+	authorization.capturedCents = amountCents
+	return nil
+}
+
+func (p *FakeProvider) Void(_ context.Context, authorizationID string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	authorization, ok := p.authorizations[authorizationID]
+	switch {
+	case !ok:
+		return fmt.Errorf("authorization %s not found", authorizationID)
+	case authorization.capturedCents > 0:
+		return fmt.Errorf("authorization %s was already captured", authorizationID)
+	}
+
+	authorization.voided = true
+	return nil
+}
+
+func (p *FakeProvider) Refund(_ context.Context, authorizationID string, amountCents int64) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	authorization, ok := p.authorizations[authorizationID]
+	switch {
+	case !ok:
+		return fmt.Errorf("authorization %s not found", authorizationID)
+	case authorization.refundedCents+amountCents > authorization.capturedCents:
+		return fmt.Errorf("refund of %d cents exceeds the captured %d", amountCents, authorization.capturedCents-authorization.refundedCents)
+	}
+
+	authorization.refundedCents += amountCents
+	return nil
+}
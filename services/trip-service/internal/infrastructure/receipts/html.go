@@ -0,0 +1,102 @@
+package receipts
+
+import (
+	"bytes"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"html/template"
+	"strings"
+)
+
+const (
+	mapWidth  = 480
+	mapHeight = 240
+	mapMargin = 16
+)
+
+var htmlTemplate = template.Must(template.New("receipt").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>go-ride receipt {{.Receipt.Number}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; color: #222; max-width: 560px; margin: 24px auto; }
+table { width: 100%; border-collapse: collapse; margin-bottom: 20px; }
+td { padding: 4px 0; }
+td.amount { text-align: right; }
+tr.total td { font-weight: bold; border-top: 1px solid #222; }
+svg { background: #f2f2f2; border-radius: 8px; }
+</style>
+</head>
+<body>
+<h1>go-ride</h1>
+<p>Thanks for riding with us, here is the receipt of your trip.</p>
+{{if .Points}}<svg width="{{.Width}}" height="{{.Height}}" viewBox="0 0 {{.Width}} {{.Height}}" role="img" aria-label="Route of the trip">
+<polyline points="{{.Points}}" fill="none" stroke="#1a66cc" stroke-width="3" stroke-linejoin="round" stroke-linecap="round"/>
+<circle cx="{{printf "%.1f" .Start.X}}" cy="{{printf "%.1f" .Start.Y}}" r="6" fill="#2e9e44"/>
+<circle cx="{{printf "%.1f" .End.X}}" cy="{{printf "%.1f" .End.Y}}" r="6" fill="#cc3333"/>
+</svg>{{end}}
+<h2>Fare</h2>
+<table>
+{{range .Fare}}<tr{{if .Total}} class="total"{{end}}><td>{{.Label}}</td><td class="amount">{{.Amount}}</td></tr>
+{{end}}</table>
+{{if .Payment}}<h2>Payment</h2>
+<table>
+{{range .Payment}}<tr><td>{{.Label}}</td><td class="amount">{{.Amount}}</td></tr>
+{{end}}</table>{{end}}
+<h2>Trip</h2>
+<table>
+{{range .Details}}<tr><td>{{.Label}}</td><td class="amount">{{.Amount}}</td></tr>
+{{end}}</table>
+<p><small>Issued {{.IssuedAt}}</small></p>
+</body>
+</html>
+`))
+
+func renderHTML(receipt *domain.Receipt) ([]byte, error) {
+	data := struct {
+		Receipt    *domain.Receipt
+		Width      int
+		Height     int
+		Points     string
+		Start, End point
+		Fare       []line
+		Payment    []line
+		Details    []line
+		IssuedAt   string
+	}{
+		Receipt:  receipt,
+		Width:    mapWidth,
+		Height:   mapHeight,
+		Fare:     fareLines(receipt),
+		Payment:  paymentLines(receipt),
+		Details:  tripDetails(receipt),
+		IssuedAt: formatTime(receipt.IssuedAt),
+	}
+
+	if points := projectRoute(receipt, mapWidth, mapHeight, mapMargin); len(points) > 0 {
+		// SVG has y growing downwards
+		for i := range points {
+			points[i].Y = mapHeight - points[i].Y
+		}
+		data.Points = formatPoints(points)
+		data.Start, data.End = points[0], points[len(points)-1]
+	}
+
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func formatPoints(points []point) string {
+	var b strings.Builder
+	for i, p := range points {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%.1f,%.1f", p.X, p.Y)
+	}
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package receipts
+
+import (
+	"bytes"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"strings"
+)
+
+// A4 in points
+const (
+	pageWidth  = 595
+	pageHeight = 842
+	pageMargin = 50
+)
+
+// renderPDF writes a single page PDF 1.4 document. Text uses the standard Helvetica fonts,
+// which every reader has, so nothing is embedded.
+func renderPDF(receipt *domain.Receipt) ([]byte, error) {
+	var content pdfContent
+	y := float64(pageHeight - pageMargin - 18)
+
+	content.text(pageMargin, y, 22, true, "go-ride")
+	y -= 22
+	content.text(pageMargin, y, 11, false, "Thanks for riding with us, here is the receipt of your trip.")
+	y -= 24
+
+	mapTop := y
+	if points := projectRoute(receipt, mapWidth, mapHeight, mapMargin); len(points) > 0 {
+		left, bottom := float64(pageMargin), mapTop-mapHeight
+		content.printf("0.95 g %.2f %.2f %d %d re f\n", left, bottom, mapWidth, mapHeight)
+		content.printf("0.10 0.40 0.80 RG 3 w 1 j 1 J\n")
+		for i, p := range points {
+			op := "l"
+			if i == 0 {
+				op = "m"
+			}
+			content.printf("%.2f %.2f %s\n", left+p.X, bottom+p.Y, op)
+		}
+		content.printf("S\n")
+		content.marker(left+points[0].X, bottom+points[0].Y, "0.18 0.62 0.27")
+		content.marker(left+points[len(points)-1].X, bottom+points[len(points)-1].Y, "0.80 0.20 0.20")
+		y = bottom - 30
+	}
+
+	y = content.section(y, "Fare", fareLines(receipt))
+	if payment := paymentLines(receipt); len(payment) > 0 {
+		y = content.section(y, "Payment", payment)
+	}
+	y = content.section(y, "Trip", tripDetails(receipt))
+	content.text(pageMargin, y, 9, false, "Issued "+formatTime(receipt.IssuedAt))
+
+	return writePDF(content.Bytes()), nil
+}
+
+type pdfContent struct {
+	bytes.Buffer
+}
+
+func (c *pdfContent) printf(format string, args ...any) {
+	fmt.Fprintf(c, format, args...)
+}
+
+func (c *pdfContent) text(x, y, size float64, bold bool, s string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	c.printf("0 g BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, escapePDF(s))
+}
+
+func (c *pdfContent) marker(x, y float64, color string) {
+	c.printf("%s rg %.2f %.2f 10 10 re f\n", color, x-5, y-5)
+}
+
+// section writes a titled table of lines, amounts in a second column, and returns the y below it.
+func (c *pdfContent) section(y float64, title string, lines []line) float64 {
+	c.text(pageMargin, y, 14, true, title)
+	y -= 20
+	for _, l := range lines {
+		if l.Total {
+			c.printf("0 G 0.8 w %d %.2f m %d %.2f l S\n", pageMargin, y+14, pageWidth-pageMargin, y+14)
+		}
+		c.text(pageMargin, y, 11, l.Total, l.Label)
+		c.text(pageMargin+260, y, 11, l.Total, l.Amount)
+		y -= 17
+	}
+	return y - 14
+}
+
+func writePDF(content []byte) []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents 6 0 R >>", pageWidth, pageHeight),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+// escapePDF makes s a literal string of a WinAnsi font, characters outside Latin-1 become '?'.
+func escapePDF(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r < 0x20 || (r >= 0x7f && r < 0xa0) || r > 0xff:
+			b.WriteByte('?')
+		default:
+			b.WriteByte(byte(r))
+		}
+	}
+	return b.String()
+}
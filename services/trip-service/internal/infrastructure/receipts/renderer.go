@@ -0,0 +1,156 @@
+package receipts
+
+import (
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"math"
+	"time"
+)
+
+// Renderer renders receipts without any external dependency: HTML through html/template,
+// with the route drawn as inline SVG, and PDF written by hand with the standard Helvetica fonts.
+type Renderer struct{}
+
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+func (r *Renderer) Render(receipt *domain.Receipt, format domain.ReceiptFormat) ([]byte, error) {
+	switch format {
+	case domain.ReceiptHTML:
+		return renderHTML(receipt)
+	case domain.ReceiptPDF:
+		return renderPDF(receipt)
+	default:
+		return nil, fmt.Errorf("unknown receipt format %q", format)
+	}
+}
+
+// line is a row of the fare breakdown, shared by both formats.
+type line struct {
+	Label  string
+	Amount string
+	Total  bool
+}
+
+func fareLines(receipt *domain.Receipt) []line {
+	lines := []line{
+		{Label: fmt.Sprintf("Fare (%s)", receipt.PackageSlug), Amount: formatCents(receipt.FareCents)},
+	}
+	if receipt.DiscountCents > 0 {
+		label := "Discount"
+		if receipt.PromoCode != "" {
+			label = fmt.Sprintf("Discount (%s)", receipt.PromoCode)
+		}
+		lines = append(lines, line{Label: label, Amount: formatCents(-receipt.DiscountCents)})
+	}
+	if receipt.TipCents > 0 {
+		lines = append(lines, line{Label: "Tip", Amount: formatCents(receipt.TipCents)})
+	}
+	if receipt.RefundedCents > 0 {
+		lines = append(lines, line{Label: "Refunded", Amount: formatCents(-receipt.RefundedCents)})
+	}
+	return append(lines, line{Label: "Total", Amount: formatCents(receipt.TotalCents), Total: true})
+}
+
+func paymentLines(receipt *domain.Receipt) []line {
+	var lines []line
+	if receipt.WalletCents > 0 {
+		lines = append(lines, line{Label: "Wallet", Amount: formatCents(receipt.WalletCents)})
+	}
+	if card := receipt.TotalCents + receipt.RefundedCents - receipt.WalletCents; card > 0 {
+		label := "Card"
+		if receipt.PaymentMethod != "" {
+			label = "Card, " + receipt.PaymentMethod
+		}
+		lines = append(lines, line{Label: label, Amount: formatCents(card)})
+	}
+	return lines
+}
+
+func tripDetails(receipt *domain.Receipt) []line {
+	details := []line{
+		{Label: "Receipt", Amount: receipt.Number},
+		{Label: "Trip", Amount: receipt.TripID},
+		{Label: "Completed", Amount: formatTime(receipt.CompletedAt)},
+		{Label: "Distance", Amount: fmt.Sprintf("%.1f km", receipt.DistanceMeters/1000)},
+		{Label: "Duration", Amount: fmt.Sprintf("%d min", int(math.Round(receipt.DurationSeconds/60)))},
+	}
+	if receipt.DriverName != "" {
+		driver := receipt.DriverName
+		if receipt.CarPlate != "" {
+			driver = fmt.Sprintf("%s (%s)", driver, receipt.CarPlate)
+		}
+		details = append(details, line{Label: "Driver", Amount: driver})
+	}
+	return details
+}
+
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format("2006-01-02 15:04 UTC")
+}
+
+type point struct {
+	X, Y float64
+}
+
+// projectRoute fits the route into a width by height box with a margin, y growing upwards.
+// Longitudes are shrunk by the cosine of the latitude so the map keeps its proportions.
+// Without a stored geometry the pickup and destination are joined by a straight line.
+func projectRoute(receipt *domain.Receipt, width, height, margin float64) []point {
+	coordinates := receipt.Route
+	if len(coordinates) == 0 && receipt.Pickup != nil && receipt.Destination != nil {
+		coordinates = [][]float64{
+			{receipt.Pickup.Longitude, receipt.Pickup.Latitude},
+			{receipt.Destination.Longitude, receipt.Destination.Latitude},
+		}
+	}
+
+	var raw []point
+	for _, coordinate := range coordinates {
+		if len(coordinate) < 2 {
+			continue
+		}
+		raw = append(raw, point{X: coordinate[0], Y: coordinate[1]})
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+
+	minX, maxX, minY, maxY := raw[0].X, raw[0].X, raw[0].Y, raw[0].Y
+	for _, p := range raw[1:] {
+		minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+		minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+	}
+
+	shrink := math.Cos((minY + maxY) / 2 * math.Pi / 180)
+	spanX := (maxX - minX) * shrink
+	spanY := maxY - minY
+
+	scale := 0.0
+	if spanX > 0 || spanY > 0 {
+		scale = math.Min((width-2*margin)/math.Max(spanX, 1e-12), (height-2*margin)/math.Max(spanY, 1e-12))
+	}
+	offsetX := (width - spanX*scale) / 2
+	offsetY := (height - spanY*scale) / 2
+
+	projected := make([]point, len(raw))
+	for i, p := range raw {
+		projected[i] = point{
+			X: offsetX + (p.X-minX)*shrink*scale,
+			Y: offsetY + (p.Y-minY)*scale,
+		}
+	}
+	return projected
+}
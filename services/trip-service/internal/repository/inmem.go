@@ -4,31 +4,66 @@ import (
 	"context"
 	"fmt"
 	"go-ride/services/trip-service/internal/domain"
+	pb "go-ride/shared/proto/trip"
+	"sync"
+	"time"
 )
 
 type inmemRepository struct {
-	trips     map[string]*domain.TripModel
-	rideFares map[string]*domain.RideFareModel
+	trips          map[string]*domain.TripModel
+	rideFares      map[string]*domain.RideFareModel
+	paymentMethods map[string][]*domain.PaymentMethod // by user, oldest first
+	payments       map[string]*domain.Payment         // by trip
+	topUps         map[string]*domain.TopUp           // by user and key
+	journals       []*domain.JournalEntry             // by sequence, starting at 1
+	journalKeys    map[string]*domain.JournalEntry
+	balances       map[string]int64
+	accountEntries map[string][]*domain.JournalEntry
+	promos         map[string]*domain.PromoCode
+	redemptions    map[string]*domain.PromoRedemption // by trip
+	ratings        map[string]*domain.Rating          // by trip and rater role
+	ratingsByRatee map[string][]*domain.Rating        // oldest first
+	tips           map[string]*domain.Tip             // by trip
+	receipts       map[string]*domain.Receipt         // by trip
+	mutex          sync.RWMutex                       // trips are updated by the gRPC handlers and the event consumers
 }
 
 func NewInmemRepository() *inmemRepository {
 	return &inmemRepository{
-		trips:     make(map[string]*domain.TripModel),
-		rideFares: make(map[string]*domain.RideFareModel),
+		trips:          make(map[string]*domain.TripModel),
+		rideFares:      make(map[string]*domain.RideFareModel),
+		paymentMethods: make(map[string][]*domain.PaymentMethod),
+		payments:       make(map[string]*domain.Payment),
+		topUps:         make(map[string]*domain.TopUp),
+		journalKeys:    make(map[string]*domain.JournalEntry),
+		balances:       make(map[string]int64),
+		accountEntries: make(map[string][]*domain.JournalEntry),
+		promos:         make(map[string]*domain.PromoCode),
+		redemptions:    make(map[string]*domain.PromoRedemption),
+		ratings:        make(map[string]*domain.Rating),
+		ratingsByRatee: make(map[string][]*domain.Rating),
+		tips:           make(map[string]*domain.Tip),
+		receipts:       make(map[string]*domain.Receipt),
 	}
 }
 
 func (r *inmemRepository) CreateTrip(ctx context.Context, trip *domain.TripModel) (*domain.TripModel, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 	r.trips[trip.ID.String()] = trip
 	return trip, nil
 }
 
 func (r *inmemRepository) SaveRideFare(ctx context.Context, fare *domain.RideFareModel) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 	r.rideFares[fare.ID.String()] = fare
 	return nil
 }
 
 func (r *inmemRepository) GetRideFareByID(ctx context.Context, fareID string) (*domain.RideFareModel, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
 	fare, exists := r.rideFares[fareID]
 	if !exists {
 		return nil, fmt.Errorf("fare does not exists with ID: %s", fareID)
@@ -37,28 +72,136 @@ func (r *inmemRepository) GetRideFareByID(ctx context.Context, fareID string) (*
 }
 
 func (r *inmemRepository) GetTripByID(ctx context.Context, id string) (*domain.TripModel, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
 	trip, ok := r.trips[id]
 	if !ok {
 		return nil, nil
 	}
-	return trip, nil
+
+	// Callers get a copy, so they never race with later updates
+	copied := *trip
+	return &copied, nil
 }
 
-// func (r *inmemRepository) UpdateTrip(ctx context.Context, tripID string, status string, driver *pbd.Driver) error {
-// 	trip, ok := r.trips[tripID]
-// 	if !ok {
-// 		return fmt.Errorf("trip not found with ID: %s", tripID)
-// 	}
-
-// 	trip.Status = status
-
-// 	if driver != nil {
-// 		trip.Driver = &pb.TripDriver{
-// 			Id:             driver.Id,
-// 			Name:           driver.Name,
-// 			CarPlate:       driver.CarPlate,
-// 			ProfilePicture: driver.ProfilePicture,
-// 		}
-// 	}
-// 	return nil
-// }
+func (r *inmemRepository) AssignDriver(ctx context.Context, tripID string, driver *pb.TripDriver, acceptedAt time.Time) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	trip, ok := r.trips[tripID]
+	if !ok {
+		return false, fmt.Errorf("trip not found with ID: %s", tripID)
+	}
+
+	if trip.Status != domain.REQUESTED {
+		return false, nil
+	}
+
+	trip.Status = domain.ACCEPTED
+	trip.Driver = driver
+	trip.AcceptedAt = acceptedAt
+	return true, nil
+}
+
+func (r *inmemRepository) UpdateTripStatus(ctx context.Context, tripID string, from, to domain.TripStatus) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	trip, ok := r.trips[tripID]
+	if !ok {
+		return false, fmt.Errorf("trip not found with ID: %s", tripID)
+	}
+
+	if trip.Status != from {
+		return false, nil
+	}
+
+	trip.Status = to
+	return true, nil
+}
+
+func (r *inmemRepository) CompleteTrip(ctx context.Context, tripID string, completedAt time.Time) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	trip, ok := r.trips[tripID]
+	if !ok {
+		return false, fmt.Errorf("trip not found with ID: %s", tripID)
+	}
+
+	if trip.Status != domain.IN_PROGRESS {
+		return false, nil
+	}
+
+	trip.Status = domain.COMPLETED
+	trip.CompletedAt = completedAt
+	return true, nil
+}
+
+func (r *inmemRepository) MarkArrived(ctx context.Context, tripID string, arrivedAt time.Time) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	trip, ok := r.trips[tripID]
+	if !ok {
+		return false, fmt.Errorf("trip not found with ID: %s", tripID)
+	}
+
+	if trip.Status != domain.ACCEPTED || !trip.ArrivedAt.IsZero() {
+		return false, nil
+	}
+
+	trip.ArrivedAt = arrivedAt
+	return true, nil
+}
+
+func (r *inmemRepository) CancelTrip(ctx context.Context, tripID string, from domain.TripStatus, cancellation *domain.Cancellation) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	trip, ok := r.trips[tripID]
+	if !ok {
+		return false, fmt.Errorf("trip not found with ID: %s", tripID)
+	}
+
+	if trip.Status != from {
+		return false, nil
+	}
+
+	trip.Status = domain.CANCELED
+	trip.Cancellation = cancellation
+	return true, nil
+}
+
+func (r *inmemRepository) SetMatchTimeoutToken(ctx context.Context, tripID, token string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	trip, ok := r.trips[tripID]
+	if !ok {
+		return fmt.Errorf("trip not found with ID: %s", tripID)
+	}
+
+	trip.MatchTimeoutToken = token
+	return nil
+}
+
+func (r *inmemRepository) WaiveCancellationFee(ctx context.Context, tripID string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	trip, ok := r.trips[tripID]
+	if !ok {
+		return fmt.Errorf("trip not found with ID: %s", tripID)
+	}
+	if trip.Status != domain.CANCELED || trip.Cancellation == nil {
+		return fmt.Errorf("trip %s is %s, expected %s", tripID, trip.Status, domain.CANCELED)
+	}
+
+	waived := *trip.Cancellation
+	waived.FeeCents = 0
+	waived.DriverCompensationCents = 0
+	trip.Cancellation = &waived
+	return nil
+}
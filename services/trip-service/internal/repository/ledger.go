@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"slices"
+)
+
+func (r *inmemRepository) PostJournal(ctx context.Context, entry *domain.JournalEntry) (*domain.JournalEntry, bool, error) {
+	if !entry.Balanced() {
+		return nil, false, fmt.Errorf("%w: %s", domain.ErrUnbalancedJournal, entry.Key)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if posted, ok := r.journalKeys[entry.Key]; ok {
+		return copyJournal(posted), false, nil
+	}
+
+	for _, posting := range entry.Postings {
+		if domain.IsWalletAccount(posting.Account) && r.balances[posting.Account]+posting.AmountCents < 0 {
+			return nil, false, fmt.Errorf("%w: %s", domain.ErrInsufficientBalance, posting.Account)
+		}
+	}
+
+	// The stored entry is never handed out, so nobody can alter a posted journal
+	posted := copyJournal(entry)
+	posted.Sequence = int64(len(r.journals)) + 1
+
+	r.journals = append(r.journals, posted)
+	r.journalKeys[posted.Key] = posted
+	for _, posting := range posted.Postings {
+		r.balances[posting.Account] += posting.AmountCents
+		r.accountEntries[posting.Account] = append(r.accountEntries[posting.Account], posted)
+	}
+
+	return copyJournal(posted), true, nil
+}
+
+func (r *inmemRepository) GetJournal(ctx context.Context, key string) (*domain.JournalEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entry, ok := r.journalKeys[key]
+	if !ok {
+		return nil, nil
+	}
+	return copyJournal(entry), nil
+}
+
+func (r *inmemRepository) GetBalance(ctx context.Context, account string) (int64, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.balances[account], nil
+}
+
+func (r *inmemRepository) ListJournals(ctx context.Context, afterSequence int64, limit int) ([]*domain.JournalEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	start := min(max(afterSequence, 0), int64(len(r.journals)))
+	end := min(start+int64(limit), int64(len(r.journals)))
+
+	entries := make([]*domain.JournalEntry, 0, end-start)
+	for _, entry := range r.journals[start:end] {
+		entries = append(entries, copyJournal(entry))
+	}
+	return entries, nil
+}
+
+func (r *inmemRepository) ListAccountJournals(ctx context.Context, account string, limit int) ([]*domain.JournalEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	posted := r.accountEntries[account]
+	start := max(len(posted)-limit, 0)
+
+	entries := make([]*domain.JournalEntry, 0, len(posted)-start)
+	for _, entry := range posted[start:] {
+		entries = append(entries, copyJournal(entry))
+	}
+	slices.Reverse(entries)
+	return entries, nil
+}
+
+func copyJournal(entry *domain.JournalEntry) *domain.JournalEntry {
+	copied := *entry
+	copied.Postings = slices.Clone(entry.Postings)
+	return &copied
+}
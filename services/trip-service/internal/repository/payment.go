@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"time"
+)
+
+func (r *inmemRepository) SavePaymentMethod(ctx context.Context, method *domain.PaymentMethod) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	copied := *method
+	r.paymentMethods[method.UserID] = append(r.paymentMethods[method.UserID], &copied)
+	return nil
+}
+
+func (r *inmemRepository) GetPaymentMethod(ctx context.Context, userID, methodID string) (*domain.PaymentMethod, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, method := range r.paymentMethods[userID] {
+		if method.ID == methodID {
+			copied := *method
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inmemRepository) ListPaymentMethods(ctx context.Context, userID string) ([]*domain.PaymentMethod, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	methods := make([]*domain.PaymentMethod, 0, len(r.paymentMethods[userID]))
+	for _, method := range r.paymentMethods[userID] {
+		copied := *method
+		methods = append(methods, &copied)
+	}
+	return methods, nil
+}
+
+func (r *inmemRepository) DeletePaymentMethod(ctx context.Context, userID, methodID string) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	methods := r.paymentMethods[userID]
+	for i, method := range methods {
+		if method.ID == methodID {
+			r.paymentMethods[userID] = append(methods[:i:i], methods[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *inmemRepository) SavePayment(ctx context.Context, payment *domain.Payment) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	copied := *payment
+	r.payments[payment.TripID] = &copied
+	return nil
+}
+
+func (r *inmemRepository) GetPayment(ctx context.Context, tripID string) (*domain.Payment, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	payment, ok := r.payments[tripID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *payment
+	return &copied, nil
+}
+
+func (r *inmemRepository) ListPaymentsByStatus(ctx context.Context, status domain.PaymentStatus) ([]*domain.Payment, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var payments []*domain.Payment
+	for _, payment := range r.payments {
+		if payment.Status == status {
+			copied := *payment
+			payments = append(payments, &copied)
+		}
+	}
+	return payments, nil
+}
+
+func (r *inmemRepository) UpdatePaymentStatus(ctx context.Context, tripID string, from, to domain.PaymentStatus) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	payment, ok := r.payments[tripID]
+	if !ok {
+		return false, fmt.Errorf("payment not found for trip: %s", tripID)
+	}
+
+	if payment.Status != from {
+		return false, nil
+	}
+
+	payment.Status = to
+	payment.UpdatedAt = time.Now()
+	return true, nil
+}
+
+func (r *inmemRepository) MarkCardSettled(ctx context.Context, tripID string) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	payment, ok := r.payments[tripID]
+	if !ok {
+		return false, fmt.Errorf("payment not found for trip: %s", tripID)
+	}
+
+	if payment.Status != domain.PaymentAuthorized {
+		return false, nil
+	}
+
+	payment.CardSettled = true
+	payment.UpdatedAt = time.Now()
+	return true, nil
+}
+
+func (r *inmemRepository) CapturePayment(ctx context.Context, tripID string, amountCents, walletCents int64) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	payment, ok := r.payments[tripID]
+	if !ok {
+		return false, fmt.Errorf("payment not found for trip: %s", tripID)
+	}
+
+	if payment.Status != domain.PaymentAuthorized {
+		return false, nil
+	}
+
+	payment.Status = domain.PaymentCaptured
+	payment.AmountCents = amountCents
+	payment.WalletCents = walletCents
+	payment.UpdatedAt = time.Now()
+	return true, nil
+}
+
+func (r *inmemRepository) RecordRefund(ctx context.Context, tripID string, amountCents, cardCents int64) (*domain.Payment, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	payment, ok := r.payments[tripID]
+	if !ok {
+		return nil, fmt.Errorf("payment not found for trip: %s", tripID)
+	}
+
+	if payment.Status != domain.PaymentCaptured {
+		return nil, fmt.Errorf("payment of trip %s is %s", tripID, payment.Status)
+	}
+	if payment.RefundedCents+amountCents > payment.AmountCents {
+		return nil, fmt.Errorf("refund of %d cents exceeds the captured amount of trip %s", amountCents, tripID)
+	}
+	if payment.CardRefundedCents+cardCents > payment.CardCents() {
+		return nil, fmt.Errorf("refund of %d cents exceeds the card charge of trip %s", cardCents, tripID)
+	}
+
+	payment.RefundedCents += amountCents
+	payment.CardRefundedCents += cardCents
+	if payment.RefundedCents == payment.AmountCents {
+		payment.Status = domain.PaymentRefunded
+	}
+	payment.UpdatedAt = time.Now()
+
+	copied := *payment
+	return &copied, nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"go-ride/services/trip-service/internal/domain"
+	"slices"
+	"strings"
+)
+
+func (r *inmemRepository) CreatePromo(ctx context.Context, promo *domain.PromoCode) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.promos[promo.Code]; ok {
+		return false, nil
+	}
+
+	r.promos[promo.Code] = copyPromo(promo)
+	return true, nil
+}
+
+func (r *inmemRepository) GetPromo(ctx context.Context, code string) (*domain.PromoCode, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	promo, ok := r.promos[code]
+	if !ok {
+		return nil, nil
+	}
+	return copyPromo(promo), nil
+}
+
+func (r *inmemRepository) ListPromos(ctx context.Context) ([]*domain.PromoCode, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	promos := make([]*domain.PromoCode, 0, len(r.promos))
+	for _, promo := range r.promos {
+		promos = append(promos, copyPromo(promo))
+	}
+	slices.SortFunc(promos, func(a, b *domain.PromoCode) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+	return promos, nil
+}
+
+func (r *inmemRepository) SetPromoActive(ctx context.Context, code string, active bool) (*domain.PromoCode, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	promo, ok := r.promos[code]
+	if !ok {
+		return nil, nil
+	}
+
+	promo.Active = active
+	return copyPromo(promo), nil
+}
+
+func (r *inmemRepository) CheckPromoUsage(ctx context.Context, promo *domain.PromoCode, userID, tripID string) error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.checkPromoUsage(promo, userID, tripID)
+}
+
+func (r *inmemRepository) RedeemPromo(ctx context.Context, promo *domain.PromoCode, redemption *domain.PromoRedemption) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.checkPromoUsage(promo, redemption.UserID, redemption.TripID); err != nil {
+		return err
+	}
+
+	copied := *redemption
+	r.redemptions[redemption.TripID] = &copied
+	if stored, ok := r.promos[promo.Code]; ok {
+		stored.Redemptions++
+	}
+	return nil
+}
+
+func (r *inmemRepository) ReleaseRedemption(ctx context.Context, tripID string) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	redemption, ok := r.redemptions[tripID]
+	if !ok {
+		return false, nil
+	}
+
+	delete(r.redemptions, tripID)
+	if promo, ok := r.promos[redemption.Code]; ok {
+		promo.Redemptions--
+	}
+	return true, nil
+}
+
+// checkPromoUsage must be called holding the mutex. The trip being redeemed
+// does not count as a previous ride of the user.
+func (r *inmemRepository) checkPromoUsage(promo *domain.PromoCode, userID, tripID string) error {
+	if stored, ok := r.promos[promo.Code]; ok && promo.MaxUses > 0 && stored.Redemptions >= promo.MaxUses {
+		return domain.ErrPromoExhausted
+	}
+
+	if promo.MaxUsesPerUser > 0 {
+		var uses int
+		for _, redemption := range r.redemptions {
+			if redemption.Code == promo.Code && redemption.UserID == userID {
+				uses++
+			}
+		}
+		if uses >= promo.MaxUsesPerUser {
+			return domain.ErrPromoUserLimit
+		}
+	}
+
+	if promo.FirstRideOnly {
+		for id, trip := range r.trips {
+			if id != tripID && trip.PassengerID.String() == userID && trip.Status != domain.CANCELED {
+				return domain.ErrPromoFirstRideOnly
+			}
+		}
+	}
+
+	return nil
+}
+
+func copyPromo(promo *domain.PromoCode) *domain.PromoCode {
+	copied := *promo
+	copied.Packages = slices.Clone(promo.Packages)
+	return &copied
+}
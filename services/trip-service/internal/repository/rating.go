@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"go-ride/services/trip-service/internal/domain"
+	"slices"
+)
+
+func (r *inmemRepository) SaveRating(ctx context.Context, rating *domain.Rating) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := rating.TripID + ":" + string(rating.RaterRole)
+	if _, ok := r.ratings[key]; ok {
+		return false, nil
+	}
+
+	copied := *rating
+	copied.Tags = slices.Clone(rating.Tags)
+	r.ratings[key] = &copied
+	r.ratingsByRatee[rating.RateeID] = append(r.ratingsByRatee[rating.RateeID], &copied)
+	return true, nil
+}
+
+func (r *inmemRepository) GetRating(ctx context.Context, tripID string, role domain.RatingRole) (*domain.Rating, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	rating, ok := r.ratings[tripID+":"+string(role)]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *rating
+	copied.Tags = slices.Clone(rating.Tags)
+	return &copied, nil
+}
+
+func (r *inmemRepository) GetRatingSummary(ctx context.Context, userID string, window int) (*domain.RatingSummary, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	ratings := r.ratingsByRatee[userID]
+	if len(ratings) > window {
+		ratings = ratings[len(ratings)-window:]
+	}
+
+	summary := &domain.RatingSummary{Count: len(ratings)}
+	if len(ratings) == 0 {
+		return summary, nil
+	}
+
+	var total int
+	for _, rating := range ratings {
+		total += rating.Stars
+	}
+	summary.Average = float64(total) / float64(len(ratings))
+	return summary, nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"go-ride/services/trip-service/internal/domain"
+)
+
+func (r *inmemRepository) SaveReceipt(ctx context.Context, receipt *domain.Receipt) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	copied := *receipt
+	r.receipts[receipt.TripID] = &copied
+	return nil
+}
+
+func (r *inmemRepository) GetReceipt(ctx context.Context, tripID string) (*domain.Receipt, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	receipt, ok := r.receipts[tripID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *receipt
+	return &copied, nil
+}
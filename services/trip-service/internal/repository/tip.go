@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"go-ride/services/trip-service/internal/domain"
+)
+
+func (r *inmemRepository) CreateTip(ctx context.Context, tip *domain.Tip) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.tips[tip.TripID]; ok {
+		return false, nil
+	}
+
+	copied := *tip
+	r.tips[tip.TripID] = &copied
+	return true, nil
+}
+
+func (r *inmemRepository) GetTip(ctx context.Context, tripID string) (*domain.Tip, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	tip, ok := r.tips[tripID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *tip
+	return &copied, nil
+}
+
+func (r *inmemRepository) UpdateTip(ctx context.Context, tip *domain.Tip) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	copied := *tip
+	r.tips[tip.TripID] = &copied
+	return nil
+}
+
+func (r *inmemRepository) DeleteTip(ctx context.Context, tripID string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.tips, tripID)
+	return nil
+}
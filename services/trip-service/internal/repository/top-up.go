@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"go-ride/services/trip-service/internal/domain"
+)
+
+func (r *inmemRepository) CreateTopUp(ctx context.Context, topUp *domain.TopUp) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	id := topUpID(topUp.UserID, topUp.Key)
+	if _, ok := r.topUps[id]; ok {
+		return false, nil
+	}
+
+	copied := *topUp
+	r.topUps[id] = &copied
+	return true, nil
+}
+
+func (r *inmemRepository) GetTopUp(ctx context.Context, userID, key string) (*domain.TopUp, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	topUp, ok := r.topUps[topUpID(userID, key)]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *topUp
+	return &copied, nil
+}
+
+func (r *inmemRepository) UpdateTopUp(ctx context.Context, topUp *domain.TopUp) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	copied := *topUp
+	r.topUps[topUpID(topUp.UserID, topUp.Key)] = &copied
+	return nil
+}
+
+func (r *inmemRepository) DeleteTopUp(ctx context.Context, userID, key string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.topUps, topUpID(userID, key))
+	return nil
+}
+
+func topUpID(userID, key string) string {
+	return userID + ":" + key
+}
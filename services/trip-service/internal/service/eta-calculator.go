@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	pd "go-ride/shared/proto/driver"
+	"go-ride/shared/types"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	etaCandidates = 5
+	etaRadiusKm   = 5.0
+)
+
+type etaCalculator struct {
+	drivers pd.DriverServiceClient
+	osrm    domain.OSRMService
+}
+
+func NewETACalculator(drivers pd.DriverServiceClient, osrm domain.OSRMService) *etaCalculator {
+	return &etaCalculator{
+		drivers: drivers,
+		osrm:    osrm,
+	}
+}
+
+// PickupETA returns the shortest drive time from the nearest idle drivers of the package to the pickup.
+func (c *etaCalculator) PickupETA(ctx context.Context, pickup *types.Coordinate, slug domain.PackageSlug) (*time.Duration, error) {
+	res, err := c.drivers.GetNearbyDrivers(ctx, &pd.GetNearbyDriversRequest{
+		Location: &pd.Coordinate{
+			Latitude:  pickup.Latitude,
+			Longitude: pickup.Longitude,
+		},
+		RadiusKm:    etaRadiusKm,
+		PackageSlug: string(slug),
+		Limit:       etaCandidates,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nearby drivers: %v", err)
+	}
+
+	if len(res.Drivers) == 0 {
+		return nil, nil
+	}
+
+	sources := make([]*types.Coordinate, len(res.Drivers))
+	for i, driver := range res.Drivers {
+		sources[i] = &types.Coordinate{
+			Latitude:  driver.Location.Latitude,
+			Longitude: driver.Location.Longitude,
+		}
+	}
+
+	durations, err := c.osrm.GetDurations(ctx, sources, pickup)
+	if err != nil {
+		return nil, err
+	}
+
+	var fastest *time.Duration
+	for _, seconds := range durations {
+		if seconds == nil {
+			continue
+		}
+
+		eta := time.Duration(*seconds * float64(time.Second))
+		if fastest == nil || eta < *fastest {
+			fastest = &eta
+		}
+	}
+
+	return fastest, nil
+}
+
+// DriverETA returns the drive time of an assigned driver to the pickup.
+func (c *etaCalculator) DriverETA(ctx context.Context, driverID string, pickup *types.Coordinate) (*time.Duration, error) {
+	if pickup == nil {
+		return nil, nil
+	}
+
+	res, err := c.drivers.GetDriverLocation(ctx, &pd.GetDriverLocationRequest{
+		DriverID: driverID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get driver location: %v", err)
+	}
+
+	source := &types.Coordinate{
+		Latitude:  res.Location.Latitude,
+		Longitude: res.Location.Longitude,
+	}
+
+	durations, err := c.osrm.GetDurations(ctx, []*types.Coordinate{source}, pickup)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(durations) == 0 || durations[0] == nil {
+		return nil, nil
+	}
+
+	eta := time.Duration(*durations[0] * float64(time.Second))
+	return &eta, nil
+}
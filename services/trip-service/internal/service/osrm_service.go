@@ -9,6 +9,8 @@ import (
 	"go-ride/shared/types"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 type OSRMService struct{}
@@ -53,3 +55,67 @@ func (s *OSRMService) GetRoute(
 
 	return &routeRes, nil
 }
+
+// GetDurations asks the OSRM table endpoint for the drive time from each source to the destination.
+// The durations follow the order of the sources, nil when a source has no route.
+func (s *OSRMService) GetDurations(
+	ctx context.Context,
+	sources []*types.Coordinate,
+	destination *types.Coordinate,
+) ([]*float64, error) {
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	coordinates := make([]string, 0, len(sources)+1)
+	sourceIndexes := make([]string, len(sources))
+	for i, source := range sources {
+		coordinates = append(coordinates, fmt.Sprintf("%f,%f", source.Longitude, source.Latitude))
+		sourceIndexes[i] = strconv.Itoa(i)
+	}
+	coordinates = append(coordinates, fmt.Sprintf("%f,%f", destination.Longitude, destination.Latitude))
+
+	url := fmt.Sprintf(
+		"http://router.project-osrm.org/table/v1/driving/%s?sources=%s&destinations=%d",
+		strings.Join(coordinates, ";"),
+		strings.Join(sourceIndexes, ";"),
+		len(sources),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("failed to fetch from OSRM API: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response: %w", err)
+	}
+
+	var tableRes tripTypes.OSRMTableResponse
+	if err := json.Unmarshal(body, &tableRes); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if tableRes.Code != "Ok" || len(tableRes.Durations) != len(sources) {
+		return nil, fmt.Errorf("unexpected OSRM table response: %s", tableRes.Code)
+	}
+
+	durations := make([]*float64, len(sources))
+	for i, row := range tableRes.Durations {
+		if len(row) > 0 {
+			durations[i] = row[0]
+		}
+	}
+
+	return durations, nil
+}
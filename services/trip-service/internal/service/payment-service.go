@@ -0,0 +1,532 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNoPaymentMethod       = errors.New("the user has no payment method")
+	ErrPaymentMethodNotFound = errors.New("payment method not found")
+	ErrPaymentNotFound       = errors.New("payment not found")
+	ErrInvalidPaymentStatus  = errors.New("invalid payment status")
+	ErrInvalidRefund         = errors.New("invalid refund amount")
+	ErrTopUpInProgress       = errors.New("a top-up with this key is being charged")
+	ErrFeeNotCharged         = errors.New("the provider did not charge the fee")
+)
+
+type paymentService struct {
+	repo     domain.PaymentRepository
+	wallet   domain.WalletService
+	provider domain.PaymentProvider
+	timeout  time.Duration
+}
+
+// NewPaymentService charges the riders through the provider, each call to it is bounded by the timeout.
+func NewPaymentService(repo domain.PaymentRepository, wallet domain.WalletService, provider domain.PaymentProvider, timeout time.Duration) *paymentService {
+	return &paymentService{
+		repo:     repo,
+		wallet:   wallet,
+		provider: provider,
+		timeout:  timeout,
+	}
+}
+
+// AddPaymentMethod stores a card tokenized by the provider, after checking the token is valid.
+func (s *paymentService) AddPaymentMethod(ctx context.Context, userID, token string) (*domain.PaymentMethod, error) {
+	var card *domain.CardDetails
+	err := s.callProvider(ctx, func(ctx context.Context) error {
+		var err error
+		card, err = s.provider.VerifyCard(ctx, token)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	method := &domain.PaymentMethod{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		Token:       token,
+		Brand:       card.Brand,
+		Last4:       card.Last4,
+		Fingerprint: card.Fingerprint,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.repo.SavePaymentMethod(ctx, method); err != nil {
+		return nil, fmt.Errorf("failed to save payment method: %w", err)
+	}
+
+	return method, nil
+}
+
+func (s *paymentService) ListPaymentMethods(ctx context.Context, userID string) ([]*domain.PaymentMethod, error) {
+	methods, err := s.repo.ListPaymentMethods(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment methods: %w", err)
+	}
+	return methods, nil
+}
+
+func (s *paymentService) DeletePaymentMethod(ctx context.Context, userID, methodID string) error {
+	deleted, err := s.repo.DeletePaymentMethod(ctx, userID, methodID)
+	if err != nil {
+		return fmt.Errorf("failed to delete payment method: %w", err)
+	}
+	if !deleted {
+		return ErrPaymentMethodNotFound
+	}
+	return nil
+}
+
+// AuthorizeTrip holds the fare, spending the wallet balance first and authorizing the rest on the payment method,
+// the oldest one of the passenger when no ID is given.
+// The trip ID is the idempotency key, so a retried authorization never holds the fare twice.
+func (s *paymentService) AuthorizeTrip(ctx context.Context, trip *domain.TripModel, methodID string) (*domain.Payment, error) {
+	tripID := trip.ID.String()
+	userID := trip.PassengerID.String()
+	amount := int64(math.Round(trip.RideFare.TotalPriceInCents))
+
+	held, err := s.wallet.HoldForTrip(ctx, userID, tripID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	payment := &domain.Payment{
+		TripID:      tripID,
+		UserID:      userID,
+		Status:      domain.PaymentAuthorized,
+		AmountCents: amount,
+		WalletCents: held,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if payment.CardCents() > 0 {
+		if err := s.authorizeCard(ctx, payment, methodID); err != nil {
+			s.release(ctx, payment)
+			return nil, err
+		}
+	}
+
+	if err := s.repo.SavePayment(ctx, payment); err != nil {
+		// An authorization we cannot track would hold the money of the passenger for nothing
+		s.release(ctx, payment)
+		return nil, fmt.Errorf("failed to save payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+// CaptureTrip charges the authorized fare once the trip is completed.
+// A capture retried after a later step failed does not charge the card again.
+func (s *paymentService) CaptureTrip(ctx context.Context, tripID string) (*domain.Payment, error) {
+	payment, err := s.payment(ctx, tripID, domain.PaymentAuthorized)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.CardCents() > 0 && !payment.CardSettled {
+		err = s.callProvider(ctx, func(ctx context.Context) error {
+			return s.provider.Capture(ctx, payment.AuthorizationID, payment.CardCents())
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := s.markCardSettled(ctx, payment); err != nil {
+			return nil, err
+		}
+	}
+
+	if payment.WalletCents > 0 {
+		if err := s.wallet.SettleTrip(ctx, tripID, payment.WalletCents); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.updateStatus(ctx, payment, domain.PaymentCaptured)
+}
+
+// VoidTrip releases the authorized fare of a trip that will not happen.
+func (s *paymentService) VoidTrip(ctx context.Context, tripID string) (*domain.Payment, error) {
+	payment, err := s.payment(ctx, tripID, domain.PaymentAuthorized)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.CardCents() > 0 && !payment.CardSettled {
+		err = s.callProvider(ctx, func(ctx context.Context) error {
+			return s.provider.Void(ctx, payment.AuthorizationID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := s.markCardSettled(ctx, payment); err != nil {
+			return nil, err
+		}
+	}
+
+	if payment.WalletCents > 0 {
+		if err := s.wallet.ReleaseTrip(ctx, payment.UserID, tripID, payment.WalletCents); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.updateStatus(ctx, payment, domain.PaymentVoided)
+}
+
+// ChargeCancellation keeps the cancellation fee out of the authorized fare and releases the rest of it.
+// The fee is taken from the wallet first, like the fare. Without a fee the payment is voided.
+// ErrFeeNotCharged tells the card was not charged, any other error leaves the payment to be settled again.
+func (s *paymentService) ChargeCancellation(ctx context.Context, tripID string, feeCents int64) (*domain.Payment, error) {
+	if feeCents <= 0 {
+		return s.VoidTrip(ctx, tripID)
+	}
+
+	payment, err := s.payment(ctx, tripID, domain.PaymentAuthorized)
+	if err != nil {
+		return nil, err
+	}
+
+	feeCents = min(feeCents, payment.AmountCents)
+	walletFee := min(feeCents, payment.WalletCents)
+	cardFee := feeCents - walletFee
+
+	if payment.CardCents() > 0 && !payment.CardSettled {
+		err = s.callProvider(ctx, func(ctx context.Context) error {
+			if cardFee > 0 {
+				return s.provider.Capture(ctx, payment.AuthorizationID, cardFee)
+			}
+			return s.provider.Void(ctx, payment.AuthorizationID)
+		})
+		if err != nil && cardFee > 0 {
+			return nil, fmt.Errorf("%w: %w", ErrFeeNotCharged, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := s.markCardSettled(ctx, payment); err != nil {
+			return nil, err
+		}
+	}
+
+	if walletFee > 0 {
+		if err := s.wallet.SettleTrip(ctx, tripID, walletFee); err != nil {
+			return nil, err
+		}
+	}
+	if rest := payment.WalletCents - walletFee; rest > 0 {
+		if err := s.wallet.ReleaseTrip(ctx, payment.UserID, tripID, rest); err != nil {
+			return nil, err
+		}
+	}
+
+	captured, err := s.repo.CapturePayment(ctx, tripID, feeCents, walletFee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+	if !captured {
+		return nil, fmt.Errorf("%w: payment of trip %s changed concurrently", ErrInvalidPaymentStatus, tripID)
+	}
+
+	payment.Status = domain.PaymentCaptured
+	payment.AmountCents = feeCents
+	payment.WalletCents = walletFee
+	return payment, nil
+}
+
+// RefundTrip gives back part of a captured fare, all that is left of it when the amount is zero.
+// The card is refunded first and the wallet gets the rest, unless the whole refund goes to the wallet.
+func (s *paymentService) RefundTrip(ctx context.Context, tripID string, amountCents int64, toWallet bool) (*domain.Payment, error) {
+	payment, err := s.payment(ctx, tripID, domain.PaymentCaptured)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := payment.AmountCents - payment.RefundedCents
+	if amountCents == 0 {
+		amountCents = remaining
+	}
+	if amountCents <= 0 || amountCents > remaining {
+		return nil, fmt.Errorf("%w: %d cents left to refund", ErrInvalidRefund, remaining)
+	}
+
+	var cardCents int64
+	if !toWallet {
+		cardCents = min(amountCents, payment.CardCents()-payment.CardRefundedCents)
+	}
+	walletCents := amountCents - cardCents
+
+	if cardCents > 0 {
+		err = s.callProvider(ctx, func(ctx context.Context) error {
+			return s.provider.Refund(ctx, payment.AuthorizationID, cardCents)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if walletCents > 0 {
+		// Refunds of a trip are told apart by how much had been refunded before them
+		key := strconv.FormatInt(payment.RefundedCents, 10)
+		if _, err := s.wallet.Refund(ctx, payment.UserID, tripID, walletCents, key); err != nil {
+			return nil, err
+		}
+	}
+
+	payment, err = s.repo.RecordRefund(ctx, tripID, amountCents, cardCents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record refund: %w", err)
+	}
+	return payment, nil
+}
+
+// TopUpWallet charges the payment method and adds the amount to the wallet of the rider.
+// Retrying with the same key returns the first top-up without charging again, and credits
+// the wallet when the card was charged but the credit failed.
+func (s *paymentService) TopUpWallet(ctx context.Context, userID, methodID string, amountCents int64, key string) (*domain.JournalEntry, error) {
+	if amountCents <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	entry, err := s.wallet.FindTopUp(ctx, userID, key)
+	if err != nil || entry != nil {
+		return entry, err
+	}
+
+	topUp, err := s.repo.GetTopUp(ctx, userID, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top-up: %w", err)
+	}
+	if topUp != nil {
+		if topUp.Status == domain.TopUpCaptured {
+			return s.wallet.TopUp(ctx, userID, topUp.AmountCents, key)
+		}
+		return nil, ErrTopUpInProgress
+	}
+
+	method, err := s.paymentMethod(ctx, userID, methodID)
+	if err != nil {
+		return nil, err
+	}
+
+	topUp = &domain.TopUp{
+		UserID:          userID,
+		Key:             key,
+		PaymentMethodID: method.ID,
+		Status:          domain.TopUpPending,
+		AmountCents:     amountCents,
+		CreatedAt:       time.Now(),
+	}
+	created, err := s.repo.CreateTopUp(ctx, topUp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save top-up: %w", err)
+	}
+	if !created {
+		return nil, ErrTopUpInProgress
+	}
+
+	err = s.callProvider(ctx, func(ctx context.Context) error {
+		var err error
+		topUp.AuthorizationID, err = s.provider.Authorize(ctx, method.Token, amountCents, topUpKey(userID, key))
+		if err != nil {
+			return err
+		}
+		return s.provider.Capture(ctx, topUp.AuthorizationID, amountCents)
+	})
+	if err != nil {
+		if topUp.AuthorizationID != "" {
+			voidErr := s.callProvider(ctx, func(ctx context.Context) error {
+				return s.provider.Void(ctx, topUp.AuthorizationID)
+			})
+			if voidErr != nil {
+				log.Printf("failed to void the top-up authorization %s of %s: %v", key, userID, voidErr)
+			}
+		}
+		if deleteErr := s.repo.DeleteTopUp(ctx, userID, key); deleteErr != nil {
+			log.Printf("failed to release the top-up %s of %s: %v", key, userID, deleteErr)
+		}
+		return nil, err
+	}
+
+	topUp.Status = domain.TopUpCaptured
+	if err := s.repo.UpdateTopUp(ctx, topUp); err != nil {
+		return nil, fmt.Errorf("failed to save top-up: %w", err)
+	}
+
+	return s.wallet.TopUp(ctx, userID, amountCents, key)
+}
+
+// ChargeTip charges the tip on the payment method, by default the card that paid for the trip.
+// Tips never come out of the wallet, which only pays for fares.
+func (s *paymentService) ChargeTip(ctx context.Context, tip *domain.Tip, methodID string) error {
+	if tip.AmountCents <= 0 {
+		return ErrInvalidAmount
+	}
+
+	if methodID == "" {
+		payment, err := s.repo.GetPayment(ctx, tip.TripID)
+		if err != nil {
+			return fmt.Errorf("failed to get payment: %w", err)
+		}
+		if payment != nil {
+			methodID = payment.PaymentMethodID
+		}
+	}
+
+	method, err := s.paymentMethod(ctx, tip.UserID, methodID)
+	if err != nil {
+		return err
+	}
+
+	var authorizationID string
+	err = s.callProvider(ctx, func(ctx context.Context) error {
+		var err error
+		authorizationID, err = s.provider.Authorize(ctx, method.Token, tip.AmountCents, "tip:"+tip.ID)
+		if err != nil {
+			return err
+		}
+		return s.provider.Capture(ctx, authorizationID, tip.AmountCents)
+	})
+	if err != nil {
+		if authorizationID != "" {
+			voidErr := s.callProvider(ctx, func(ctx context.Context) error {
+				return s.provider.Void(ctx, authorizationID)
+			})
+			if voidErr != nil {
+				log.Printf("failed to void the tip authorization of trip %s: %v", tip.TripID, voidErr)
+			}
+		}
+		return err
+	}
+
+	tip.PaymentMethodID = method.ID
+	tip.AuthorizationID = authorizationID
+	return nil
+}
+
+func (s *paymentService) authorizeCard(ctx context.Context, payment *domain.Payment, methodID string) error {
+	method, err := s.paymentMethod(ctx, payment.UserID, methodID)
+	if err != nil {
+		return err
+	}
+
+	var authorizationID string
+	err = s.callProvider(ctx, func(ctx context.Context) error {
+		var err error
+		authorizationID, err = s.provider.Authorize(ctx, method.Token, payment.CardCents(), payment.TripID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	payment.PaymentMethodID = method.ID
+	payment.AuthorizationID = authorizationID
+	return nil
+}
+
+// release undoes an authorization that did not go through, failures are only logged
+// since the caller is already returning the error that caused it.
+func (s *paymentService) release(ctx context.Context, payment *domain.Payment) {
+	if payment.AuthorizationID != "" {
+		err := s.callProvider(ctx, func(ctx context.Context) error {
+			return s.provider.Void(ctx, payment.AuthorizationID)
+		})
+		if err != nil {
+			log.Printf("failed to void the authorization of trip %s: %v", payment.TripID, err)
+		}
+	}
+
+	if payment.WalletCents > 0 {
+		if err := s.wallet.ReleaseTrip(ctx, payment.UserID, payment.TripID, payment.WalletCents); err != nil {
+			log.Printf("failed to release the wallet hold of trip %s: %v", payment.TripID, err)
+		}
+	}
+}
+
+func (s *paymentService) paymentMethod(ctx context.Context, userID, methodID string) (*domain.PaymentMethod, error) {
+	if methodID != "" {
+		method, err := s.repo.GetPaymentMethod(ctx, userID, methodID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get payment method: %w", err)
+		}
+		if method == nil {
+			return nil, ErrPaymentMethodNotFound
+		}
+		return method, nil
+	}
+
+	methods, err := s.repo.ListPaymentMethods(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment methods: %w", err)
+	}
+	if len(methods) == 0 {
+		return nil, ErrNoPaymentMethod
+	}
+	return methods[0], nil
+}
+
+func (s *paymentService) payment(ctx context.Context, tripID string, expected domain.PaymentStatus) (*domain.Payment, error) {
+	payment, err := s.repo.GetPayment(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+	if payment == nil {
+		return nil, ErrPaymentNotFound
+	}
+	if payment.Status != expected {
+		return nil, fmt.Errorf("%w: payment is %s, expected %s", ErrInvalidPaymentStatus, payment.Status, expected)
+	}
+	return payment, nil
+}
+
+// markCardSettled records the provider call that went through, the wallet and the payment
+// status are settled after it and a retry picks up from there.
+func (s *paymentService) markCardSettled(ctx context.Context, payment *domain.Payment) error {
+	marked, err := s.repo.MarkCardSettled(ctx, payment.TripID)
+	if err != nil {
+		return fmt.Errorf("failed to update payment: %w", err)
+	}
+	if !marked {
+		return fmt.Errorf("%w: payment of trip %s changed concurrently", ErrInvalidPaymentStatus, payment.TripID)
+	}
+
+	payment.CardSettled = true
+	return nil
+}
+
+func (s *paymentService) updateStatus(ctx context.Context, payment *domain.Payment, to domain.PaymentStatus) (*domain.Payment, error) {
+	updated, err := s.repo.UpdatePaymentStatus(ctx, payment.TripID, payment.Status, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+	if !updated {
+		return nil, fmt.Errorf("%w: payment of trip %s changed concurrently", ErrInvalidPaymentStatus, payment.TripID)
+	}
+
+	payment.Status = to
+	payment.UpdatedAt = time.Now()
+	return payment, nil
+}
+
+// callProvider bounds the call to the provider, a provider that does not answer in time is reported as a timeout.
+func (s *paymentService) callProvider(ctx context.Context, call func(ctx context.Context) error) error {
+	callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	err := call(callCtx)
+	if err != nil && !errors.Is(err, domain.ErrPaymentTimeout) && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %v", domain.ErrPaymentTimeout, err)
+	}
+	return err
+}
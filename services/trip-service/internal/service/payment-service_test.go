@@ -0,0 +1,487 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"go-ride/services/trip-service/internal/domain"
+	"go-ride/services/trip-service/internal/infrastructure/payments"
+	"go-ride/services/trip-service/internal/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var errWalletDown = errors.New("wallet unavailable")
+
+// flakyWallet fails the next settle, release or top-up calls, after the provider was already called.
+type flakyWallet struct {
+	domain.WalletService
+	failSettles  int
+	failReleases int
+	failTopUps   int
+}
+
+func (w *flakyWallet) SettleTrip(ctx context.Context, tripID string, amountCents int64) error {
+	if w.failSettles > 0 {
+		w.failSettles--
+		return errWalletDown
+	}
+	return w.WalletService.SettleTrip(ctx, tripID, amountCents)
+}
+
+func (w *flakyWallet) ReleaseTrip(ctx context.Context, userID, tripID string, amountCents int64) error {
+	if w.failReleases > 0 {
+		w.failReleases--
+		return errWalletDown
+	}
+	return w.WalletService.ReleaseTrip(ctx, userID, tripID, amountCents)
+}
+
+func (w *flakyWallet) TopUp(ctx context.Context, userID string, amountCents int64, key string) (*domain.JournalEntry, error) {
+	if w.failTopUps > 0 {
+		w.failTopUps--
+		return nil, errWalletDown
+	}
+	return w.WalletService.TopUp(ctx, userID, amountCents, key)
+}
+
+// countingProvider counts the calls that move money on the fake provider and fails the next captures.
+type countingProvider struct {
+	*payments.FakeProvider
+	captures     int
+	voids        int
+	failCaptures int
+}
+
+func (p *countingProvider) Capture(ctx context.Context, authorizationID string, amountCents int64) error {
+	if p.failCaptures > 0 {
+		p.failCaptures--
+		return domain.ErrPaymentDeclined
+	}
+	if err := p.FakeProvider.Capture(ctx, authorizationID, amountCents); err != nil {
+		return err
+	}
+	p.captures++
+	return nil
+}
+
+func (p *countingProvider) Void(ctx context.Context, authorizationID string) error {
+	if err := p.FakeProvider.Void(ctx, authorizationID); err != nil {
+		return err
+	}
+	p.voids++
+	return nil
+}
+
+type paymentFixture struct {
+	trips    domain.TripRepository
+	payments domain.PaymentRepository
+	ledger   domain.LedgerRepository
+	tips     domain.TipRepository
+	wallet   *flakyWallet
+	provider *countingProvider
+	service  *paymentService
+	settler  *paymentSettler
+}
+
+func newPaymentFixture(t *testing.T) *paymentFixture {
+	t.Helper()
+
+	repo := repository.NewInmemRepository()
+	wallet := &flakyWallet{WalletService: NewWalletService(repo)}
+	provider := &countingProvider{FakeProvider: payments.NewFakeProvider()}
+	service := NewPaymentService(repo, wallet, provider, time.Second)
+
+	return &paymentFixture{
+		trips:    repo,
+		payments: repo,
+		ledger:   repo,
+		tips:     repo,
+		wallet:   wallet,
+		provider: provider,
+		service:  service,
+		settler:  NewPaymentSettler(repo, repo, service),
+	}
+}
+
+// authorizedTrip creates a trip of the fare in the status, paid by a new rider with the wallet balance and a visa card.
+func (f *paymentFixture) authorizedTrip(t *testing.T, status domain.TripStatus, fareCents, walletCents int64) *domain.TripModel {
+	t.Helper()
+	ctx := context.Background()
+
+	trip := &domain.TripModel{
+		ID:          uuid.New(),
+		PassengerID: uuid.New(),
+		Status:      status,
+		RideFare:    &domain.RideFareModel{ID: uuid.New(), TotalPriceInCents: float64(fareCents)},
+		CompletedAt: time.Now(),
+	}
+	userID := trip.PassengerID.String()
+	if _, err := f.trips.CreateTrip(ctx, trip); err != nil {
+		t.Fatal(err)
+	}
+
+	if walletCents > 0 {
+		if _, err := f.wallet.Credit(ctx, userID, walletCents, "test", "test credit"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := f.service.AddPaymentMethod(ctx, userID, payments.TokenVisa); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.service.AuthorizeTrip(ctx, trip, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	return trip
+}
+
+func (f *paymentFixture) payment(t *testing.T, tripID string) *domain.Payment {
+	t.Helper()
+
+	payment, err := f.payments.GetPayment(context.Background(), tripID)
+	if err != nil || payment == nil {
+		t.Fatalf("payment of trip %s: %v, %v", tripID, payment, err)
+	}
+	return payment
+}
+
+func (f *paymentFixture) balance(t *testing.T, account string) int64 {
+	t.Helper()
+
+	balance, err := f.ledger.GetBalance(context.Background(), account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return balance
+}
+
+func TestCaptureTripChargesTheCardOnce(t *testing.T) {
+	f := newPaymentFixture(t)
+	trip := f.authorizedTrip(t, domain.COMPLETED, 1800, 0)
+
+	payment, err := f.service.CaptureTrip(context.Background(), trip.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Status != domain.PaymentCaptured {
+		t.Errorf("status = %s, want %s", payment.Status, domain.PaymentCaptured)
+	}
+
+	if _, err := f.service.CaptureTrip(context.Background(), trip.ID.String()); !errors.Is(err, ErrInvalidPaymentStatus) {
+		t.Errorf("second capture err = %v, want %v", err, ErrInvalidPaymentStatus)
+	}
+	if f.provider.captures != 1 {
+		t.Errorf("card captured %d times, want once", f.provider.captures)
+	}
+}
+
+func TestSettlerFinishesACaptureWhoseWalletStepFailed(t *testing.T) {
+	f := newPaymentFixture(t)
+	trip := f.authorizedTrip(t, domain.COMPLETED, 1800, 500)
+	tripID := trip.ID.String()
+
+	f.wallet.failSettles = 1
+	if _, err := f.service.CaptureTrip(context.Background(), tripID); !errors.Is(err, errWalletDown) {
+		t.Fatalf("capture err = %v, want %v", err, errWalletDown)
+	}
+	if payment := f.payment(t, tripID); payment.Status != domain.PaymentAuthorized || !payment.CardSettled {
+		t.Fatalf("payment is %s with card settled %t, want AUTHORIZED with the card settled", payment.Status, payment.CardSettled)
+	}
+
+	settled, err := f.settler.SettlePayments(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settled != 1 {
+		t.Errorf("settled %d payments, want 1", settled)
+	}
+
+	if payment := f.payment(t, tripID); payment.Status != domain.PaymentCaptured {
+		t.Errorf("status = %s, want %s", payment.Status, domain.PaymentCaptured)
+	}
+	if f.provider.captures != 1 {
+		t.Errorf("card captured %d times, want once", f.provider.captures)
+	}
+	if held := f.balance(t, domain.AccountWalletHolds); held != 0 {
+		t.Errorf("%d cents still held, want the hold settled", held)
+	}
+	if revenue := f.balance(t, domain.AccountTripRevenue); revenue != 500 {
+		t.Errorf("trip revenue = %d, want the 500 cents of the wallet", revenue)
+	}
+}
+
+func TestSettlerRetriesAFailedCapture(t *testing.T) {
+	f := newPaymentFixture(t)
+	trip := f.authorizedTrip(t, domain.COMPLETED, 1800, 0)
+	running := f.authorizedTrip(t, domain.IN_PROGRESS, 1200, 0)
+
+	f.provider.failCaptures = 1
+	if _, err := f.service.CaptureTrip(context.Background(), trip.ID.String()); !errors.Is(err, domain.ErrPaymentDeclined) {
+		t.Fatalf("capture err = %v, want %v", err, domain.ErrPaymentDeclined)
+	}
+
+	settled, err := f.settler.SettlePayments(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settled != 1 {
+		t.Errorf("settled %d payments, want 1", settled)
+	}
+	if payment := f.payment(t, trip.ID.String()); payment.Status != domain.PaymentCaptured {
+		t.Errorf("status = %s, want %s", payment.Status, domain.PaymentCaptured)
+	}
+	// A trip still running keeps its authorization
+	if payment := f.payment(t, running.ID.String()); payment.Status != domain.PaymentAuthorized {
+		t.Errorf("running trip payment = %s, want %s", payment.Status, domain.PaymentAuthorized)
+	}
+}
+
+func TestVoidTripReleasesTheWalletHold(t *testing.T) {
+	f := newPaymentFixture(t)
+	trip := f.authorizedTrip(t, domain.CANCELED, 1800, 500)
+	userID := trip.PassengerID.String()
+
+	if balance := f.balance(t, domain.WalletAccount(userID)); balance != 0 {
+		t.Fatalf("wallet = %d while held, want 0", balance)
+	}
+
+	f.wallet.failReleases = 1
+	if _, err := f.service.VoidTrip(context.Background(), trip.ID.String()); !errors.Is(err, errWalletDown) {
+		t.Fatalf("void err = %v, want %v", err, errWalletDown)
+	}
+	payment, err := f.service.VoidTrip(context.Background(), trip.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if payment.Status != domain.PaymentVoided {
+		t.Errorf("status = %s, want %s", payment.Status, domain.PaymentVoided)
+	}
+	if f.provider.voids != 1 {
+		t.Errorf("card voided %d times, want once", f.provider.voids)
+	}
+	if balance := f.balance(t, domain.WalletAccount(userID)); balance != 500 {
+		t.Errorf("wallet = %d, want the 500 cents back", balance)
+	}
+}
+
+// canceledTrip cancels an accepted trip for the fee, authorized like authorizedTrip.
+func (f *paymentFixture) canceledTrip(t *testing.T, fareCents, walletCents, feeCents int64) *domain.TripModel {
+	t.Helper()
+
+	trip := f.authorizedTrip(t, domain.ACCEPTED, fareCents, walletCents)
+	cancellation := &domain.Cancellation{
+		By:                      domain.CanceledByPassenger,
+		Reason:                  domain.CancelReasonLatePassenger,
+		FeeCents:                feeCents,
+		DriverCompensationCents: feeCents * 8 / 10,
+		CanceledAt:              time.Now(),
+	}
+	if canceled, err := f.trips.CancelTrip(context.Background(), trip.ID.String(), domain.ACCEPTED, cancellation); err != nil || !canceled {
+		t.Fatalf("failed to cancel trip %s: %v", trip.ID, err)
+	}
+
+	trip.Status = domain.CANCELED
+	trip.Cancellation = cancellation
+	return trip
+}
+
+func TestChargeCancellationTakesTheFeeFromTheWalletFirst(t *testing.T) {
+	f := newPaymentFixture(t)
+	trip := f.canceledTrip(t, 1800, 300, 500)
+	userID := trip.PassengerID.String()
+
+	payment, err := f.service.ChargeCancellation(context.Background(), trip.ID.String(), 500)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if payment.Status != domain.PaymentCaptured || payment.AmountCents != 500 || payment.WalletCents != 300 {
+		t.Errorf("payment = %s of %d cents with %d from the wallet, want CAPTURED of 500 with 300", payment.Status, payment.AmountCents, payment.WalletCents)
+	}
+	if f.provider.captures != 1 {
+		t.Errorf("card captured %d times, want once", f.provider.captures)
+	}
+	if balance := f.balance(t, domain.WalletAccount(userID)); balance != 0 {
+		t.Errorf("wallet = %d, want the 300 cents spent on the fee", balance)
+	}
+	if revenue := f.balance(t, domain.AccountTripRevenue); revenue != 300 {
+		t.Errorf("trip revenue = %d, want the 300 cents of the wallet", revenue)
+	}
+}
+
+func TestChargeCancellationTellsACardThatWasNotCharged(t *testing.T) {
+	f := newPaymentFixture(t)
+	trip := f.canceledTrip(t, 1800, 0, 500)
+
+	f.provider.failCaptures = 1
+	if _, err := f.service.ChargeCancellation(context.Background(), trip.ID.String(), 500); !errors.Is(err, ErrFeeNotCharged) {
+		t.Fatalf("err = %v, want %v", err, ErrFeeNotCharged)
+	}
+
+	// A failure once the card was charged is not mistaken for a declined fee
+	f.wallet.failSettles = 1
+	other := f.canceledTrip(t, 1800, 300, 500)
+	_, err := f.service.ChargeCancellation(context.Background(), other.ID.String(), 500)
+	if err == nil || errors.Is(err, ErrFeeNotCharged) {
+		t.Fatalf("err = %v, want a failure other than %v", err, ErrFeeNotCharged)
+	}
+}
+
+func TestSettlerChargesAFeeWhoseWalletStepFailed(t *testing.T) {
+	f := newPaymentFixture(t)
+	trip := f.canceledTrip(t, 1800, 300, 500)
+	tripID := trip.ID.String()
+
+	f.wallet.failSettles = 1
+	if _, err := f.service.ChargeCancellation(context.Background(), tripID, 500); !errors.Is(err, errWalletDown) {
+		t.Fatalf("err = %v, want %v", err, errWalletDown)
+	}
+
+	if _, err := f.settler.SettlePayments(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	payment := f.payment(t, tripID)
+	if payment.Status != domain.PaymentCaptured || payment.AmountCents != 500 {
+		t.Errorf("payment = %s of %d cents, want CAPTURED of 500", payment.Status, payment.AmountCents)
+	}
+	if f.provider.captures != 1 {
+		t.Errorf("card captured %d times, want once", f.provider.captures)
+	}
+	if held := f.balance(t, domain.AccountWalletHolds); held != 0 {
+		t.Errorf("%d cents still held, want the hold settled", held)
+	}
+}
+
+func TestSettlerVoidsAWaivedFee(t *testing.T) {
+	f := newPaymentFixture(t)
+	trips := NewTripService(f.trips, domain.CancellationPolicy{})
+	trip := f.canceledTrip(t, 1800, 0, 500)
+
+	f.provider.failCaptures = 1
+	if _, err := f.service.ChargeCancellation(context.Background(), trip.ID.String(), 500); !errors.Is(err, ErrFeeNotCharged) {
+		t.Fatalf("err = %v, want %v", err, ErrFeeNotCharged)
+	}
+	if err := trips.WaiveCancellationFee(context.Background(), trip); err != nil {
+		t.Fatal(err)
+	}
+	if trip.Cancellation.FeeCents != 0 || trip.Cancellation.DriverCompensationCents != 0 {
+		t.Errorf("waived cancellation = %+v, want no fee and no compensation", trip.Cancellation)
+	}
+
+	if _, err := f.settler.SettlePayments(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if payment := f.payment(t, trip.ID.String()); payment.Status != domain.PaymentVoided {
+		t.Errorf("status = %s, want %s", payment.Status, domain.PaymentVoided)
+	}
+	if f.provider.captures != 0 || f.provider.voids != 1 {
+		t.Errorf("card captured %d and voided %d times, want only voided", f.provider.captures, f.provider.voids)
+	}
+}
+
+// riderWithCard adds a visa card to a new rider and returns the rider and the card.
+func (f *paymentFixture) riderWithCard(t *testing.T) (string, string) {
+	t.Helper()
+
+	userID := uuid.NewString()
+	method, err := f.service.AddPaymentMethod(context.Background(), userID, payments.TokenVisa)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return userID, method.ID
+}
+
+func TestTopUpWalletChargesOnce(t *testing.T) {
+	f := newPaymentFixture(t)
+	userID, methodID := f.riderWithCard(t)
+
+	first, err := f.service.TopUpWallet(context.Background(), userID, methodID, 1000, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	retried, err := f.service.TopUpWallet(context.Background(), userID, methodID, 1000, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if retried.ID != first.ID {
+		t.Errorf("retry posted entry %s, want the first one %s", retried.ID, first.ID)
+	}
+	if f.provider.captures != 1 {
+		t.Errorf("card captured %d times, want once", f.provider.captures)
+	}
+	if balance := f.balance(t, domain.WalletAccount(userID)); balance != 1000 {
+		t.Errorf("wallet = %d, want 1000", balance)
+	}
+}
+
+func TestTopUpWalletCreditsAChargedTopUpOnRetry(t *testing.T) {
+	f := newPaymentFixture(t)
+	userID, methodID := f.riderWithCard(t)
+
+	f.wallet.failTopUps = 1
+	if _, err := f.service.TopUpWallet(context.Background(), userID, methodID, 1000, "key"); !errors.Is(err, errWalletDown) {
+		t.Fatalf("err = %v, want %v", err, errWalletDown)
+	}
+	if balance := f.balance(t, domain.WalletAccount(userID)); balance != 0 {
+		t.Fatalf("wallet = %d before the retry, want 0", balance)
+	}
+
+	if _, err := f.service.TopUpWallet(context.Background(), userID, methodID, 1000, "key"); err != nil {
+		t.Fatal(err)
+	}
+	if f.provider.captures != 1 {
+		t.Errorf("card captured %d times, want once", f.provider.captures)
+	}
+	if balance := f.balance(t, domain.WalletAccount(userID)); balance != 1000 {
+		t.Errorf("wallet = %d, want 1000", balance)
+	}
+}
+
+func TestTopUpWalletVoidsADeclinedCharge(t *testing.T) {
+	f := newPaymentFixture(t)
+	userID, methodID := f.riderWithCard(t)
+
+	f.provider.failCaptures = 1
+	if _, err := f.service.TopUpWallet(context.Background(), userID, methodID, 1000, "key"); !errors.Is(err, domain.ErrPaymentDeclined) {
+		t.Fatalf("err = %v, want %v", err, domain.ErrPaymentDeclined)
+	}
+
+	if f.provider.voids != 1 {
+		t.Errorf("authorization voided %d times, want once", f.provider.voids)
+	}
+	if topUp, err := f.payments.GetTopUp(context.Background(), userID, "key"); err != nil || topUp != nil {
+		t.Errorf("top-up = %+v, %v, want it released", topUp, err)
+	}
+	if balance := f.balance(t, domain.WalletAccount(userID)); balance != 0 {
+		t.Errorf("wallet = %d, want 0", balance)
+	}
+}
+
+func TestTopUpWalletRefusesATopUpInProgress(t *testing.T) {
+	f := newPaymentFixture(t)
+	userID, methodID := f.riderWithCard(t)
+
+	pending := &domain.TopUp{
+		UserID:          userID,
+		Key:             "key",
+		PaymentMethodID: methodID,
+		Status:          domain.TopUpPending,
+		AmountCents:     1000,
+		CreatedAt:       time.Now(),
+	}
+	if created, err := f.payments.CreateTopUp(context.Background(), pending); err != nil || !created {
+		t.Fatalf("failed to create the pending top-up: %v", err)
+	}
+
+	if _, err := f.service.TopUpWallet(context.Background(), userID, methodID, 1000, "key"); !errors.Is(err, ErrTopUpInProgress) {
+		t.Fatalf("err = %v, want %v", err, ErrTopUpInProgress)
+	}
+	if f.provider.captures != 0 {
+		t.Errorf("card captured %d times while the top-up was in progress, want none", f.provider.captures)
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"log"
+	"time"
+)
+
+type paymentSettler struct {
+	trips    domain.TripRepository
+	payments domain.PaymentRepository
+	service  domain.PaymentService
+}
+
+// NewPaymentSettler settles the payments left authorized when the capture at the end of the trip failed.
+func NewPaymentSettler(trips domain.TripRepository, payments domain.PaymentRepository, service domain.PaymentService) *paymentSettler {
+	return &paymentSettler{
+		trips:    trips,
+		payments: payments,
+		service:  service,
+	}
+}
+
+// SettlePayments captures the fare of the completed trips and charges the fee of the canceled ones
+// whose payment is still authorized. Trips still running are left alone. A payment that fails to be
+// settled is logged and retried on the next run.
+func (s *paymentSettler) SettlePayments(ctx context.Context) (int, error) {
+	authorized, err := s.payments.ListPaymentsByStatus(ctx, domain.PaymentAuthorized)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list payments: %w", err)
+	}
+
+	settled := 0
+	for _, payment := range authorized {
+		trip, err := s.trips.GetTripByID(ctx, payment.TripID)
+		if err != nil || trip == nil {
+			log.Printf("failed to get the trip of payment %s: %v", payment.TripID, err)
+			continue
+		}
+
+		switch trip.Status {
+		case domain.COMPLETED:
+			_, err = s.service.CaptureTrip(ctx, payment.TripID)
+		case domain.CANCELED:
+			var fee int64
+			if trip.Cancellation != nil {
+				fee = trip.Cancellation.FeeCents
+			}
+			_, err = s.service.ChargeCancellation(ctx, payment.TripID, fee)
+		default:
+			continue
+		}
+		if err != nil {
+			log.Printf("failed to settle the payment of trip %s: %v", payment.TripID, err)
+			continue
+		}
+		settled++
+	}
+
+	return settled, nil
+}
+
+// RunPaymentSettler settles the payments every interval until ctx is cancelled.
+func (s *paymentSettler) RunPaymentSettler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			settled, err := s.SettlePayments(ctx)
+			if err != nil {
+				log.Printf("failed to settle payments: %v", err)
+				continue
+			}
+
+			if settled > 0 {
+				log.Printf("settled %d payments", settled)
+			}
+		}
+	}
+}
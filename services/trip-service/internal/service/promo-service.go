@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPromo       = errors.New("invalid promo code")
+	ErrPromoExists        = errors.New("promo code already exists")
+	ErrPromoNotFound      = errors.New("promo code not found")
+	ErrPromoUnavailable   = errors.New("promo code is not active")
+	ErrPromoNotApplicable = errors.New("promo code does not apply to this trip")
+)
+
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+type promoService struct {
+	repo domain.PromoRepository
+}
+
+func NewPromoService(repo domain.PromoRepository) *promoService {
+	return &promoService{repo: repo}
+}
+
+// CreatePromo validates the rules of the code and stores it active.
+func (s *promoService) CreatePromo(ctx context.Context, promo *domain.PromoCode) (*domain.PromoCode, error) {
+	promo.Code = normalizePromoCode(promo.Code)
+	if err := validatePromo(promo); err != nil {
+		return nil, err
+	}
+
+	promo.Active = true
+	promo.Redemptions = 0
+	promo.CreatedAt = time.Now()
+
+	created, err := s.repo.CreatePromo(ctx, promo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save promo code: %w", err)
+	}
+	if !created {
+		return nil, ErrPromoExists
+	}
+	return promo, nil
+}
+
+// DeactivatePromo stops the code from being applied, trips that already redeemed it keep their discount.
+func (s *promoService) DeactivatePromo(ctx context.Context, code string) (*domain.PromoCode, error) {
+	promo, err := s.repo.SetPromoActive(ctx, normalizePromoCode(code), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update promo code: %w", err)
+	}
+	if promo == nil {
+		return nil, ErrPromoNotFound
+	}
+	return promo, nil
+}
+
+func (s *promoService) ListPromos(ctx context.Context) ([]*domain.PromoCode, error) {
+	promos, err := s.repo.ListPromos(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promo codes: %w", err)
+	}
+	return promos, nil
+}
+
+// ApplyToFares takes the discount of the code off every fare it applies to.
+// It fails when the user cannot use the code or no fare qualifies.
+func (s *promoService) ApplyToFares(ctx context.Context, code, userID string, fares []*domain.RideFareModel) error {
+	promo, err := s.getAvailablePromo(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.CheckPromoUsage(ctx, promo, userID, ""); err != nil {
+		return fmt.Errorf("failed to apply promo code: %w", err)
+	}
+
+	var applied bool
+	for _, fare := range fares {
+		discount := promo.Discount(fare.TotalPriceInCents, fare.PackageSlug)
+		if discount <= 0 {
+			continue
+		}
+
+		fare.PromoCode = promo.Code
+		fare.DiscountCents = discount
+		fare.TotalPriceInCents -= float64(discount)
+		applied = true
+	}
+
+	if !applied {
+		return ErrPromoNotApplicable
+	}
+	return nil
+}
+
+// RedeemForTrip records the use of the code the fare of the trip was discounted with.
+// The usage limits are checked again, as other trips may have used the code since the preview.
+func (s *promoService) RedeemForTrip(ctx context.Context, trip *domain.TripModel) error {
+	fare := trip.RideFare
+	if fare.PromoCode == "" {
+		return nil
+	}
+
+	promo, err := s.getAvailablePromo(ctx, fare.PromoCode)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.RedeemPromo(ctx, promo, &domain.PromoRedemption{
+		Code:          promo.Code,
+		UserID:        trip.PassengerID.String(),
+		TripID:        trip.ID.String(),
+		DiscountCents: fare.DiscountCents,
+		RedeemedAt:    time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to redeem promo code: %w", err)
+	}
+	return nil
+}
+
+// ReleaseTrip gives the use of the code back when the trip is canceled.
+func (s *promoService) ReleaseTrip(ctx context.Context, tripID string) error {
+	if _, err := s.repo.ReleaseRedemption(ctx, tripID); err != nil {
+		return fmt.Errorf("failed to release promo code: %w", err)
+	}
+	return nil
+}
+
+func (s *promoService) getAvailablePromo(ctx context.Context, code string) (*domain.PromoCode, error) {
+	promo, err := s.repo.GetPromo(ctx, normalizePromoCode(code))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+	if promo == nil {
+		return nil, ErrPromoNotFound
+	}
+	if !promo.Available(time.Now()) {
+		return nil, ErrPromoUnavailable
+	}
+	return promo, nil
+}
+
+func validatePromo(promo *domain.PromoCode) error {
+	if !promoCodePattern.MatchString(promo.Code) {
+		return fmt.Errorf("%w: the code must have 3 to 32 letters, digits, - or _", ErrInvalidPromo)
+	}
+
+	switch promo.DiscountType {
+	case domain.DiscountPercentage:
+		if promo.Value <= 0 || promo.Value > 100 {
+			return fmt.Errorf("%w: a percentage must be between 1 and 100", ErrInvalidPromo)
+		}
+	case domain.DiscountFixed:
+		if promo.Value <= 0 {
+			return fmt.Errorf("%w: a fixed discount must be positive", ErrInvalidPromo)
+		}
+	default:
+		return fmt.Errorf("%w: unknown discount type", ErrInvalidPromo)
+	}
+
+	if promo.MaxDiscountCents < 0 || promo.MinFareCents < 0 || promo.MaxUses < 0 || promo.MaxUsesPerUser < 0 {
+		return fmt.Errorf("%w: limits cannot be negative", ErrInvalidPromo)
+	}
+	for _, slug := range promo.Packages {
+		if slug == "" {
+			return fmt.Errorf("%w: unknown package", ErrInvalidPromo)
+		}
+	}
+	if !promo.StartsAt.IsZero() && !promo.EndsAt.IsZero() && !promo.EndsAt.After(promo.StartsAt) {
+		return fmt.Errorf("%w: the code must end after it starts", ErrInvalidPromo)
+	}
+
+	return nil
+}
+
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxRatingTags    = 5
+	maxRatingComment = 500
+)
+
+var (
+	ErrInvalidRating = errors.New("invalid rating")
+	ErrAlreadyRated  = errors.New("the trip was already rated")
+	ErrRatingClosed  = errors.New("the trip can no longer be rated")
+)
+
+type ratingService struct {
+	trips   domain.TripRepository
+	ratings domain.RatingRepository
+	// window is how long after the completion both sides can rate the trip
+	window time.Duration
+	// averageOver is the number of latest ratings the averages are computed on
+	averageOver int
+}
+
+func NewRatingService(trips domain.TripRepository, ratings domain.RatingRepository, window time.Duration, averageOver int) *ratingService {
+	return &ratingService{
+		trips:       trips,
+		ratings:     ratings,
+		window:      window,
+		averageOver: averageOver,
+	}
+}
+
+// RateTrip records the rating of the other side of a completed trip, once per side.
+// Retrying the same stars returns the saved rating, so the rated event can be published
+// again when it failed the first time.
+func (s *ratingService) RateTrip(ctx context.Context, tripID, userID string, stars int, tags []string, comment string) (*domain.Rating, error) {
+	trip, err := s.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trip: %v", err)
+	}
+	if trip == nil {
+		return nil, ErrTripNotFound
+	}
+
+	rating := &domain.Rating{
+		TripID:    tripID,
+		RaterID:   userID,
+		Stars:     stars,
+		Comment:   strings.TrimSpace(comment),
+		CreatedAt: time.Now(),
+	}
+
+	var allowedTags []string
+	switch userID {
+	case trip.PassengerID.String():
+		rating.RaterRole = domain.RatedByPassenger
+		rating.RateeID = trip.Driver.GetId()
+		allowedTags = domain.DriverRatingTags
+	case trip.Driver.GetId():
+		rating.RaterRole = domain.RatedByDriver
+		rating.RateeID = trip.PassengerID.String()
+		allowedTags = domain.PassengerRatingTags
+	default:
+		return nil, ErrNotTripPassenger
+	}
+
+	if trip.Status != domain.COMPLETED {
+		return nil, fmt.Errorf("%w: a %s trip cannot be rated", ErrInvalidTripStatus, trip.Status)
+	}
+	if rating.CreatedAt.Sub(trip.CompletedAt) > s.window {
+		return nil, ErrRatingClosed
+	}
+
+	if stars < 1 || stars > 5 {
+		return nil, fmt.Errorf("%w: stars must be between 1 and 5", ErrInvalidRating)
+	}
+	if utf8.RuneCountInString(rating.Comment) > maxRatingComment {
+		return nil, fmt.Errorf("%w: the comment is longer than %d characters", ErrInvalidRating, maxRatingComment)
+	}
+	for _, tag := range tags {
+		if !slices.Contains(allowedTags, tag) {
+			return nil, fmt.Errorf("%w: unknown tag %q", ErrInvalidRating, tag)
+		}
+		if !slices.Contains(rating.Tags, tag) {
+			rating.Tags = append(rating.Tags, tag)
+		}
+	}
+	if len(rating.Tags) > maxRatingTags {
+		return nil, fmt.Errorf("%w: at most %d tags", ErrInvalidRating, maxRatingTags)
+	}
+
+	saved, err := s.ratings.SaveRating(ctx, rating)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save rating: %w", err)
+	}
+	if !saved {
+		existing, err := s.ratings.GetRating(ctx, tripID, rating.RaterRole)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rating: %w", err)
+		}
+		if existing != nil && existing.Stars == stars {
+			return existing, nil
+		}
+		return nil, ErrAlreadyRated
+	}
+
+	return rating, nil
+}
+
+func (s *ratingService) GetRatingSummary(ctx context.Context, userID string) (*domain.RatingSummary, error) {
+	summary, err := s.ratings.GetRatingSummary(ctx, userID, s.averageOver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating summary: %w", err)
+	}
+	return summary, nil
+}
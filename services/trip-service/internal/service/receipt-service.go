@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"math"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidReceiptFormat = errors.New("invalid receipt format")
+)
+
+type receiptService struct {
+	trips    domain.TripRepository
+	payments domain.PaymentRepository
+	tips     domain.TipRepository
+	receipts domain.ReceiptRepository
+	renderer domain.ReceiptRenderer
+}
+
+func NewReceiptService(trips domain.TripRepository, payments domain.PaymentRepository, tips domain.TipRepository, receipts domain.ReceiptRepository, renderer domain.ReceiptRenderer) *receiptService {
+	return &receiptService{
+		trips:    trips,
+		payments: payments,
+		tips:     tips,
+		receipts: receipts,
+		renderer: renderer,
+	}
+}
+
+// IssueReceipt builds the receipt of a completed trip from its fare, payment and tip, replacing any previous one.
+func (s *receiptService) IssueReceipt(ctx context.Context, tripID string) (*domain.Receipt, error) {
+	trip, err := s.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trip: %v", err)
+	}
+	if trip == nil {
+		return nil, ErrTripNotFound
+	}
+	if trip.Status != domain.COMPLETED {
+		return nil, fmt.Errorf("%w: a %s trip has no receipt", ErrInvalidTripStatus, trip.Status)
+	}
+
+	fare := trip.RideFare
+	receipt := &domain.Receipt{
+		Number:        "GR-" + strings.ToUpper(strings.ReplaceAll(tripID, "-", "")[:12]),
+		TripID:        tripID,
+		PassengerID:   trip.PassengerID.String(),
+		DriverName:    trip.Driver.GetName(),
+		CarPlate:      trip.Driver.GetCarPlate(),
+		PackageSlug:   fare.PackageSlug,
+		Pickup:        fare.Pickup,
+		Destination:   fare.Destination,
+		DiscountCents: fare.DiscountCents,
+		PromoCode:     fare.PromoCode,
+		CompletedAt:   trip.CompletedAt,
+		IssuedAt:      time.Now(),
+	}
+	receipt.FareCents = int64(math.Round(fare.TotalPriceInCents)) + fare.DiscountCents
+	receipt.TotalCents = receipt.FareCents - receipt.DiscountCents
+
+	if fare.Route != nil && len(fare.Route.Routes) > 0 {
+		route := fare.Route.Routes[0]
+		receipt.Route = route.Geometry.Coordinates
+		receipt.DistanceMeters = route.Distance
+		receipt.DurationSeconds = route.Duration
+	}
+
+	payment, err := s.payments.GetPayment(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+	if payment != nil {
+		receipt.TotalCents = payment.AmountCents - payment.RefundedCents
+		receipt.RefundedCents = payment.RefundedCents
+		receipt.WalletCents = payment.WalletCents
+		if err := s.describePaymentMethod(ctx, receipt, payment.PaymentMethodID); err != nil {
+			return nil, err
+		}
+	}
+
+	tip, err := s.tips.GetTip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tip: %w", err)
+	}
+	if tip != nil && tip.Status == domain.TipCaptured {
+		receipt.TipCents = tip.AmountCents
+		receipt.TotalCents += tip.AmountCents
+		if receipt.PaymentMethod == "" {
+			if err := s.describePaymentMethod(ctx, receipt, tip.PaymentMethodID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The number is kept when the receipt is issued again
+	previous, err := s.receipts.GetReceipt(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+	if previous != nil {
+		receipt.Number = previous.Number
+	}
+
+	if err := s.receipts.SaveReceipt(ctx, receipt); err != nil {
+		return nil, fmt.Errorf("failed to save receipt: %w", err)
+	}
+
+	return receipt, nil
+}
+
+// RenderReceipt renders the receipt of a trip of the passenger, issuing it first if it was missed at completion.
+func (s *receiptService) RenderReceipt(ctx context.Context, tripID, userID string, format domain.ReceiptFormat) ([]byte, error) {
+	if format != domain.ReceiptHTML && format != domain.ReceiptPDF {
+		return nil, fmt.Errorf("%w: %q, expected html or pdf", ErrInvalidReceiptFormat, format)
+	}
+
+	receipt, err := s.receipts.GetReceipt(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+	if receipt == nil {
+		trip, err := s.trips.GetTripByID(ctx, tripID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get trip: %v", err)
+		}
+		if trip == nil {
+			return nil, ErrTripNotFound
+		}
+		if trip.PassengerID.String() != userID {
+			return nil, ErrNotTripPassenger
+		}
+
+		receipt, err = s.IssueReceipt(ctx, tripID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if receipt.PassengerID != userID {
+		return nil, ErrNotTripPassenger
+	}
+
+	document, err := s.renderer.Render(receipt, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render receipt: %w", err)
+	}
+	return document, nil
+}
+
+func (s *receiptService) describePaymentMethod(ctx context.Context, receipt *domain.Receipt, methodID string) error {
+	if methodID == "" {
+		return nil
+	}
+
+	method, err := s.payments.GetPaymentMethod(ctx, receipt.PassengerID, methodID)
+	if err != nil {
+		return fmt.Errorf("failed to get payment method: %w", err)
+	}
+	// The card may have been removed since, the receipt is still issued without it
+	if method != nil {
+		receipt.PaymentMethod = fmt.Sprintf("%s ending in %s", method.Brand, method.Last4)
+	}
+	return nil
+}
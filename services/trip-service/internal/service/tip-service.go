@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTip    = errors.New("invalid tip amount")
+	ErrAlreadyTipped = errors.New("the trip was already tipped")
+	ErrTipClosed     = errors.New("the trip can no longer be tipped")
+)
+
+type tipService struct {
+	trips    domain.TripRepository
+	tips     domain.TipRepository
+	payments domain.PaymentService
+	// window is how long after the completion the passenger can tip
+	window   time.Duration
+	maxCents int64
+}
+
+func NewTipService(trips domain.TripRepository, tips domain.TipRepository, payments domain.PaymentService, window time.Duration, maxCents int64) *tipService {
+	return &tipService{
+		trips:    trips,
+		tips:     tips,
+		payments: payments,
+		window:   window,
+		maxCents: maxCents,
+	}
+}
+
+// AddTip charges the passenger a tip for the driver of a completed trip, once per trip.
+// Retrying a captured tip of the same amount returns it without charging again, so the
+// tipped event can be published again when it failed the first time.
+func (s *tipService) AddTip(ctx context.Context, tripID, userID string, amountCents int64, methodID string) (*domain.Tip, error) {
+	trip, err := s.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trip: %v", err)
+	}
+	if trip == nil {
+		return nil, ErrTripNotFound
+	}
+	if trip.PassengerID.String() != userID {
+		return nil, ErrNotTripPassenger
+	}
+	if trip.Status != domain.COMPLETED {
+		return nil, fmt.Errorf("%w: a %s trip cannot be tipped", ErrInvalidTripStatus, trip.Status)
+	}
+
+	existing, err := s.tips.GetTip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tip: %w", err)
+	}
+	if existing != nil {
+		if existing.Status == domain.TipCaptured && existing.AmountCents == amountCents {
+			return existing, nil
+		}
+		return nil, ErrAlreadyTipped
+	}
+
+	now := time.Now()
+	if now.Sub(trip.CompletedAt) > s.window {
+		return nil, ErrTipClosed
+	}
+	if amountCents <= 0 || amountCents > s.maxCents {
+		return nil, fmt.Errorf("%w: the tip must be between 1 and %d cents", ErrInvalidTip, s.maxCents)
+	}
+
+	tip := &domain.Tip{
+		ID:          uuid.NewString(),
+		TripID:      tripID,
+		UserID:      userID,
+		DriverID:    trip.Driver.GetId(),
+		Status:      domain.TipPending,
+		AmountCents: amountCents,
+		CreatedAt:   now,
+	}
+
+	created, err := s.tips.CreateTip(ctx, tip)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save tip: %w", err)
+	}
+	if !created {
+		return nil, ErrAlreadyTipped
+	}
+
+	if err := s.payments.ChargeTip(ctx, tip, methodID); err != nil {
+		if deleteErr := s.tips.DeleteTip(ctx, tripID); deleteErr != nil {
+			log.Printf("failed to release the tip of trip %s: %v", tripID, deleteErr)
+		}
+		return nil, err
+	}
+
+	tip.Status = domain.TipCaptured
+	if err := s.tips.UpdateTip(ctx, tip); err != nil {
+		return nil, fmt.Errorf("failed to save tip: %w", err)
+	}
+
+	return tip, nil
+}
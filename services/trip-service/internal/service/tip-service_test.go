@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"go-ride/services/trip-service/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const testTipMaxCents = 5000
+
+func (f *paymentFixture) tipService(window time.Duration) *tipService {
+	return NewTipService(f.trips, f.tips, f.service, window, testTipMaxCents)
+}
+
+func TestAddTipChargesOnce(t *testing.T) {
+	f := newPaymentFixture(t)
+	tips := f.tipService(time.Hour)
+	trip := f.authorizedTrip(t, domain.COMPLETED, 1800, 0)
+	tripID, userID := trip.ID.String(), trip.PassengerID.String()
+
+	tip, err := tips.AddTip(context.Background(), tripID, userID, 300, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tip.Status != domain.TipCaptured {
+		t.Errorf("status = %s, want %s", tip.Status, domain.TipCaptured)
+	}
+
+	// A retry gets the captured tip back, so the tipped event can be published again
+	retried, err := tips.AddTip(context.Background(), tripID, userID, 300, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retried.ID != tip.ID {
+		t.Errorf("retry returned tip %s, want %s", retried.ID, tip.ID)
+	}
+	if _, err := tips.AddTip(context.Background(), tripID, userID, 500, ""); !errors.Is(err, ErrAlreadyTipped) {
+		t.Errorf("second tip err = %v, want %v", err, ErrAlreadyTipped)
+	}
+
+	if f.provider.captures != 1 {
+		t.Errorf("card captured %d times, want once", f.provider.captures)
+	}
+}
+
+func TestAddTipReleasesADeclinedTip(t *testing.T) {
+	f := newPaymentFixture(t)
+	tips := f.tipService(time.Hour)
+	trip := f.authorizedTrip(t, domain.COMPLETED, 1800, 0)
+	tripID, userID := trip.ID.String(), trip.PassengerID.String()
+
+	f.provider.failCaptures = 1
+	if _, err := tips.AddTip(context.Background(), tripID, userID, 300, ""); !errors.Is(err, domain.ErrPaymentDeclined) {
+		t.Fatalf("err = %v, want %v", err, domain.ErrPaymentDeclined)
+	}
+	if f.provider.voids != 1 {
+		t.Errorf("tip authorization voided %d times, want once", f.provider.voids)
+	}
+	if tip, err := f.tips.GetTip(context.Background(), tripID); err != nil || tip != nil {
+		t.Fatalf("tip = %+v, %v, want it released", tip, err)
+	}
+
+	// The passenger can tip again once the pending tip is released
+	if _, err := tips.AddTip(context.Background(), tripID, userID, 300, ""); err != nil {
+		t.Fatal(err)
+	}
+	if f.provider.captures != 1 {
+		t.Errorf("card captured %d times, want once", f.provider.captures)
+	}
+}
+
+func TestAddTipValidatesTheTip(t *testing.T) {
+	f := newPaymentFixture(t)
+	completed := f.authorizedTrip(t, domain.COMPLETED, 1800, 0)
+	running := f.authorizedTrip(t, domain.IN_PROGRESS, 1800, 0)
+
+	tests := []struct {
+		name        string
+		window      time.Duration
+		trip        *domain.TripModel
+		userID      string
+		amountCents int64
+		want        error
+	}{
+		{"no amount", time.Hour, completed, completed.PassengerID.String(), 0, ErrInvalidTip},
+		{"over the maximum", time.Hour, completed, completed.PassengerID.String(), testTipMaxCents + 1, ErrInvalidTip},
+		{"another user", time.Hour, completed, uuid.NewString(), 300, ErrNotTripPassenger},
+		{"trip not completed", time.Hour, running, running.PassengerID.String(), 300, ErrInvalidTripStatus},
+		{"window closed", 0, completed, completed.PassengerID.String(), 300, ErrTipClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.tipService(tt.window).AddTip(context.Background(), tt.trip.ID.String(), tt.userID, tt.amountCents, "")
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if f.provider.captures != 0 {
+		t.Errorf("card captured %d times, want none", f.provider.captures)
+	}
+}
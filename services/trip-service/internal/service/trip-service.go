@@ -2,20 +2,34 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go-ride/services/trip-service/internal/domain"
 	tripTypes "go-ride/services/trip-service/pkg/types"
 	"go-ride/shared/proto/trip"
+	"go-ride/shared/types"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrTripNotFound      = errors.New("trip not found")
+	ErrNotTripDriver     = errors.New("the trip is assigned to another driver")
+	ErrNotTripPassenger  = errors.New("the trip belongs to another passenger")
+	ErrInvalidTripStatus = errors.New("invalid trip status")
+)
+
 type tripService struct {
-	repo domain.TripRepository
+	repo   domain.TripRepository
+	policy domain.CancellationPolicy
 }
 
-func NewTripService(repo domain.TripRepository) *tripService {
-	return &tripService{repo: repo}
+func NewTripService(repo domain.TripRepository, policy domain.CancellationPolicy) *tripService {
+	return &tripService{
+		repo:   repo,
+		policy: policy,
+	}
 }
 
 func (s *tripService) EstimatePackagesPriceWithRoute(route *tripTypes.OSRMApiResponse) []*domain.RideFareModel {
@@ -75,6 +89,7 @@ func (s *tripService) GenerateTripFares(
 	rideFares []*domain.RideFareModel,
 	passengerID string,
 	route *tripTypes.OSRMApiResponse,
+	pickup, destination *types.Coordinate,
 ) ([]*domain.RideFareModel, error) {
 	fares := make([]*domain.RideFareModel, len(rideFares))
 
@@ -86,6 +101,11 @@ func (s *tripService) GenerateTripFares(
 			TotalPriceInCents: fare.TotalPriceInCents,
 			PackageSlug:       fare.PackageSlug,
 			Route:             route,
+			Pickup:            pickup,
+			Destination:       destination,
+			PickupETA:         fare.PickupETA,
+			PromoCode:         fare.PromoCode,
+			DiscountCents:     fare.DiscountCents,
 		}
 
 		if err := s.repo.SaveRideFare(ctx, newFare); err != nil {
@@ -127,7 +147,7 @@ func (s *tripService) CreateTrip(ctx context.Context, fare *domain.RideFareModel
 	trip := &domain.TripModel{
 		ID:          uuid.New(),
 		PassengerID: passengerID,
-		Status:      "pending",
+		Status:      domain.REQUESTED,
 		RideFare:    fare,
 		Driver:      &trip.TripDriver{},
 	}
@@ -135,17 +155,200 @@ func (s *tripService) CreateTrip(ctx context.Context, fare *domain.RideFareModel
 	return s.repo.CreateTrip(ctx, trip)
 }
 
-// TODO: Adicionar um endpoint que cancela uma viagem.
-// Basicamente será pegar o ID do tripModel e atualizar o status para CANCELED
-// PS: Não deve ser possível cancelar se já estiver em progresso
-// PS: Nao deve ser possível cancelar se já estiver completa
-// PS: Se for cancelada pelo passageiro e estiver com o status ACCEPTED, então devo avisar o motorista para que ele possa buscar novas viagens
-// PS: Se for cancelada pelo motorista e estiver com o status ACCEPTED, então devo avisar o passageiro para que ele possa buscar novas viagens
-
-// TODO: Adicionar um endpoint para encerrar uma viagem
-// Basicamente será pegar o ID do tripModel e atualizar o status para COMPLETED
-// PS: Só deve ser possível encerrar se já estiver em progresso
-// PS: Nao deve ser possível encerrar se já estiver completa
-// PS: Não deve ser possível encerrar se a corrida estiver com o status de ACCEPTED
-// PS: Somente o motorista pode encerrar uma viagem
-// PS: O usuário precisa ser notificado que a viagem dele foi encerrada
+func (s *tripService) GetTripByID(ctx context.Context, tripID string) (*domain.TripModel, error) {
+	trip, err := s.repo.GetTripByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trip: %v", err)
+	}
+
+	if trip == nil {
+		return nil, fmt.Errorf("trip does not exists with ID: %s", tripID)
+	}
+
+	return trip, nil
+}
+
+// AssignDriver accepts the trip on behalf of the driver found by driver-service.
+// A trip canceled while the driver was searched for cannot be accepted anymore.
+func (s *tripService) AssignDriver(ctx context.Context, tripID string, driver *trip.TripDriver) error {
+	assigned, err := s.repo.AssignDriver(ctx, tripID, driver, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to assign driver: %v", err)
+	}
+	if !assigned {
+		return fmt.Errorf("%w: trip %s is no longer %s", ErrInvalidTripStatus, tripID, domain.REQUESTED)
+	}
+	return nil
+}
+
+// StartTrip is called by the driver once the passenger is on board.
+func (s *tripService) StartTrip(ctx context.Context, tripID, driverID string) (*domain.TripModel, error) {
+	return s.transitionTrip(ctx, tripID, driverID, domain.ACCEPTED, domain.IN_PROGRESS)
+}
+
+// CompleteTrip is called by the driver at the destination, only a trip in progress can be completed.
+// The ratings of the trip are open from then on.
+func (s *tripService) CompleteTrip(ctx context.Context, tripID, driverID string) (*domain.TripModel, error) {
+	trip, err := s.getTrip(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if trip.Driver.GetId() != driverID {
+		return nil, ErrNotTripDriver
+	}
+	// A retry gets the completed trip back, so the completed event is published again
+	// when it failed the first time
+	if trip.Status == domain.COMPLETED {
+		return trip, nil
+	}
+
+	completedAt := time.Now()
+	completed, err := s.repo.CompleteTrip(ctx, tripID, completedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update trip: %v", err)
+	}
+	if !completed {
+		return nil, fmt.Errorf("%w: trip is %s, expected %s", ErrInvalidTripStatus, trip.Status, domain.IN_PROGRESS)
+	}
+
+	trip.Status = domain.COMPLETED
+	trip.CompletedAt = completedAt
+	return trip, nil
+}
+
+// ArriveAtPickup is called by the driver at the pickup, the no-show grace period starts then.
+func (s *tripService) ArriveAtPickup(ctx context.Context, tripID, driverID string) (*domain.TripModel, error) {
+	trip, err := s.getTrip(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if trip.Driver.GetId() != driverID {
+		return nil, ErrNotTripDriver
+	}
+
+	arrivedAt := time.Now()
+	marked, err := s.repo.MarkArrived(ctx, tripID, arrivedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update trip: %v", err)
+	}
+	if !marked {
+		return nil, fmt.Errorf("%w: trip is %s, or the driver already arrived", ErrInvalidTripStatus, trip.Status)
+	}
+
+	trip.ArrivedAt = arrivedAt
+	return trip, nil
+}
+
+// CancelTrip is called by the passenger, or the driver, before the trip starts.
+// The fee charged to the passenger follows the cancellation policy.
+func (s *tripService) CancelTrip(ctx context.Context, tripID, userID string, by domain.CanceledBy) (*domain.TripModel, error) {
+	trip, err := s.getTrip(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch by {
+	case domain.CanceledByPassenger:
+		if trip.PassengerID.String() != userID {
+			return nil, ErrNotTripPassenger
+		}
+		if trip.Status != domain.REQUESTED && trip.Status != domain.ACCEPTED {
+			return nil, fmt.Errorf("%w: a %s trip cannot be canceled", ErrInvalidTripStatus, trip.Status)
+		}
+	case domain.CanceledByDriver:
+		if trip.Driver.GetId() != userID {
+			return nil, ErrNotTripDriver
+		}
+		if trip.Status != domain.ACCEPTED {
+			return nil, fmt.Errorf("%w: a %s trip cannot be canceled", ErrInvalidTripStatus, trip.Status)
+		}
+	}
+
+	return s.cancel(ctx, trip, s.policy.Cancel(trip, by, time.Now()))
+}
+
+// CancelRequestedTrip gives up on a trip no driver has accepted yet, free of charge.
+func (s *tripService) CancelRequestedTrip(ctx context.Context, tripID, reason string) (*domain.TripModel, error) {
+	trip, err := s.getTrip(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if trip.Status != domain.REQUESTED {
+		return nil, fmt.Errorf("%w: trip is %s, expected %s", ErrInvalidTripStatus, trip.Status, domain.REQUESTED)
+	}
+
+	return s.cancel(ctx, trip, &domain.Cancellation{
+		By:         domain.CanceledBySystem,
+		Reason:     reason,
+		CanceledAt: time.Now(),
+	})
+}
+
+// SetMatchTimeoutToken keeps the token of the scheduled match timeout of the trip, to cancel it once a driver accepts.
+func (s *tripService) SetMatchTimeoutToken(ctx context.Context, tripID, token string) error {
+	if err := s.repo.SetMatchTimeoutToken(ctx, tripID, token); err != nil {
+		return fmt.Errorf("failed to update trip: %v", err)
+	}
+	return nil
+}
+
+// WaiveCancellationFee drops the fee of a canceled trip that could not be charged, the driver
+// is only compensated out of a fee that was collected.
+func (s *tripService) WaiveCancellationFee(ctx context.Context, trip *domain.TripModel) error {
+	if err := s.repo.WaiveCancellationFee(ctx, trip.ID.String()); err != nil {
+		return fmt.Errorf("failed to update trip: %v", err)
+	}
+
+	waived := *trip.Cancellation
+	waived.FeeCents = 0
+	waived.DriverCompensationCents = 0
+	trip.Cancellation = &waived
+	return nil
+}
+
+func (s *tripService) cancel(ctx context.Context, trip *domain.TripModel, cancellation *domain.Cancellation) (*domain.TripModel, error) {
+	canceled, err := s.repo.CancelTrip(ctx, trip.ID.String(), trip.Status, cancellation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update trip: %v", err)
+	}
+	if !canceled {
+		return nil, fmt.Errorf("%w: trip changed while canceling it", ErrInvalidTripStatus)
+	}
+
+	trip.Status = domain.CANCELED
+	trip.Cancellation = cancellation
+	return trip, nil
+}
+
+func (s *tripService) getTrip(ctx context.Context, tripID string) (*domain.TripModel, error) {
+	trip, err := s.repo.GetTripByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trip: %v", err)
+	}
+	if trip == nil {
+		return nil, ErrTripNotFound
+	}
+	return trip, nil
+}
+
+func (s *tripService) transitionTrip(ctx context.Context, tripID, driverID string, from, to domain.TripStatus) (*domain.TripModel, error) {
+	trip, err := s.getTrip(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	if trip.Driver.GetId() != driverID {
+		return nil, ErrNotTripDriver
+	}
+
+	updated, err := s.repo.UpdateTripStatus(ctx, tripID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update trip: %v", err)
+	}
+	if !updated {
+		return nil, fmt.Errorf("%w: trip is %s, expected %s", ErrInvalidTripStatus, trip.Status, from)
+	}
+
+	trip.Status = to
+	return trip, nil
+}
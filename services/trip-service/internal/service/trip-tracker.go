@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"go-ride/shared/types"
+	"sync"
+	"time"
+)
+
+const (
+	TargetPickup      = "pickup"
+	TargetDestination = "destination"
+)
+
+type tripETA struct {
+	target     string
+	duration   time.Duration
+	computedAt time.Time
+}
+
+type tripTracker struct {
+	repo domain.TripRepository
+	osrm domain.OSRMService
+	// refreshInterval is how often the ETA is recomputed with OSRM, in between it counts down
+	refreshInterval time.Duration
+	etas            map[string]*tripETA // tripID -> last computed ETA
+	mutex           sync.Mutex
+}
+
+func NewTripTracker(repo domain.TripRepository, osrm domain.OSRMService, refreshInterval time.Duration) *tripTracker {
+	return &tripTracker{
+		repo:            repo,
+		osrm:            osrm,
+		refreshInterval: refreshInterval,
+		etas:            make(map[string]*tripETA),
+	}
+}
+
+// TrackDriverLocation returns the update to relay to the passenger, or nil when the trip
+// is not ACCEPTED nor IN_PROGRESS. The ETA targets the pickup until the trip starts.
+func (t *tripTracker) TrackDriverLocation(ctx context.Context, tripID string, location *types.Coordinate) (*domain.DriverLocationUpdate, error) {
+	trip, err := t.repo.GetTripByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trip: %v", err)
+	}
+
+	var target string
+	var targetLocation *types.Coordinate
+	switch {
+	case trip == nil:
+		return nil, nil
+	case trip.Status == domain.ACCEPTED:
+		target, targetLocation = TargetPickup, trip.RideFare.Pickup
+	case trip.Status == domain.IN_PROGRESS:
+		target, targetLocation = TargetDestination, trip.RideFare.Destination
+	default:
+		t.forget(tripID)
+		return nil, nil
+	}
+
+	update := &domain.DriverLocationUpdate{
+		Trip:     trip,
+		Location: location,
+		Target:   target,
+	}
+
+	if targetLocation == nil {
+		return update, nil
+	}
+
+	update.ETA, err = t.eta(ctx, tripID, target, location, targetLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	return update, nil
+}
+
+func (t *tripTracker) eta(ctx context.Context, tripID, target string, from, to *types.Coordinate) (time.Duration, error) {
+	t.mutex.Lock()
+	cached, exists := t.etas[tripID]
+	t.mutex.Unlock()
+
+	now := time.Now()
+	if exists && cached.target == target && now.Sub(cached.computedAt) < t.refreshInterval {
+		return max(cached.duration-now.Sub(cached.computedAt), 0), nil
+	}
+
+	route, err := t.osrm.GetRoute(ctx, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get route to the %s: %v", target, err)
+	}
+	if len(route.Routes) == 0 {
+		return 0, fmt.Errorf("no route to the %s", target)
+	}
+
+	duration := time.Duration(route.Routes[0].Duration * float64(time.Second))
+
+	t.mutex.Lock()
+	t.etas[tripID] = &tripETA{
+		target:     target,
+		duration:   duration,
+		computedAt: now,
+	}
+	t.mutex.Unlock()
+
+	return duration, nil
+}
+
+func (t *tripTracker) forget(tripID string) {
+	t.mutex.Lock()
+	delete(t.etas, tripID)
+	t.mutex.Unlock()
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/services/trip-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAmount = errors.New("amount must be positive")
+)
+
+// holdAttempts bounds the retries of a hold racing with another spending of the same wallet
+const holdAttempts = 3
+
+type walletService struct {
+	ledger domain.LedgerRepository
+}
+
+func NewWalletService(ledger domain.LedgerRepository) *walletService {
+	return &walletService{ledger: ledger}
+}
+
+func (s *walletService) GetBalance(ctx context.Context, userID string) (int64, error) {
+	balance, err := s.ledger.GetBalance(ctx, domain.WalletAccount(userID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get wallet balance: %w", err)
+	}
+	return balance, nil
+}
+
+func (s *walletService) ListEntries(ctx context.Context, userID string, limit int) ([]*domain.JournalEntry, error) {
+	entries, err := s.ledger.ListAccountJournals(ctx, domain.WalletAccount(userID), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallet entries: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *walletService) ListJournals(ctx context.Context, afterSequence int64, limit int) ([]*domain.JournalEntry, error) {
+	entries, err := s.ledger.ListJournals(ctx, afterSequence, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journals: %w", err)
+	}
+	return entries, nil
+}
+
+// Credit gives the rider balance paid by the platform, promotions and goodwill gestures.
+func (s *walletService) Credit(ctx context.Context, userID string, amountCents int64, key, description string) (*domain.JournalEntry, error) {
+	return s.fund(ctx, domain.JournalCredit, domain.AccountCredits, userID, amountCents, "credit:"+userID+":"+key, description)
+}
+
+// TopUp adds to the wallet the cents the rider paid with a card.
+func (s *walletService) TopUp(ctx context.Context, userID string, amountCents int64, key string) (*domain.JournalEntry, error) {
+	return s.fund(ctx, domain.JournalTopUp, domain.AccountTopUps, userID, amountCents, topUpKey(userID, key), "wallet top-up")
+}
+
+// FindTopUp returns the top-up already posted with the key, nil when there is none.
+func (s *walletService) FindTopUp(ctx context.Context, userID, key string) (*domain.JournalEntry, error) {
+	entry, err := s.ledger.GetJournal(ctx, topUpKey(userID, key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal: %w", err)
+	}
+	return entry, nil
+}
+
+// Refund gives back to the wallet part of the fare of a trip.
+func (s *walletService) Refund(ctx context.Context, userID, tripID string, amountCents int64, key string) (*domain.JournalEntry, error) {
+	return s.fund(ctx, domain.JournalRefund, domain.AccountRefunds, userID, amountCents, "refund:"+tripID+":"+key, "refund of trip "+tripID)
+}
+
+// HoldForTrip sets aside as much of the balance as it can, up to the fare, and returns the held cents.
+// Holding again for the same trip returns what was held the first time.
+func (s *walletService) HoldForTrip(ctx context.Context, userID, tripID string, maxCents int64) (int64, error) {
+	wallet := domain.WalletAccount(userID)
+
+	// The first hold emptied the balance it is checked against, it is looked up by its key
+	existing, err := s.ledger.GetJournal(ctx, "trip_hold:"+tripID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get journal: %w", err)
+	}
+	if existing != nil {
+		return existing.Postings[1].AmountCents, nil
+	}
+
+	for range holdAttempts {
+		balance, err := s.ledger.GetBalance(ctx, wallet)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get wallet balance: %w", err)
+		}
+
+		held := min(balance, maxCents)
+		if held <= 0 {
+			return 0, nil
+		}
+
+		entry, err := s.post(ctx, domain.JournalTripHold, "trip_hold:"+tripID, "fare of trip "+tripID,
+			domain.Posting{Account: wallet, AmountCents: -held},
+			domain.Posting{Account: domain.AccountWalletHolds, AmountCents: held},
+		)
+		if errors.Is(err, domain.ErrInsufficientBalance) {
+			// The wallet was spent in the meantime, the hold is retried with what is left
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		return entry.Postings[1].AmountCents, nil
+	}
+
+	return 0, fmt.Errorf("failed to hold the wallet of %s: %w", userID, domain.ErrInsufficientBalance)
+}
+
+// ReleaseTrip gives the held cents back to the wallet when the trip does not happen.
+func (s *walletService) ReleaseTrip(ctx context.Context, userID, tripID string, amountCents int64) error {
+	_, err := s.post(ctx, domain.JournalTripRelease, "trip_release:"+tripID, "release of trip "+tripID,
+		domain.Posting{Account: domain.AccountWalletHolds, AmountCents: -amountCents},
+		domain.Posting{Account: domain.WalletAccount(userID), AmountCents: amountCents},
+	)
+	return err
+}
+
+// SettleTrip turns the held cents into revenue once the trip is completed.
+func (s *walletService) SettleTrip(ctx context.Context, tripID string, amountCents int64) error {
+	_, err := s.post(ctx, domain.JournalTripSettle, "trip_settle:"+tripID, "fare of trip "+tripID,
+		domain.Posting{Account: domain.AccountWalletHolds, AmountCents: -amountCents},
+		domain.Posting{Account: domain.AccountTripRevenue, AmountCents: amountCents},
+	)
+	return err
+}
+
+func (s *walletService) fund(ctx context.Context, kind domain.JournalKind, source, userID string, amountCents int64, key, description string) (*domain.JournalEntry, error) {
+	if amountCents <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	entry, err := s.post(ctx, kind, key, description,
+		domain.Posting{Account: source, AmountCents: -amountCents},
+		domain.Posting{Account: domain.WalletAccount(userID), AmountCents: amountCents},
+	)
+	return entry, err
+}
+
+func topUpKey(userID, key string) string {
+	return "top_up:" + userID + ":" + key
+}
+
+// post is idempotent, the entry already posted with the key is returned instead of a new one.
+func (s *walletService) post(ctx context.Context, kind domain.JournalKind, key, description string, postings ...domain.Posting) (*domain.JournalEntry, error) {
+	entry, _, err := s.ledger.PostJournal(ctx, &domain.JournalEntry{
+		ID:          uuid.NewString(),
+		Key:         key,
+		Kind:        kind,
+		Description: description,
+		Postings:    postings,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to post %s journal: %w", kind, err)
+	}
+	return entry, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"go-ride/services/trip-service/internal/domain"
+	"go-ride/services/trip-service/internal/repository"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func newTestWallet(t *testing.T, balanceCents int64) (*walletService, domain.LedgerRepository, string) {
+	t.Helper()
+
+	ledger := repository.NewInmemRepository()
+	wallet := NewWalletService(ledger)
+	userID := uuid.NewString()
+	if balanceCents > 0 {
+		if _, err := wallet.Credit(context.Background(), userID, balanceCents, "test", "test credit"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return wallet, ledger, userID
+}
+
+func balanceOf(t *testing.T, ledger domain.LedgerRepository, account string) int64 {
+	t.Helper()
+
+	balance, err := ledger.GetBalance(context.Background(), account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return balance
+}
+
+func TestHoldForTripHoldsAtMostTheBalanceOnce(t *testing.T) {
+	wallet, ledger, userID := newTestWallet(t, 500)
+	tripID := uuid.NewString()
+
+	for range 2 {
+		held, err := wallet.HoldForTrip(context.Background(), userID, tripID, 1800)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if held != 500 {
+			t.Errorf("held %d cents, want the 500 of the balance", held)
+		}
+	}
+
+	if balance := balanceOf(t, ledger, domain.WalletAccount(userID)); balance != 0 {
+		t.Errorf("wallet = %d, want 0", balance)
+	}
+	if held := balanceOf(t, ledger, domain.AccountWalletHolds); held != 500 {
+		t.Errorf("holds = %d, want 500", held)
+	}
+
+	// An empty wallet holds nothing and leaves the fare to the card
+	held, err := wallet.HoldForTrip(context.Background(), userID, uuid.NewString(), 1800)
+	if err != nil || held != 0 {
+		t.Errorf("hold of an empty wallet = %d, %v, want 0", held, err)
+	}
+}
+
+func TestSettleAndReleaseTripArePostedOnce(t *testing.T) {
+	wallet, ledger, userID := newTestWallet(t, 1000)
+	settled, released := uuid.NewString(), uuid.NewString()
+
+	for _, tripID := range []string{settled, released} {
+		if _, err := wallet.HoldForTrip(context.Background(), userID, tripID, 500); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for range 2 {
+		if err := wallet.SettleTrip(context.Background(), settled, 500); err != nil {
+			t.Fatal(err)
+		}
+		if err := wallet.ReleaseTrip(context.Background(), userID, released, 500); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if revenue := balanceOf(t, ledger, domain.AccountTripRevenue); revenue != 500 {
+		t.Errorf("trip revenue = %d, want 500", revenue)
+	}
+	if balance := balanceOf(t, ledger, domain.WalletAccount(userID)); balance != 500 {
+		t.Errorf("wallet = %d, want the 500 released", balance)
+	}
+	if held := balanceOf(t, ledger, domain.AccountWalletHolds); held != 0 {
+		t.Errorf("holds = %d, want 0", held)
+	}
+}
+
+func TestWalletRefusesToGoNegative(t *testing.T) {
+	wallet, ledger, userID := newTestWallet(t, 300)
+
+	_, err := wallet.post(context.Background(), domain.JournalTripHold, "overdraft", "overdraft",
+		domain.Posting{Account: domain.WalletAccount(userID), AmountCents: -500},
+		domain.Posting{Account: domain.AccountWalletHolds, AmountCents: 500},
+	)
+	if !errors.Is(err, domain.ErrInsufficientBalance) {
+		t.Fatalf("err = %v, want %v", err, domain.ErrInsufficientBalance)
+	}
+	if balance := balanceOf(t, ledger, domain.WalletAccount(userID)); balance != 300 {
+		t.Errorf("wallet = %d, want the 300 untouched", balance)
+	}
+
+	if _, err := wallet.Credit(context.Background(), userID, 0, "zero", "zero credit"); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("credit of 0 err = %v, want %v", err, ErrInvalidAmount)
+	}
+}
@@ -14,6 +14,13 @@ type OSRMApiResponse struct {
 	} `json:"routes"`
 }
 
+// OSRMTableResponse holds the drive times in seconds from every source to every destination,
+// a nil duration means there is no route.
+type OSRMTableResponse struct {
+	Code      string       `json:"code"`
+	Durations [][]*float64 `json:"durations"`
+}
+
 type PricingConfig struct {
 	BaseFare               int64
 	MinimumFare            int64
@@ -0,0 +1,92 @@
+package grpc_clients
+
+import (
+	"context"
+	pbt "go-ride/shared/proto/trip"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TripRewardGateway credits referral rewards to the wallets kept by trip-service.
+type TripRewardGateway struct {
+	client pbt.TripServiceClient
+}
+
+func NewTripRewardGateway(addr string) (*TripRewardGateway, *grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &TripRewardGateway{client: pbt.NewTripServiceClient(conn)}, conn, nil
+}
+
+func (g *TripRewardGateway) SharePaymentMethod(ctx context.Context, userID, otherUserID string) (bool, error) {
+	fingerprints, err := g.fingerprints(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if len(fingerprints) == 0 {
+		return false, nil
+	}
+
+	others, err := g.fingerprints(ctx, otherUserID)
+	if err != nil {
+		return false, err
+	}
+	for fingerprint := range others {
+		if _, ok := fingerprints[fingerprint]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (g *TripRewardGateway) CreditRide(ctx context.Context, userID string, amountCents int64, reference, description string) error {
+	_, err := g.client.CreditWallet(ctx, &pbt.CreditWalletRequest{
+		UserID:      userID,
+		AmountCents: amountCents,
+		Reference:   reference,
+		Description: description,
+	}, grpc.WaitForReady(true))
+	return err
+}
+
+func (g *TripRewardGateway) fingerprints(ctx context.Context, userID string) (map[string]struct{}, error) {
+	res, err := g.client.ListPaymentMethods(ctx, &pbt.ListPaymentMethodsRequest{
+		UserID: userID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprints := make(map[string]struct{}, len(res.PaymentMethods))
+	for _, method := range res.PaymentMethods {
+		if method.Fingerprint != "" {
+			fingerprints[method.Fingerprint] = struct{}{}
+		}
+	}
+	return fingerprints, nil
+}
+
+// TripReceiptSource fetches the receipts rendered by trip-service.
+type TripReceiptSource struct {
+	client pbt.TripServiceClient
+}
+
+func NewTripReceiptSource(conn *grpc.ClientConn) *TripReceiptSource {
+	return &TripReceiptSource{client: pbt.NewTripServiceClient(conn)}
+}
+
+func (s *TripReceiptSource) GetReceipt(ctx context.Context, tripID, userID, format string) ([]byte, string, error) {
+	res, err := s.client.GetReceipt(ctx, &pbt.GetReceiptRequest{
+		TripID: tripID,
+		UserID: userID,
+		Format: format,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, "", err
+	}
+	return res.Content, res.FileName, nil
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "context"
+
+type Attachment struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// Notification is a message sent to a user through a notification channel, e.g. an email.
+type Notification struct {
+	To          string
+	Subject     string
+	HTMLBody    string
+	Attachments []Attachment
+}
+
+// Notifier delivers notifications to the users, the channel is picked at startup.
+type Notifier interface {
+	Send(ctx context.Context, notification *Notification) error
+}
+
+// ReceiptSource fetches the receipts of the trips, rendered by trip-service.
+type ReceiptSource interface {
+	// GetReceipt returns the receipt in the format, "html" or "pdf", with its file name
+	GetReceipt(ctx context.Context, tripID, userID, format string) ([]byte, string, error)
+}
+
+type ReceiptMailer interface {
+	SendReceipt(ctx context.Context, tripID, passengerID, number string) error
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type ReferralStatus string
+
+const (
+	ReferralPending  ReferralStatus = "PENDING"
+	ReferralRewarded ReferralStatus = "REWARDED"
+	ReferralRejected ReferralStatus = "REJECTED"
+)
+
+// Reasons a referral is not rewarded, never told to the users
+const (
+	RejectSelfReferral      = "self_referral"
+	RejectSameDevice        = "same_device"
+	RejectSamePaymentMethod = "same_payment_method"
+)
+
+// Referral links a new user to the user who invited them. Both are rewarded
+// once the referred user completes a first trip.
+type Referral struct {
+	ReferrerID          string
+	ReferredID          string
+	Code                string
+	Status              ReferralStatus
+	RejectReason        string
+	ReferrerRewardCents int64
+	ReferredRewardCents int64
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// ReferralStats sums up the referrals of a user.
+type ReferralStats struct {
+	ReferralCode string
+	Invited      int
+	Pending      int
+	Rewarded     int
+	Rejected     int
+	EarnedCents  int64
+}
+
+type ReferralRepository interface {
+	SaveReferral(ctx context.Context, referral *Referral) error
+	GetReferralByReferred(ctx context.Context, referredID string) (*Referral, error)
+	ListReferralsByReferrer(ctx context.Context, referrerID string) ([]*Referral, error)
+	// CloseReferral moves a PENDING referral to REWARDED or REJECTED, it reports false when it was not PENDING anymore
+	CloseReferral(ctx context.Context, referredID string, to ReferralStatus, rejectReason string, at time.Time) (bool, error)
+}
+
+// RewardGateway reaches trip-service, which keeps the payment methods and the wallets of the riders.
+type RewardGateway interface {
+	// SharePaymentMethod reports whether both users stored the same card
+	SharePaymentMethod(ctx context.Context, userID, otherUserID string) (bool, error)
+	// CreditRide gives the user wallet credit spent on the next fares, crediting twice with the reference has no effect
+	CreditRide(ctx context.Context, userID string, amountCents int64, reference, description string) error
+}
+
+type ReferralService interface {
+	// ResolveCode returns the user owning the referral code
+	ResolveCode(ctx context.Context, code string) (*UserModel, error)
+	Refer(ctx context.Context, referrer, referred *UserModel) (*Referral, error)
+	HandleTripCompleted(ctx context.Context, passengerID, tripID string) error
+	GetStats(ctx context.Context, userID string) (*ReferralStats, error)
+}
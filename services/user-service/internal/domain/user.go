@@ -13,6 +13,8 @@ type UserModel struct {
 	Email          string
 	PasswordHashed string
 	Type           types.UserType
+	ReferralCode   string // shared by the user to invite friends
+	DeviceID       string // the device the account was created from
 }
 
 type UserService interface {
@@ -24,4 +26,7 @@ type UserService interface {
 type UserRepository interface {
 	CreateUser(ctx context.Context, user *UserModel) (*UserModel, error)
 	GetUserByEmail(ctx context.Context, email string) (*UserModel, error)
+	GetUserByID(ctx context.Context, userID string) (*UserModel, error)
+	GetUserByReferralCode(ctx context.Context, code string) (*UserModel, error)
+	ListUsersByDevice(ctx context.Context, deviceID string) ([]*UserModel, error)
 }
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-ride/services/user-service/internal/domain"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+)
+
+type TripEventConsumer struct {
+	bus             messaging.MessageBus
+	referralService domain.ReferralService
+	receiptMailer   domain.ReceiptMailer
+}
+
+func NewTripEventConsumer(bus messaging.MessageBus, referralService domain.ReferralService, receiptMailer domain.ReceiptMailer) *TripEventConsumer {
+	return &TripEventConsumer{
+		bus:             bus,
+		referralService: referralService,
+		receiptMailer:   receiptMailer,
+	}
+}
+
+// Listen starts consuming the queues user-service is responsible for.
+func (c *TripEventConsumer) Listen(ctx context.Context) error {
+	if err := c.bus.ConsumeMessages(ctx, messaging.ReferralTripCompletedQueue, c.handleTripCompleted); err != nil {
+		return err
+	}
+
+	return c.bus.ConsumeMessages(ctx, messaging.ReceiptEmailsQueue, c.handleReceiptIssued)
+}
+
+func (c *TripEventConsumer) handleTripCompleted(ctx context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.TripEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal trip event: %v", err)
+	}
+
+	return c.referralService.HandleTripCompleted(ctx, payload.Trip.GetUserId(), payload.Trip.GetId())
+}
+
+func (c *TripEventConsumer) handleReceiptIssued(ctx context.Context, _ string, message contracts.AmqpMessage) error {
+	var payload messaging.ReceiptIssuedEventData
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal receipt issued event: %v", err)
+	}
+
+	return c.receiptMailer.SendReceipt(ctx, payload.TripID, payload.PassengerID, payload.Number)
+}
@@ -20,16 +20,18 @@ import (
 
 type gRPCHandler struct {
 	pu.UnimplementedUserServiceServer
-	userService domain.UserService
-	jwtService  *jwt.JWTService
-	rdb         *redis.Client
+	userService     domain.UserService
+	referralService domain.ReferralService
+	jwtService      *jwt.JWTService
+	rdb             *redis.Client
 }
 
-func NewGRPCHandler(server *grpc.Server, userService domain.UserService, jwtService *jwt.JWTService, rdb *redis.Client) *gRPCHandler {
+func NewGRPCHandler(server *grpc.Server, userService domain.UserService, referralService domain.ReferralService, jwtService *jwt.JWTService, rdb *redis.Client) *gRPCHandler {
 	handler := &gRPCHandler{
-		userService: userService,
-		jwtService:  jwtService,
-		rdb:         rdb,
+		userService:     userService,
+		referralService: referralService,
+		jwtService:      jwtService,
+		rdb:             rdb,
 	}
 
 	pu.RegisterUserServiceServer(server, handler)
@@ -37,11 +39,25 @@ func NewGRPCHandler(server *grpc.Server, userService domain.UserService, jwtServ
 }
 
 func (h *gRPCHandler) CreateUser(ctx context.Context, req *pu.CreateUserRequest) (*pu.CreateUserResponse, error) {
+	var referrer *domain.UserModel
+	if req.ReferralCode != "" {
+		var err error
+		referrer, err = h.referralService.ResolveCode(ctx, req.ReferralCode)
+		if errors.Is(err, service.ErrReferralCodeNotFound) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			log.Printf("create user error: %v", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
 	userModel := &domain.UserModel{
 		Name:           req.Name,
 		Email:          req.Email,
 		PasswordHashed: req.Password,
 		Type:           types.UserType(req.Type.String()),
+		DeviceID:       req.DeviceId,
 	}
 
 	user, err := h.userService.CreateUser(ctx, userModel)
@@ -57,9 +73,37 @@ func (h *gRPCHandler) CreateUser(ctx context.Context, req *pu.CreateUserRequest)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
+	// The account exists either way, a referral that could not be recorded is only logged
+	if referrer != nil {
+		if _, err := h.referralService.Refer(ctx, referrer, user); err != nil {
+			log.Printf("failed to record the referral of %s: %v", user.ID, err)
+		}
+	}
+
 	return &pu.CreateUserResponse{Id: user.ID.String()}, nil
 }
 
+func (h *gRPCHandler) GetReferralStats(ctx context.Context, req *pu.GetReferralStatsRequest) (*pu.GetReferralStatsResponse, error) {
+	stats, err := h.referralService.GetStats(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		log.Printf("get referral stats error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pu.GetReferralStatsResponse{
+		ReferralCode: stats.ReferralCode,
+		Invited:      int32(stats.Invited),
+		Pending:      int32(stats.Pending),
+		Rewarded:     int32(stats.Rewarded),
+		Rejected:     int32(stats.Rejected),
+		EarnedCents:  stats.EarnedCents,
+	}, nil
+}
+
 func (h *gRPCHandler) Login(ctx context.Context, req *pu.LoginRequest) (*pu.LoginResponse, error) {
 	user, err := h.userService.Authenticate(ctx, req.Email, req.Password)
 	if err != nil {
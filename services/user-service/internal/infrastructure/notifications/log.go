@@ -0,0 +1,22 @@
+package notifications
+
+import (
+	"context"
+	"go-ride/services/user-service/internal/domain"
+	"log"
+)
+
+// LogNotifier only logs the notifications, for local runs without a mail server.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Send(_ context.Context, notification *domain.Notification) error {
+	log.Printf("[notification] to %s: %q, %d bytes of HTML", notification.To, notification.Subject, len(notification.HTMLBody))
+	for _, attachment := range notification.Attachments {
+		log.Printf("[notification] attached %s (%s, %d bytes)", attachment.FileName, attachment.ContentType, len(attachment.Content))
+	}
+	return nil
+}
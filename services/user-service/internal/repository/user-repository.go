@@ -3,24 +3,35 @@ package repository
 import (
 	"context"
 	"go-ride/services/user-service/internal/domain"
+	"sync"
+	"time"
 )
 
 type inmemRepository struct {
-	users map[string]*domain.UserModel
+	users     map[string]*domain.UserModel
+	referrals map[string]*domain.Referral // by referred user
+	mutex     sync.RWMutex                // referrals are updated by the gRPC handlers and the event consumer
 }
 
 func NewInmemRepository() *inmemRepository {
 	return &inmemRepository{
-		users: make(map[string]*domain.UserModel),
+		users:     make(map[string]*domain.UserModel),
+		referrals: make(map[string]*domain.Referral),
 	}
 }
 
 func (r *inmemRepository) CreateUser(ctx context.Context, user *domain.UserModel) (*domain.UserModel, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
 	r.users[user.ID.String()] = user
 	return user, nil
 }
 
 func (r *inmemRepository) GetUserByEmail(ctx context.Context, email string) (*domain.UserModel, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
 	for _, user := range r.users {
 		if user.Email == email {
 			return user, nil
@@ -29,3 +40,89 @@ func (r *inmemRepository) GetUserByEmail(ctx context.Context, email string) (*do
 
 	return nil, nil
 }
+
+func (r *inmemRepository) GetUserByID(ctx context.Context, userID string) (*domain.UserModel, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.users[userID], nil
+}
+
+func (r *inmemRepository) GetUserByReferralCode(ctx context.Context, code string) (*domain.UserModel, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, user := range r.users {
+		if user.ReferralCode == code {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *inmemRepository) ListUsersByDevice(ctx context.Context, deviceID string) ([]*domain.UserModel, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var users []*domain.UserModel
+	for _, user := range r.users {
+		if user.DeviceID == deviceID {
+			users = append(users, user)
+		}
+	}
+
+	return users, nil
+}
+
+func (r *inmemRepository) SaveReferral(ctx context.Context, referral *domain.Referral) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	copied := *referral
+	r.referrals[referral.ReferredID] = &copied
+	return nil
+}
+
+func (r *inmemRepository) GetReferralByReferred(ctx context.Context, referredID string) (*domain.Referral, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	referral, ok := r.referrals[referredID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *referral
+	return &copied, nil
+}
+
+func (r *inmemRepository) ListReferralsByReferrer(ctx context.Context, referrerID string) ([]*domain.Referral, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var referrals []*domain.Referral
+	for _, referral := range r.referrals {
+		if referral.ReferrerID == referrerID {
+			copied := *referral
+			referrals = append(referrals, &copied)
+		}
+	}
+
+	return referrals, nil
+}
+
+func (r *inmemRepository) CloseReferral(ctx context.Context, referredID string, to domain.ReferralStatus, rejectReason string, at time.Time) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	referral, ok := r.referrals[referredID]
+	if !ok || referral.Status != domain.ReferralPending {
+		return false, nil
+	}
+
+	referral.Status = to
+	referral.RejectReason = rejectReason
+	referral.UpdatedAt = at
+	return true, nil
+}
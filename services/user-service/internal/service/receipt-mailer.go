@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-ride/services/user-service/internal/domain"
+)
+
+type receiptMailer struct {
+	users    domain.UserRepository
+	receipts domain.ReceiptSource
+	notifier domain.Notifier
+}
+
+func NewReceiptMailer(users domain.UserRepository, receipts domain.ReceiptSource, notifier domain.Notifier) *receiptMailer {
+	return &receiptMailer{
+		users:    users,
+		receipts: receipts,
+		notifier: notifier,
+	}
+}
+
+// SendReceipt emails the receipt of the trip to the passenger, the HTML receipt as the body and the PDF attached.
+func (m *receiptMailer) SendReceipt(ctx context.Context, tripID, passengerID, number string) error {
+	user, err := m.users.GetUserByID(ctx, passengerID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, passengerID)
+	}
+
+	body, _, err := m.receipts.GetReceipt(ctx, tripID, passengerID, "html")
+	if err != nil {
+		return fmt.Errorf("failed to get the receipt of trip %s: %w", tripID, err)
+	}
+	pdf, fileName, err := m.receipts.GetReceipt(ctx, tripID, passengerID, "pdf")
+	if err != nil {
+		return fmt.Errorf("failed to get the receipt of trip %s: %w", tripID, err)
+	}
+
+	err = m.notifier.Send(ctx, &domain.Notification{
+		To:       user.Email,
+		Subject:  fmt.Sprintf("Your go-ride receipt %s", number),
+		HTMLBody: string(body),
+		Attachments: []domain.Attachment{
+			{FileName: fileName, ContentType: "application/pdf", Content: pdf},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send the receipt of trip %s: %w", tripID, err)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/services/user-service/internal/domain"
+	"log"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUserNotFound         = errors.New("user not found")
+	ErrReferralCodeNotFound = errors.New("referral code not found")
+)
+
+type referralService struct {
+	users          domain.UserRepository
+	referrals      domain.ReferralRepository
+	rewards        domain.RewardGateway
+	referrerReward int64
+	referredReward int64
+}
+
+func NewReferralService(users domain.UserRepository, referrals domain.ReferralRepository, rewards domain.RewardGateway, referrerReward, referredReward int64) *referralService {
+	return &referralService{
+		users:          users,
+		referrals:      referrals,
+		rewards:        rewards,
+		referrerReward: referrerReward,
+		referredReward: referredReward,
+	}
+}
+
+func (s *referralService) ResolveCode(ctx context.Context, code string) (*domain.UserModel, error) {
+	referrer, err := s.users.GetUserByReferralCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if referrer == nil {
+		return nil, ErrReferralCodeNotFound
+	}
+	return referrer, nil
+}
+
+// Refer records the invitation of the new user. A referral caught by the fraud guards is
+// recorded as REJECTED and the signup goes on, so the users cannot tell which guard caught it.
+func (s *referralService) Refer(ctx context.Context, referrer, referred *domain.UserModel) (*domain.Referral, error) {
+	now := time.Now()
+	referral := &domain.Referral{
+		ReferrerID:          referrer.ID.String(),
+		ReferredID:          referred.ID.String(),
+		Code:                referrer.ReferralCode,
+		Status:              domain.ReferralPending,
+		ReferrerRewardCents: s.referrerReward,
+		ReferredRewardCents: s.referredReward,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	reason, err := s.screen(ctx, referrer, referred)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		referral.Status = domain.ReferralRejected
+		referral.RejectReason = reason
+		log.Printf("referral of %s by %s rejected: %s", referral.ReferredID, referral.ReferrerID, reason)
+	}
+
+	if err := s.referrals.SaveReferral(ctx, referral); err != nil {
+		return nil, fmt.Errorf("failed to save referral: %w", err)
+	}
+	return referral, nil
+}
+
+// HandleTripCompleted rewards both users when the passenger completes a first trip.
+// A referral is PENDING until then, so later trips find it closed and are ignored.
+func (s *referralService) HandleTripCompleted(ctx context.Context, passengerID, tripID string) error {
+	referral, err := s.referrals.GetReferralByReferred(ctx, passengerID)
+	if err != nil {
+		return fmt.Errorf("failed to get referral: %w", err)
+	}
+	if referral == nil || referral.Status != domain.ReferralPending {
+		return nil
+	}
+
+	// The riders add their cards after the signup, so this guard waits until the first trip
+	shared, err := s.rewards.SharePaymentMethod(ctx, referral.ReferrerID, referral.ReferredID)
+	if err != nil {
+		return fmt.Errorf("failed to compare payment methods: %w", err)
+	}
+	if shared {
+		log.Printf("referral of %s by %s rejected: %s", referral.ReferredID, referral.ReferrerID, domain.RejectSamePaymentMethod)
+		return s.close(ctx, referral, domain.ReferralRejected, domain.RejectSamePaymentMethod)
+	}
+
+	// Credits are idempotent by reference, a redelivered event does not pay twice
+	reference := "referral:" + referral.ReferredID
+	if err := s.rewards.CreditRide(ctx, referral.ReferrerID, referral.ReferrerRewardCents, reference, "referral reward"); err != nil {
+		return fmt.Errorf("failed to credit referrer: %w", err)
+	}
+	if err := s.rewards.CreditRide(ctx, referral.ReferredID, referral.ReferredRewardCents, reference, "welcome reward"); err != nil {
+		return fmt.Errorf("failed to credit referred user: %w", err)
+	}
+
+	log.Printf("referral of %s by %s rewarded after trip %s", referral.ReferredID, referral.ReferrerID, tripID)
+	return s.close(ctx, referral, domain.ReferralRewarded, "")
+}
+
+func (s *referralService) GetStats(ctx context.Context, userID string) (*domain.ReferralStats, error) {
+	user, err := s.users.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	referrals, err := s.referrals.ListReferralsByReferrer(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list referrals: %w", err)
+	}
+
+	stats := &domain.ReferralStats{
+		ReferralCode: user.ReferralCode,
+		Invited:      len(referrals),
+	}
+	for _, referral := range referrals {
+		switch referral.Status {
+		case domain.ReferralPending:
+			stats.Pending++
+		case domain.ReferralRewarded:
+			stats.Rewarded++
+			stats.EarnedCents += referral.ReferrerRewardCents
+		case domain.ReferralRejected:
+			stats.Rejected++
+		}
+	}
+
+	return stats, nil
+}
+
+// screen returns the reason to reject the referral, empty when it passes the signup guards.
+func (s *referralService) screen(ctx context.Context, referrer, referred *domain.UserModel) (string, error) {
+	if referrer.ID == referred.ID || sameMailbox(referrer.Email, referred.Email) {
+		return domain.RejectSelfReferral, nil
+	}
+
+	if referred.DeviceID == "" {
+		return "", nil
+	}
+	users, err := s.users.ListUsersByDevice(ctx, referred.DeviceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list users by device: %w", err)
+	}
+	for _, user := range users {
+		if user.ID != referred.ID {
+			return domain.RejectSameDevice, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (s *referralService) close(ctx context.Context, referral *domain.Referral, to domain.ReferralStatus, reason string) error {
+	if _, err := s.referrals.CloseReferral(ctx, referral.ReferredID, to, reason, time.Now()); err != nil {
+		return fmt.Errorf("failed to close referral: %w", err)
+	}
+	return nil
+}
+
+// sameMailbox tells whether two addresses deliver to the same inbox,
+// ignoring the case, the dots and the +tag of the local part.
+func sameMailbox(email, other string) bool {
+	return normalizeMailbox(email) == normalizeMailbox(other)
+}
+
+func normalizeMailbox(email string) string {
+	local, domainPart, ok := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	if !ok {
+		return local
+	}
+
+	local, _, _ = strings.Cut(local, "+")
+	local = strings.ReplaceAll(local, ".", "")
+	return local + "@" + domainPart
+}
@@ -44,9 +44,32 @@ func (s *userService) CreateUser(ctx context.Context, user *domain.UserModel) (*
 		user.ID = uuid.New()
 	}
 
+	user.ReferralCode, err = s.newReferralCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.repo.CreateUser(ctx, user)
 }
 
+// newReferralCode draws codes until it finds one no user has.
+func (s *userService) newReferralCode(ctx context.Context) (string, error) {
+	for {
+		code, err := pkg.GenerateReferralCode()
+		if err != nil {
+			return "", fmt.Errorf("error generating referral code: %w", err)
+		}
+
+		owner, err := s.repo.GetUserByReferralCode(ctx, code)
+		if err != nil {
+			return "", fmt.Errorf("error checking referral code: %w", err)
+		}
+		if owner == nil {
+			return code, nil
+		}
+	}
+}
+
 func (s *userService) GetUserByEmail(ctx context.Context, email string) (*domain.UserModel, error) {
 	return s.repo.GetUserByEmail(ctx, email)
 }
@@ -0,0 +1,26 @@
+package pkg
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// referralAlphabet leaves out 0, O, 1 and I, which are easy to mistype
+const referralAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const referralCodeLength = 8
+
+func GenerateReferralCode() (string, error) {
+	code := make([]byte, referralCodeLength)
+	max := big.NewInt(int64(len(referralAlphabet)))
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = referralAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
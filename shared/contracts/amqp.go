@@ -4,6 +4,8 @@ package contracts
 type AmqpMessage struct {
 	OwnerID string `json:"ownerId"`
 	Data    []byte `json:"data"`
+	// ScheduleToken is set on delayed messages so a pending delivery can be cancelled.
+	ScheduleToken string `json:"scheduleToken,omitempty"`
 }
 
 // Routing keys - using consistent event/command patterns
@@ -11,6 +13,20 @@ const (
 	// Trip events (trip.event.*)
 	TripEventCreated             = "trip.event.created"
 	TripEventDriverAssigned      = "trip.event.driver_assigned"
+	TripEventAccepted            = "trip.event.accepted"
 	TripEventNoDriversFound      = "trip.event.no_drivers_found"
 	TripEventDriverNotInterested = "trip.event.driver_not_interested"
+	TripEventStarted             = "trip.event.started"
+	TripEventCompleted           = "trip.event.completed"
+	TripEventCancelled           = "trip.event.cancelled"
+	TripEventDriverArrived       = "trip.event.driver_arrived"
+	TripEventDriverLocation      = "trip.event.driver_location"
+	TripEventRated               = "trip.event.rated"
+	TripEventTipped              = "trip.event.tipped"
+	TripEventReceiptIssued       = "trip.event.receipt_issued"
+
+	// Driver events (driver.event.*)
+	DriverEventLocation         = "driver.event.location"
+	DriverEventDocumentExpiring = "driver.event.document_expiring"
+	DriverEventShiftNotice      = "driver.event.shift_notice"
 )
@@ -3,12 +3,64 @@ package contracts
 import "encoding/json"
 
 // WSMessage is the message structure for the WebSocket.
+// Seq is a per-user sequence number assigned by the gateway, clients resume a dropped
+// session from the last sequence they received. Messages with AckRequired are delivered
+// again on every reconnection until the client acknowledges them, so clients must discard
+// sequence numbers they already processed.
 type WSMessage struct {
-	Type string `json:"type"`
-	Data any    `json:"data"`
+	Type        string `json:"type"`
+	Data        any    `json:"data"`
+	Seq         uint64 `json:"seq,omitempty"`
+	AckRequired bool   `json:"ackRequired,omitempty"`
+}
+
+// WebSocket message types sent by the server
+const (
+	WSTripDriverAssigned = "trip.driver_assigned"
+	WSTripDriverLocation = "trip.driver_location"
+	WSTripStarted        = "trip.started"
+	WSTripCompleted      = "trip.completed"
+	WSTripDriverArrived  = "trip.driver_arrived"
+	WSTripCancelled      = "trip.cancelled"
+	WSDriverDocument     = "driver.document_expiring"
+	WSDriverShift        = "driver.shift_notice"
+	WSDriverTip          = "driver.tip_received"
+	WSDriverTripAssigned = "driver.trip_assigned"
+)
+
+// WebSocket message types sent by the clients
+const (
+	WSClientAck    = "client.ack"
+	WSDriverStatus = "driver.cmd.status"
+)
+
+// WSAckData is the payload of a client acknowledgement.
+type WSAckData struct {
+	Seq uint64 `json:"seq"`
 }
 
 type WSDriverMessage struct {
 	Type string          `json:"type"`
 	Data json.RawMessage `json:"data"`
 }
+
+type WSRiderMessage struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// WSDriverStatusData is the payload of a driver changing its own status, e.g. to PAUSED or BREAK.
+type WSDriverStatusData struct {
+	Status string `json:"status"`
+}
+
+// WSDriverLocation is a location update sent by the driver app. Older apps only send the
+// coordinate, the remaining fields are then zero and the gateway stamps the time.
+type WSDriverLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Timestamp int64   `json:"timestamp,omitempty"` // unix milliseconds
+	Speed     float64 `json:"speed,omitempty"`     // meters per second
+	Heading   float64 `json:"heading,omitempty"`
+	Accuracy  float64 `json:"accuracy,omitempty"` // meters
+}
@@ -0,0 +1,135 @@
+package messaging
+
+import (
+	"context"
+	"go-ride/shared/contracts"
+	"time"
+)
+
+// MessageHandler processes a single message consumed from a queue.
+// Returning an error rejects the message, which sends it to the dead letter exchange.
+type MessageHandler func(ctx context.Context, routingKey string, message contracts.AmqpMessage) error
+
+// MessageBus is the transport used by the services to publish and consume events.
+// It is implemented by RabbitMQ and by an in-process bus for services sharing a process, e.g. in tests.
+type MessageBus interface {
+	PublishMessage(ctx context.Context, routingKey string, message contracts.AmqpMessage) error
+	PublishDelayed(ctx context.Context, routingKey string, message contracts.AmqpMessage, delay time.Duration) (string, error)
+	CancelScheduled(ctx context.Context, token string) error
+	SetScheduleStore(store ScheduleStore)
+	ConsumeMessages(ctx context.Context, queueName string, handler MessageHandler) error
+	DeclareAndBindQueue(queueName string, routingKeys []string, exchange string) error
+	Close()
+}
+
+// QueueBinding describes a queue and the routing keys it receives from the trip exchange.
+type QueueBinding struct {
+	Queue       string
+	RoutingKeys []string
+}
+
+// TripQueueBindings is the topology shared by every MessageBus implementation.
+var TripQueueBindings = []QueueBinding{
+	{
+		Queue: FindAvailableDriversQueue,
+		RoutingKeys: []string{
+			contracts.TripEventCreated,
+			contracts.TripEventDriverNotInterested,
+		},
+	},
+	{
+		Queue:       NotifyNoDriversFoundQueue,
+		RoutingKeys: []string{contracts.TripEventNoDriversFound},
+	},
+	{
+		// The passenger is notified once trip-service accepted the trip and estimated the driver arrival
+		Queue:       NotifyDriverAssignQueue,
+		RoutingKeys: []string{contracts.TripEventAccepted},
+	},
+	{
+		Queue: DriverStatusUpdatesQueue,
+		RoutingKeys: []string{
+			contracts.TripEventDriverAssigned,
+			contracts.TripEventStarted,
+			contracts.TripEventCompleted,
+			contracts.TripEventCancelled,
+		},
+	},
+	{
+		// A trip no driver was found for is canceled and its payment voided
+		Queue: TripStatusUpdatesQueue,
+		RoutingKeys: []string{
+			contracts.TripEventDriverAssigned,
+			contracts.TripEventNoDriversFound,
+		},
+	},
+	{
+		Queue:       TripDriverLocationsQueue,
+		RoutingKeys: []string{contracts.DriverEventLocation},
+	},
+	{
+		Queue:       NotifyDriverLocationQueue,
+		RoutingKeys: []string{contracts.TripEventDriverLocation},
+	},
+	{
+		Queue:       NotifyDriverDocumentQueue,
+		RoutingKeys: []string{contracts.DriverEventDocumentExpiring},
+	},
+	{
+		Queue:       NotifyDriverShiftQueue,
+		RoutingKeys: []string{contracts.DriverEventShiftNotice},
+	},
+	{
+		Queue: NotifyTripStatusQueue,
+		RoutingKeys: []string{
+			contracts.TripEventDriverArrived,
+			contracts.TripEventStarted,
+			contracts.TripEventCompleted,
+			contracts.TripEventCancelled,
+		},
+	},
+	{
+		// user-service rewards a referral on the first trip the referred user completes
+		Queue:       ReferralTripCompletedQueue,
+		RoutingKeys: []string{contracts.TripEventCompleted},
+	},
+	{
+		// driver-service keeps the rolling average of the drivers, used by matching
+		Queue:       DriverRatingsQueue,
+		RoutingKeys: []string{contracts.TripEventRated},
+	},
+	{
+		// driver-service credits the whole tip to the driver earnings
+		Queue:       DriverTipsQueue,
+		RoutingKeys: []string{contracts.TripEventTipped},
+	},
+	{
+		Queue:       NotifyDriverTipQueue,
+		RoutingKeys: []string{contracts.TripEventTipped},
+	},
+	{
+		// user-service emails the receipt to the passenger
+		Queue:       ReceiptEmailsQueue,
+		RoutingKeys: []string{contracts.TripEventReceiptIssued},
+	},
+}
+
+// NewMessageBus connects to RabbitMQ, the only transport between services running in their
+// own process. The in-memory bus of NewInMemoryBus is for services sharing a process.
+func NewMessageBus(uri string) (MessageBus, error) {
+	rabbitmq, err := NewRabbitMQ(uri)
+	if err != nil {
+		return nil, err
+	}
+	return rabbitmq, nil
+}
+
+func declareTripQueues(bus MessageBus) error {
+	for _, binding := range TripQueueBindings {
+		if err := bus.DeclareAndBindQueue(binding.Queue, binding.RoutingKeys, TripExchange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package messaging
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go-ride/shared/contracts"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// replayRoutingKeyHeader carries the original routing key of a replayed message, which is
+// published straight to its queue through the default exchange and so loses it.
+const replayRoutingKeyHeader = "x-replay-routing-key"
+
+// DeadLetter is a message sitting on the dead letter queue, decoded together
+// with the x-death information RabbitMQ attaches when it dead-letters a message.
+type DeadLetter struct {
+	MessageID      string                `json:"messageId"`
+	RoutingKey     string                `json:"routingKey"`
+	Queue          string                `json:"queue"`
+	Reason         string                `json:"reason"`
+	Count          int64                 `json:"count"`
+	DeadLetteredAt time.Time             `json:"deadLetteredAt"`
+	Message        contracts.AmqpMessage `json:"message"`
+}
+
+// DeadLetterSelector decides whether a dead letter is affected by a replay or purge.
+type DeadLetterSelector func(letter *DeadLetter) bool
+
+// SelectDeadLettersByID selects the dead letters with the given message IDs.
+func SelectDeadLettersByID(ids []string) DeadLetterSelector {
+	selected := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		selected[id] = true
+	}
+
+	return func(letter *DeadLetter) bool {
+		return selected[letter.MessageID]
+	}
+}
+
+// SelectAllDeadLetters selects every message on the dead letter queue.
+func SelectAllDeadLetters(_ *DeadLetter) bool {
+	return true
+}
+
+// InspectDeadLetters lists up to limit messages from the dead letter queue without removing them.
+func (r *RabbitMQ) InspectDeadLetters(ctx context.Context, limit int) ([]*DeadLetter, error) {
+	letters := make([]*DeadLetter, 0)
+
+	err := r.walkDeadLetters(ctx, func(_ *amqp.Channel, letter *DeadLetter, _ amqp.Delivery) (bool, error) {
+		letters = append(letters, letter)
+		return limit <= 0 || len(letters) < limit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return letters, nil
+}
+
+// ReplayDeadLetters publishes the selected dead letters back to the queue they died in, and only
+// that one, then removes them from the dead letter queue. The other queues bound to the routing
+// key already processed the message, replaying it through the trip exchange would run them again.
+// With dryRun set nothing is published or removed, the affected messages are only returned.
+func (r *RabbitMQ) ReplayDeadLetters(ctx context.Context, selector DeadLetterSelector, dryRun bool) ([]*DeadLetter, error) {
+	replayed := make([]*DeadLetter, 0)
+
+	err := r.walkDeadLetters(ctx, func(ch *amqp.Channel, letter *DeadLetter, delivery amqp.Delivery) (bool, error) {
+		if !selector(letter) {
+			return true, nil
+		}
+
+		if letter.Queue == "" {
+			return false, fmt.Errorf("cannot replay message %s: the queue it died in is unknown", letter.MessageID)
+		}
+
+		replayed = append(replayed, letter)
+		if dryRun {
+			return true, nil
+		}
+
+		err := ch.PublishWithContext(ctx,
+			"", // the default exchange routes to the queue named by the routing key
+			letter.Queue,
+			false,
+			false,
+			amqp.Publishing{
+				DeliveryMode: amqp.Persistent,
+				ContentType:  delivery.ContentType,
+				MessageId:    letter.MessageID,
+				Timestamp:    time.Now(),
+				Headers:      amqp.Table{replayRoutingKeyHeader: letter.RoutingKey},
+				Body:         delivery.Body,
+			},
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to replay message %s: %v", letter.MessageID, err)
+		}
+
+		if err := delivery.Ack(false); err != nil {
+			return false, fmt.Errorf("failed to ack replayed message %s: %v", letter.MessageID, err)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return replayed, err
+	}
+
+	return replayed, nil
+}
+
+// PurgeDeadLetters removes the selected dead letters from the dead letter queue.
+// With dryRun set nothing is removed, the affected messages are only returned.
+func (r *RabbitMQ) PurgeDeadLetters(ctx context.Context, selector DeadLetterSelector, dryRun bool) ([]*DeadLetter, error) {
+	purged := make([]*DeadLetter, 0)
+
+	err := r.walkDeadLetters(ctx, func(_ *amqp.Channel, letter *DeadLetter, delivery amqp.Delivery) (bool, error) {
+		if !selector(letter) {
+			return true, nil
+		}
+
+		purged = append(purged, letter)
+		if dryRun {
+			return true, nil
+		}
+
+		if err := delivery.Ack(false); err != nil {
+			return false, fmt.Errorf("failed to purge message %s: %v", letter.MessageID, err)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return purged, err
+	}
+
+	return purged, nil
+}
+
+// walkDeadLetters fetches the dead letter queue one message at a time on a dedicated channel.
+// Messages the visitor does not ack are returned to the queue when the channel is closed.
+// The walk covers the messages queued when it starts, so replayed messages that fail
+// again and come back to the queue are not processed twice. A cancelled ctx stops the walk.
+func (r *RabbitMQ) walkDeadLetters(ctx context.Context, visit func(ch *amqp.Channel, letter *DeadLetter, delivery amqp.Delivery) (bool, error)) error {
+	ch, err := r.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to create channel: %v", err)
+	}
+	defer ch.Close()
+
+	queue, err := ch.QueueDeclarePassive(DeadLetterQueue, true, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("failed to inspect %s: %v", DeadLetterQueue, err)
+	}
+
+	for range queue.Messages {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		delivery, ok, err := ch.Get(DeadLetterQueue, false)
+		if err != nil {
+			return fmt.Errorf("failed to get message from %s: %v", DeadLetterQueue, err)
+		}
+		if !ok {
+			return nil
+		}
+
+		letter := decodeDeadLetter(delivery)
+		next, err := visit(ch, letter, delivery)
+		if err != nil {
+			return err
+		}
+		if !next {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func decodeDeadLetter(delivery amqp.Delivery) *DeadLetter {
+	letter := &DeadLetter{
+		MessageID:  delivery.MessageId,
+		RoutingKey: delivery.RoutingKey,
+	}
+
+	// Messages published before message IDs were set are identified by their content
+	if letter.MessageID == "" {
+		sum := sha1.Sum(delivery.Body)
+		letter.MessageID = hex.EncodeToString(sum[:8])
+	}
+
+	// The most recent death is the first entry of the x-death header
+	if deaths, ok := delivery.Headers["x-death"].([]interface{}); ok && len(deaths) > 0 {
+		if death, ok := deaths[0].(amqp.Table); ok {
+			if reason, ok := death["reason"].(string); ok {
+				letter.Reason = reason
+			}
+			if queue, ok := death["queue"].(string); ok {
+				letter.Queue = queue
+			}
+			if count, ok := death["count"].(int64); ok {
+				letter.Count = count
+			}
+			if deadAt, ok := death["time"].(time.Time); ok {
+				letter.DeadLetteredAt = deadAt
+			}
+			if routingKeys, ok := death["routing-keys"].([]interface{}); ok && len(routingKeys) > 0 {
+				if routingKey, ok := routingKeys[0].(string); ok {
+					letter.RoutingKey = routingKey
+				}
+			}
+		}
+	}
+
+	// Keep undecodable bodies around as raw data so they can still be purged
+	if err := json.Unmarshal(delivery.Body, &letter.Message); err != nil {
+		letter.Message.Data = delivery.Body
+	}
+
+	return letter
+}
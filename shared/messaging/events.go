@@ -2,15 +2,92 @@ package messaging
 
 import (
 	pbt "go-ride/shared/proto/trip"
+	"go-ride/shared/types"
 )
 
 const (
-	FindAvailableDriversQueue = "find_available_drivers"
-	NotifyNoDriversFoundQueue = "notify_no_drivers_found"
-	NotifyDriverAssignQueue   = "notify_driver_assign_queue"
-	DeadLetterQueue           = "dead_letter_queue"
+	FindAvailableDriversQueue  = "find_available_drivers"
+	NotifyNoDriversFoundQueue  = "notify_no_drivers_found"
+	NotifyDriverAssignQueue    = "notify_driver_assign_queue"
+	DriverStatusUpdatesQueue   = "driver_status_updates"
+	TripStatusUpdatesQueue     = "trip_status_updates"
+	TripDriverLocationsQueue   = "trip_driver_locations"
+	NotifyDriverLocationQueue  = "notify_driver_location"
+	NotifyDriverDocumentQueue  = "notify_driver_document"
+	NotifyDriverShiftQueue     = "notify_driver_shift"
+	NotifyTripStatusQueue      = "notify_trip_status"
+	ReferralTripCompletedQueue = "referral_trip_completed"
+	DriverRatingsQueue         = "driver_ratings"
+	DriverTipsQueue            = "driver_tips"
+	NotifyDriverTipQueue       = "notify_driver_tip"
+	ReceiptEmailsQueue         = "receipt_emails"
+	DeadLetterQueue            = "dead_letter_queue"
 )
 
 type TripEventData struct {
 	Trip *pbt.Trip `json:"trip"`
+	// DriverETASeconds is the drive time of the assigned driver to the pickup, set on trip.event.accepted
+	DriverETASeconds *int64 `json:"driverEtaSeconds,omitempty"`
+}
+
+// TripRatedEventData is a rating given after a trip, published by trip-service.
+type TripRatedEventData struct {
+	TripID    string `json:"tripId"`
+	RateeID   string `json:"rateeId"`
+	RaterRole string `json:"raterRole"` // "passenger" when the driver was rated
+	Stars     int    `json:"stars"`
+	Timestamp int64  `json:"timestamp"` // unix milliseconds
+}
+
+// TripTippedEventData is a tip the passenger paid the driver after the trip, published by trip-service.
+type TripTippedEventData struct {
+	TripID      string `json:"tripId"`
+	DriverID    string `json:"driverId"`
+	AmountCents int64  `json:"amountCents"`
+	Timestamp   int64  `json:"timestamp"` // unix milliseconds
+}
+
+// ReceiptIssuedEventData tells a receipt is ready for the passenger of a completed trip, published by trip-service.
+type ReceiptIssuedEventData struct {
+	TripID      string `json:"tripId"`
+	PassengerID string `json:"passengerId"`
+	Number      string `json:"number"`
+	Timestamp   int64  `json:"timestamp"` // unix milliseconds
+}
+
+// DriverLocationEventData is the position of a driver serving a trip, published by driver-service.
+type DriverLocationEventData struct {
+	TripID    string            `json:"tripId"`
+	DriverID  string            `json:"driverId"`
+	Location  *types.Coordinate `json:"location"`
+	Heading   float64           `json:"heading"`
+	Timestamp int64             `json:"timestamp"` // unix milliseconds
+}
+
+// DriverDocumentEventData warns a driver that a document is about to expire, or expired.
+type DriverDocumentEventData struct {
+	DriverID     string `json:"driverId"`
+	DocumentType string `json:"documentType"`
+	ExpiresAt    int64  `json:"expiresAt"` // unix milliseconds
+	Expired      bool   `json:"expired"`
+}
+
+// DriverShiftEventData warns a driver approaching the driving limit ("warning"),
+// or tells it that it was put on BREAK for exceeding it ("break_forced").
+type DriverShiftEventData struct {
+	DriverID       string `json:"driverId"`
+	Kind           string `json:"kind"`
+	DrivingSeconds int64  `json:"drivingSeconds"` // over the last 24 hours
+	LimitSeconds   int64  `json:"limitSeconds"`
+}
+
+// TripDriverLocationData is the driver position relayed to the passenger of the trip,
+// with the estimated time to reach the pickup, or the destination once the trip started.
+type TripDriverLocationData struct {
+	TripID     string            `json:"tripId"`
+	Location   *types.Coordinate `json:"location"`
+	Heading    float64           `json:"heading"`
+	Target     string            `json:"target"` // "pickup" or "destination"
+	ETASeconds int64             `json:"etaSeconds"`
+	Timestamp  int64             `json:"timestamp"` // unix milliseconds
 }
@@ -0,0 +1,299 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ride/shared/contracts"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const inMemoryQueueSize = 1024
+
+var (
+	ErrBusClosed     = errors.New("message bus closed")
+	ErrQueueNotFound = errors.New("queue not found")
+)
+
+type inMemoryDelivery struct {
+	routingKey string
+	message    contracts.AmqpMessage
+}
+
+type inMemoryQueue struct {
+	name               string
+	deadLetterExchange string
+	deliveries         chan inMemoryDelivery
+}
+
+type inMemoryBinding struct {
+	queue   string
+	pattern string
+}
+
+// InMemoryBus is an in-process MessageBus that mirrors the RabbitMQ topology.
+// Exchanges are topic exchanges, so bindings honour the "*" and "#" wildcards,
+// and messages rejected by a handler are routed to the dead letter exchange.
+// It only connects services running in the same process, e.g. in a test binary.
+type InMemoryBus struct {
+	queues   map[string]*inMemoryQueue
+	bindings map[string][]inMemoryBinding // exchange -> bindings
+	mutex    sync.RWMutex
+	closed   bool
+
+	schedules  ScheduleStore
+	timers     map[string]*time.Timer // schedule token -> pending delivery
+	timerMutex sync.Mutex
+}
+
+func NewInMemoryBus() *InMemoryBus {
+	bus := &InMemoryBus{
+		queues:    make(map[string]*inMemoryQueue),
+		bindings:  make(map[string][]inMemoryBinding),
+		schedules: NewInMemoryScheduleStore(),
+		timers:    make(map[string]*time.Timer),
+	}
+
+	// The dead letter queue catches everything, like its RabbitMQ counterpart
+	bus.declareQueue(DeadLetterQueue, "")
+	bus.bind(DeadLetterQueue, "#", DeadLetterExchange)
+
+	if err := declareTripQueues(bus); err != nil {
+		// Declaring queues in memory only fails once the bus is closed
+		log.Printf("failed to declare in-memory queues: %v", err)
+	}
+
+	return bus
+}
+
+func (b *InMemoryBus) PublishMessage(ctx context.Context, routingKey string, message contracts.AmqpMessage) error {
+	log.Printf("publishing in-memory message with routing key: %s", routingKey)
+	return b.publish(ctx, TripExchange, routingKey, message)
+}
+
+// SetScheduleStore replaces the store used to cancel delayed messages.
+func (b *InMemoryBus) SetScheduleStore(store ScheduleStore) {
+	b.schedules = store
+}
+
+func (b *InMemoryBus) PublishDelayed(ctx context.Context, routingKey string, message contracts.AmqpMessage, delay time.Duration) (string, error) {
+	log.Printf("publishing in-memory message with routing key: %s delayed by %s", routingKey, delay)
+
+	message.ScheduleToken = uuid.New().String()
+	token := message.ScheduleToken
+
+	b.timerMutex.Lock()
+	defer b.timerMutex.Unlock()
+
+	b.timers[token] = time.AfterFunc(delay, func() {
+		b.timerMutex.Lock()
+		delete(b.timers, token)
+		b.timerMutex.Unlock()
+
+		if err := b.publish(context.Background(), TripExchange, routingKey, message); err != nil {
+			log.Printf("failed to publish delayed message %s: %v", token, err)
+		}
+	})
+
+	return token, nil
+}
+
+func (b *InMemoryBus) CancelScheduled(ctx context.Context, token string) error {
+	b.timerMutex.Lock()
+	if timer, exists := b.timers[token]; exists {
+		timer.Stop()
+		delete(b.timers, token)
+	}
+	b.timerMutex.Unlock()
+
+	// The message may already be queued, consumers check the store before handling it
+	return b.schedules.Cancel(ctx, token)
+}
+
+func (b *InMemoryBus) ConsumeMessages(ctx context.Context, queueName string, handler MessageHandler) error {
+	b.mutex.RLock()
+	queue, exists := b.queues[queueName]
+	closed := b.closed
+	b.mutex.RUnlock()
+
+	if closed {
+		return ErrBusClosed
+	}
+	if !exists {
+		return fmt.Errorf("failed to consume from %s: %w", queueName, ErrQueueNotFound)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case delivery, ok := <-queue.deliveries:
+				if !ok {
+					return
+				}
+
+				if isScheduleCancelled(ctx, b.schedules, delivery.message) {
+					log.Printf("skipping cancelled scheduled message %s from %s", delivery.message.ScheduleToken, queueName)
+					continue
+				}
+
+				if err := handler(ctx, delivery.routingKey, delivery.message); err != nil {
+					log.Printf("failed to handle message from %s: %v", queueName, err)
+					b.deadLetter(ctx, queue, delivery)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (b *InMemoryBus) DeclareAndBindQueue(queueName string, routingKeys []string, exchange string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return ErrBusClosed
+	}
+
+	b.declareQueueLocked(queueName, DeadLetterExchange)
+	for _, routingKey := range routingKeys {
+		b.bindLocked(queueName, routingKey, exchange)
+	}
+
+	return nil
+}
+
+func (b *InMemoryBus) Close() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.closed = true
+
+	b.timerMutex.Lock()
+	for token, timer := range b.timers {
+		timer.Stop()
+		delete(b.timers, token)
+	}
+	b.timerMutex.Unlock()
+
+	for _, queue := range b.queues {
+		close(queue.deliveries)
+	}
+}
+
+// publish delivers the message to every queue it routes to, or to none of them when one is full.
+func (b *InMemoryBus) publish(_ context.Context, exchange, routingKey string, message contracts.AmqpMessage) error {
+	// Publishers are serialized, so the room checked in the queues is still there when delivering
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return ErrBusClosed
+	}
+
+	// A queue bound more than once to the same message only receives one copy
+	var targets []*inMemoryQueue
+	routed := make(map[string]bool)
+	for _, binding := range b.bindings[exchange] {
+		if routed[binding.queue] || !matchRoutingKey(binding.pattern, routingKey) {
+			continue
+		}
+		routed[binding.queue] = true
+
+		queue := b.queues[binding.queue]
+		if len(queue.deliveries) == cap(queue.deliveries) {
+			return fmt.Errorf("queue %s is full", queue.name)
+		}
+		targets = append(targets, queue)
+	}
+
+	for _, queue := range targets {
+		queue.deliveries <- inMemoryDelivery{routingKey: routingKey, message: message}
+	}
+
+	return nil
+}
+
+func (b *InMemoryBus) deadLetter(ctx context.Context, queue *inMemoryQueue, delivery inMemoryDelivery) {
+	if queue.deadLetterExchange == "" {
+		return
+	}
+
+	if err := b.publish(ctx, queue.deadLetterExchange, delivery.routingKey, delivery.message); err != nil {
+		log.Printf("failed to dead letter message from %s: %v", queue.name, err)
+	}
+}
+
+func (b *InMemoryBus) declareQueue(name, deadLetterExchange string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.declareQueueLocked(name, deadLetterExchange)
+}
+
+func (b *InMemoryBus) declareQueueLocked(name, deadLetterExchange string) {
+	if _, exists := b.queues[name]; exists {
+		return
+	}
+
+	b.queues[name] = &inMemoryQueue{
+		name:               name,
+		deadLetterExchange: deadLetterExchange,
+		deliveries:         make(chan inMemoryDelivery, inMemoryQueueSize),
+	}
+}
+
+func (b *InMemoryBus) bind(queueName, pattern, exchange string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.bindLocked(queueName, pattern, exchange)
+}
+
+func (b *InMemoryBus) bindLocked(queueName, pattern, exchange string) {
+	for _, binding := range b.bindings[exchange] {
+		if binding.queue == queueName && binding.pattern == pattern {
+			return
+		}
+	}
+
+	b.bindings[exchange] = append(b.bindings[exchange], inMemoryBinding{
+		queue:   queueName,
+		pattern: pattern,
+	})
+}
+
+// matchRoutingKey applies the AMQP topic rules: words are separated by dots,
+// "*" matches exactly one word and "#" matches zero or more words.
+func matchRoutingKey(pattern, routingKey string) bool {
+	return matchWords(strings.Split(pattern, "."), strings.Split(routingKey, "."))
+}
+
+func matchWords(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if matchWords(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(words) > 0 && matchWords(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && matchWords(pattern[1:], words[1:])
+	}
+}
@@ -0,0 +1,144 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+	"go-ride/shared/messaging/messagingtest"
+	"testing"
+	"time"
+)
+
+const (
+	receiveTimeout = time.Second
+	quietWait      = 50 * time.Millisecond
+)
+
+func TestInMemoryBusRoutesTopicWildcards(t *testing.T) {
+	h := messagingtest.NewHarness(t)
+
+	bindings := map[string][]string{
+		"exact":    {"trip.event.completed"},
+		"one_word": {"trip.event.*"},
+		"any_tail": {"trip.#"},
+		"other":    {"driver.#"},
+	}
+	for queue, keys := range bindings {
+		if err := h.Bus.DeclareAndBindQueue(queue, keys, messaging.TripExchange); err != nil {
+			t.Fatalf("failed to declare %s: %v", queue, err)
+		}
+	}
+	exact := h.Record("exact")
+	oneWord := h.Record("one_word")
+	anyTail := h.Record("any_tail")
+	other := h.Record("other")
+
+	publish(t, h, "trip.event.completed")
+	for _, recorder := range []*messagingtest.Recorder{exact, oneWord, anyTail} {
+		if got := recorder.Next(receiveTimeout).RoutingKey; got != "trip.event.completed" {
+			t.Errorf("routing key = %s, want trip.event.completed", got)
+		}
+	}
+
+	// "*" matches exactly one word, "#" any number of them
+	publish(t, h, "trip.event.driver.location")
+	if got := anyTail.Next(receiveTimeout).RoutingKey; got != "trip.event.driver.location" {
+		t.Errorf("routing key = %s, want trip.event.driver.location", got)
+	}
+	exact.ExpectNone(quietWait)
+	oneWord.ExpectNone(quietWait)
+	other.ExpectNone(quietWait)
+}
+
+func TestInMemoryBusDeliversOnceToAQueueBoundTwice(t *testing.T) {
+	h := messagingtest.NewHarness(t)
+
+	if err := h.Bus.DeclareAndBindQueue("twice", []string{"trip.#", "trip.event.*"}, messaging.TripExchange); err != nil {
+		t.Fatal(err)
+	}
+	twice := h.Record("twice")
+
+	publish(t, h, "trip.event.started")
+	twice.Next(receiveTimeout)
+	twice.ExpectNone(quietWait)
+}
+
+func TestInMemoryBusDeadLettersRejectedMessages(t *testing.T) {
+	h := messagingtest.NewHarness(t)
+
+	if err := h.Bus.DeclareAndBindQueue("rejecting", []string{"trip.event.rated"}, messaging.TripExchange); err != nil {
+		t.Fatal(err)
+	}
+	err := h.Bus.ConsumeMessages(h.Context(), "rejecting", func(context.Context, string, contracts.AmqpMessage) error {
+		return errors.New("cannot handle it")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadLetters := h.Record(messaging.DeadLetterQueue)
+
+	if err := h.Bus.PublishMessage(context.Background(), "trip.event.rated", contracts.AmqpMessage{OwnerID: "user-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	delivery := deadLetters.Next(receiveTimeout)
+	if delivery.RoutingKey != "trip.event.rated" || delivery.Message.OwnerID != "user-1" {
+		t.Errorf("dead letter = %s for %s, want trip.event.rated for user-1", delivery.RoutingKey, delivery.Message.OwnerID)
+	}
+}
+
+func TestInMemoryBusPublishesToEveryQueueOrNone(t *testing.T) {
+	h := messagingtest.NewHarness(t)
+
+	if err := h.Bus.DeclareAndBindQueue("full", []string{"test.fill", "test.shared"}, messaging.TripExchange); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Bus.DeclareAndBindQueue("empty", []string{"test.shared"}, messaging.TripExchange); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing consumes "full", it fills up
+	var err error
+	for err == nil {
+		err = h.Bus.PublishMessage(context.Background(), "test.fill", contracts.AmqpMessage{})
+	}
+
+	if err := h.Bus.PublishMessage(context.Background(), "test.shared", contracts.AmqpMessage{}); err == nil {
+		t.Fatal("publishing to a full queue succeeded")
+	}
+	h.Record("empty").ExpectNone(quietWait)
+}
+
+func TestInMemoryBusDropsCancelledScheduledMessages(t *testing.T) {
+	h := messagingtest.NewHarness(t)
+
+	if err := h.Bus.DeclareAndBindQueue("timeouts", []string{"trip.event.no_drivers_found"}, messaging.TripExchange); err != nil {
+		t.Fatal(err)
+	}
+	timeouts := h.Record("timeouts")
+
+	cancelled, err := h.Bus.PublishDelayed(context.Background(), "trip.event.no_drivers_found", contracts.AmqpMessage{OwnerID: "cancelled"}, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Bus.CancelScheduled(context.Background(), cancelled); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Bus.PublishDelayed(context.Background(), "trip.event.no_drivers_found", contracts.AmqpMessage{OwnerID: "due"}, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := timeouts.Next(receiveTimeout).Message.OwnerID; got != "due" {
+		t.Errorf("delivered the message of %s, want due", got)
+	}
+	timeouts.ExpectNone(quietWait)
+}
+
+func publish(t *testing.T, h *messagingtest.Harness, routingKey string) {
+	t.Helper()
+
+	if err := h.Bus.PublishMessage(context.Background(), routingKey, contracts.AmqpMessage{}); err != nil {
+		t.Fatalf("failed to publish %s: %v", routingKey, err)
+	}
+}
@@ -0,0 +1,100 @@
+// Package messagingtest runs the services talking over the message bus together in one test
+// binary, on an in-memory bus with the RabbitMQ topology, without a broker.
+package messagingtest
+
+import (
+	"context"
+	"go-ride/shared/contracts"
+	"go-ride/shared/messaging"
+	"testing"
+	"time"
+)
+
+// Delivery is a message received from a queue with the routing key it was published with.
+type Delivery struct {
+	RoutingKey string
+	Message    contracts.AmqpMessage
+}
+
+// Harness owns an in-memory bus closed when the test ends. Services under test publish and
+// consume on Bus, queues of the services left out are read with Record.
+type Harness struct {
+	Bus *messaging.InMemoryBus
+
+	t   testing.TB
+	ctx context.Context
+}
+
+func NewHarness(t testing.TB) *Harness {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := messaging.NewInMemoryBus()
+	t.Cleanup(func() {
+		cancel()
+		bus.Close()
+	})
+
+	return &Harness{
+		Bus: bus,
+		t:   t,
+		ctx: ctx,
+	}
+}
+
+// Context is cancelled when the test ends, consumers started with it stop then.
+func (h *Harness) Context() context.Context {
+	return h.ctx
+}
+
+// Record consumes the queue in place of the service reading it and keeps what it receives.
+func (h *Harness) Record(queue string) *Recorder {
+	h.t.Helper()
+
+	recorder := &Recorder{
+		t:          h.t,
+		queue:      queue,
+		deliveries: make(chan Delivery, 64),
+	}
+
+	err := h.Bus.ConsumeMessages(h.ctx, queue, func(_ context.Context, routingKey string, message contracts.AmqpMessage) error {
+		recorder.deliveries <- Delivery{RoutingKey: routingKey, Message: message}
+		return nil
+	})
+	if err != nil {
+		h.t.Fatalf("failed to consume from %s: %v", queue, err)
+	}
+
+	return recorder
+}
+
+// Recorder holds the messages received from a queue, oldest first.
+type Recorder struct {
+	t          testing.TB
+	queue      string
+	deliveries chan Delivery
+}
+
+// Next returns the next message of the queue, failing the test when none arrives within the timeout.
+func (r *Recorder) Next(timeout time.Duration) Delivery {
+	r.t.Helper()
+
+	select {
+	case delivery := <-r.deliveries:
+		return delivery
+	case <-time.After(timeout):
+		r.t.Fatalf("no message received from %s within %s", r.queue, timeout)
+		return Delivery{}
+	}
+}
+
+// ExpectNone fails the test when a message arrives on the queue within the wait.
+func (r *Recorder) ExpectNone(wait time.Duration) {
+	r.t.Helper()
+
+	select {
+	case delivery := <-r.deliveries:
+		r.t.Fatalf("unexpected message from %s with routing key %s", r.queue, delivery.RoutingKey)
+	case <-time.After(wait):
+	}
+}
@@ -7,7 +7,10 @@ import (
 	"go-ride/shared/contracts"
 	"go-ride/shared/env"
 	"log"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
@@ -23,10 +26,14 @@ var (
 type RabbitMQ struct {
 	conn    *amqp.Connection
 	Channel *amqp.Channel
+
+	schedules   ScheduleStore
+	delayQueues map[time.Duration]bool // delays whose TTL queue was already declared
+	delayMutex  sync.Mutex
 }
 
 func NewRabbitMQ(uri string) (*RabbitMQ, error) {
-	conn, err := amqp.Dial(AMQPAddr)
+	conn, err := amqp.Dial(uri)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to rabbitmq: %v", err)
 	}
@@ -38,8 +45,10 @@ func NewRabbitMQ(uri string) (*RabbitMQ, error) {
 	}
 
 	rmq := &RabbitMQ{
-		conn:    conn,
-		Channel: ch,
+		conn:        conn,
+		Channel:     ch,
+		schedules:   NewInMemoryScheduleStore(),
+		delayQueues: make(map[time.Duration]bool),
 	}
 
 	if err := rmq.setupExchangesAndQuests(); err != nil {
@@ -61,6 +70,8 @@ func (r *RabbitMQ) PublishMessage(ctx context.Context, routingKey string, messag
 	msg := amqp.Publishing{
 		DeliveryMode: amqp.Persistent,
 		ContentType:  "application/json",
+		MessageId:    uuid.New().String(),
+		Timestamp:    time.Now(),
 		Body:         jsonMsg,
 	}
 
@@ -73,6 +84,155 @@ func (r *RabbitMQ) PublishMessage(ctx context.Context, routingKey string, messag
 	)
 }
 
+// SetScheduleStore replaces the store used to cancel delayed messages.
+func (r *RabbitMQ) SetScheduleStore(store ScheduleStore) {
+	r.schedules = store
+}
+
+// PublishDelayed publishes the message to the trip exchange once the delay has elapsed.
+// The message waits in a TTL queue dedicated to that delay, which dead-letters it back into
+// the trip exchange with its original routing key. The returned token cancels the delivery.
+func (r *RabbitMQ) PublishDelayed(ctx context.Context, routingKey string, message contracts.AmqpMessage, delay time.Duration) (string, error) {
+	if delay < time.Millisecond {
+		return "", r.PublishMessage(ctx, routingKey, message)
+	}
+
+	exchange, err := r.declareDelayQueue(delay)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("publishing message with routing key: %s delayed by %s", routingKey, delay)
+
+	message.ScheduleToken = uuid.New().String()
+	jsonMsg, err := json.Marshal(message)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal message: %v", err)
+	}
+
+	msg := amqp.Publishing{
+		DeliveryMode: amqp.Persistent,
+		ContentType:  "application/json",
+		MessageId:    uuid.New().String(),
+		Timestamp:    time.Now(),
+		Body:         jsonMsg,
+	}
+
+	if err := r.Channel.PublishWithContext(ctx, exchange, routingKey, false, false, msg); err != nil {
+		return "", fmt.Errorf("failed to publish delayed message: %v", err)
+	}
+
+	return message.ScheduleToken, nil
+}
+
+// CancelScheduled makes consumers drop the delayed message identified by the token.
+func (r *RabbitMQ) CancelScheduled(ctx context.Context, token string) error {
+	return r.schedules.Cancel(ctx, token)
+}
+
+func (r *RabbitMQ) declareDelayQueue(delay time.Duration) (string, error) {
+	name := delayName(delay)
+
+	r.delayMutex.Lock()
+	defer r.delayMutex.Unlock()
+
+	if r.delayQueues[delay] {
+		return name, nil
+	}
+
+	// A fanout exchange keeps the original routing key on the message,
+	// so it is preserved when the TTL expires and the message is dead-lettered
+	err := r.Channel.ExchangeDeclare(
+		name,     // name
+		"fanout", // type
+		true,     // durable
+		false,    // auto-deleted
+		false,    // internal
+		false,    // no-wait
+		nil,      // arguments
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to declare delay exchange %s: %v", name, err)
+	}
+
+	args := amqp.Table{
+		"x-message-ttl":          delay.Milliseconds(),
+		"x-dead-letter-exchange": TripExchange,
+	}
+
+	queue, err := r.Channel.QueueDeclare(
+		name,  // name
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		args,  // arguments with TTL and DLX config
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to declare delay queue %s: %v", name, err)
+	}
+
+	if err := r.Channel.QueueBind(queue.Name, "", name, false, nil); err != nil {
+		return "", fmt.Errorf("failed to bind delay queue %s: %v", name, err)
+	}
+
+	r.delayQueues[delay] = true
+	return name, nil
+}
+
+func (r *RabbitMQ) ConsumeMessages(ctx context.Context, queueName string, handler MessageHandler) error {
+	// Deliver one message at a time so a slow consumer does not hoard the queue
+	if err := r.Channel.Qos(1, 0, false); err != nil {
+		return fmt.Errorf("failed to set QoS: %v", err)
+	}
+
+	msgs, err := r.Channel.Consume(
+		queueName, // queue
+		"",        // consumer
+		false,     // auto-ack
+		false,     // exclusive
+		false,     // no-local
+		false,     // no-wait
+		nil,       // args
+	)
+	if err != nil {
+		return fmt.Errorf("failed to consume from %s: %v", queueName, err)
+	}
+
+	go func() {
+		for msg := range msgs {
+			var message contracts.AmqpMessage
+			if err := json.Unmarshal(msg.Body, &message); err != nil {
+				log.Printf("failed to unmarshal message from %s: %v", queueName, err)
+				msg.Nack(false, false)
+				continue
+			}
+
+			if isScheduleCancelled(ctx, r.schedules, message) {
+				log.Printf("skipping cancelled scheduled message %s from %s", message.ScheduleToken, queueName)
+				msg.Ack(false)
+				continue
+			}
+
+			routingKey := msg.RoutingKey
+			if original, ok := msg.Headers[replayRoutingKeyHeader].(string); ok {
+				routingKey = original
+			}
+
+			if err := handler(ctx, routingKey, message); err != nil {
+				log.Printf("failed to handle message from %s: %v", queueName, err)
+				// Do not requeue, the message goes to the dead letter exchange
+				msg.Nack(false, false)
+				continue
+			}
+
+			msg.Ack(false)
+		}
+	}()
+
+	return nil
+}
+
 func (r *RabbitMQ) setupDeadLetterExchange() error {
 	// Declare the dead letter exchange
 	err := r.Channel.ExchangeDeclare(
@@ -134,37 +294,10 @@ func (r *RabbitMQ) setupExchangesAndQuests() error {
 		return fmt.Errorf("failed to declare exchange: %s: %v", TripExchange, err)
 	}
 
-	if err := r.declareAndBindQueue(
-		FindAvailableDriversQueue,
-		[]string{
-			contracts.TripEventCreated,
-			contracts.TripEventDriverNotInterested,
-		},
-		TripExchange,
-	); err != nil {
-		return err
-	}
-
-	if err := r.declareAndBindQueue(
-		NotifyNoDriversFoundQueue,
-		[]string{contracts.TripEventNoDriversFound},
-		TripExchange,
-	); err != nil {
-		return err
-	}
-
-	if err := r.declareAndBindQueue(
-		NotifyDriverAssignQueue,
-		[]string{contracts.TripEventDriverAssigned},
-		TripExchange,
-	); err != nil {
-		return err
-	}
-
-	return nil
+	return declareTripQueues(r)
 }
 
-func (r *RabbitMQ) declareAndBindQueue(queueName string, messageTypes []string, exchange string) error {
+func (r *RabbitMQ) DeclareAndBindQueue(queueName string, messageTypes []string, exchange string) error {
 	// Add dead letter configuration
 	args := amqp.Table{
 		"x-dead-letter-exchange": DeadLetterExchange,
@@ -179,7 +312,7 @@ func (r *RabbitMQ) declareAndBindQueue(queueName string, messageTypes []string,
 		args,      // arguments with DLX config
 	)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("failed to declare queue %s: %v", queueName, err)
 	}
 
 	for _, msgType := range messageTypes {
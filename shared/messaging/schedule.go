@@ -0,0 +1,106 @@
+package messaging
+
+import (
+	"context"
+	"go-ride/shared/contracts"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DelayExchangePrefix names the fanout exchange and TTL queue created per delay
+	DelayExchangePrefix = "trip.delay"
+
+	scheduleCancelledKey = "messaging:schedule:cancelled"
+	// scheduleTokenTTL bounds how long a cancellation is remembered, it must outlive the longest delay
+	scheduleTokenTTL = 24 * time.Hour
+)
+
+// ScheduleStore records cancelled schedule tokens so consumers can drop
+// delayed messages that are no longer relevant when they come due.
+// Services that cancel and consume a delayed message in different processes
+// must share the store, e.g. through Redis.
+type ScheduleStore interface {
+	Cancel(ctx context.Context, token string) error
+	IsCancelled(ctx context.Context, token string) (bool, error)
+}
+
+type inMemoryScheduleStore struct {
+	cancelled map[string]time.Time // token -> expiration
+	mutex     sync.Mutex
+}
+
+func NewInMemoryScheduleStore() ScheduleStore {
+	return &inMemoryScheduleStore{
+		cancelled: make(map[string]time.Time),
+	}
+}
+
+func (s *inMemoryScheduleStore) Cancel(_ context.Context, token string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	for t, expiresAt := range s.cancelled {
+		if now.After(expiresAt) {
+			delete(s.cancelled, t)
+		}
+	}
+
+	s.cancelled[token] = now.Add(scheduleTokenTTL)
+	return nil
+}
+
+func (s *inMemoryScheduleStore) IsCancelled(_ context.Context, token string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	expiresAt, exists := s.cancelled[token]
+	return exists && time.Now().Before(expiresAt), nil
+}
+
+type redisScheduleStore struct {
+	client *redis.Client
+}
+
+func NewRedisScheduleStore(client *redis.Client) ScheduleStore {
+	return &redisScheduleStore{
+		client: client,
+	}
+}
+
+func (s *redisScheduleStore) Cancel(ctx context.Context, token string) error {
+	return s.client.Set(ctx, scheduleCancelledKey+":"+token, "1", scheduleTokenTTL).Err()
+}
+
+func (s *redisScheduleStore) IsCancelled(ctx context.Context, token string) (bool, error) {
+	count, err := s.client.Exists(ctx, scheduleCancelledKey+":"+token).Result()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func delayName(delay time.Duration) string {
+	return DelayExchangePrefix + "." + strconv.FormatInt(delay.Milliseconds(), 10)
+}
+
+// isScheduleCancelled reports whether a delayed message was cancelled after being scheduled.
+// When the store cannot be reached the message is delivered, handlers must stay idempotent.
+func isScheduleCancelled(ctx context.Context, store ScheduleStore, message contracts.AmqpMessage) bool {
+	if message.ScheduleToken == "" || store == nil {
+		return false
+	}
+
+	cancelled, err := store.IsCancelled(ctx, message.ScheduleToken)
+	if err != nil {
+		log.Printf("failed to check schedule token %s: %v", message.ScheduleToken, err)
+		return false
+	}
+
+	return cancelled
+}
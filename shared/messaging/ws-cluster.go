@@ -0,0 +1,206 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go-ride/shared/contracts"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	wsPresenceKey   = "ws:presence" // ws:presence:<userID> -> instance ID holding the connection
+	wsInstanceKey   = "ws:instance" // ws:instance:<instanceID> -> liveness marker, ws:instance:<instanceID>:users -> set of user IDs
+	wsDeliverPrefix = "ws:deliver"  // ws:deliver:<instanceID> -> pub/sub channel of the instance
+	wsPresenceTTL   = 30 * time.Second
+	wsHeartbeat     = 10 * time.Second
+)
+
+// unregisterScript deletes the presence of a user only when it still points to this instance,
+// so a user that already reconnected to another replica is not unregistered by mistake.
+var unregisterScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type remoteWSMessage struct {
+	UserID  string              `json:"userId"`
+	Message contracts.WSMessage `json:"message"`
+}
+
+// WSCluster lets every API gateway replica deliver WebSocket messages to users connected
+// to any other replica. Each replica records which users it holds in a Redis presence
+// registry and listens on its own pub/sub channel for messages forwarded by the others.
+// Presence expires unless refreshed by the heartbeat, so the users of a replica that dies
+// stop being routed to it once their TTL runs out.
+type WSCluster struct {
+	client     *redis.Client
+	instanceID string
+}
+
+func NewWSCluster(client *redis.Client, instanceID string) *WSCluster {
+	return &WSCluster{
+		client:     client,
+		instanceID: instanceID,
+	}
+}
+
+func (c *WSCluster) InstanceID() string {
+	return c.instanceID
+}
+
+// Register records the user as connected to this instance.
+func (c *WSCluster) Register(ctx context.Context, userID string) error {
+	pipe := c.client.TxPipeline()
+	pipe.Set(ctx, c.presenceKey(userID), c.instanceID, wsPresenceTTL)
+	pipe.SAdd(ctx, c.usersKey(c.instanceID), userID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Unregister removes the user presence if it is still owned by this instance.
+func (c *WSCluster) Unregister(ctx context.Context, userID string) error {
+	if err := unregisterScript.Run(ctx, c.client, []string{c.presenceKey(userID)}, c.instanceID).Err(); err != nil {
+		return err
+	}
+	return c.client.SRem(ctx, c.usersKey(c.instanceID), userID).Err()
+}
+
+// Deliver forwards the message to the instance holding the user connection.
+func (c *WSCluster) Deliver(ctx context.Context, userID string, message contracts.WSMessage) error {
+	instanceID, err := c.client.Get(ctx, c.presenceKey(userID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return ErrConnectionNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to locate user %s: %w", userID, err)
+	}
+
+	if instanceID == c.instanceID {
+		// The presence is stale, the connection is no longer held locally
+		c.Unregister(ctx, userID)
+		return ErrConnectionNotFound
+	}
+
+	payload, err := json.Marshal(remoteWSMessage{UserID: userID, Message: message})
+	if err != nil {
+		return fmt.Errorf("failed to marshal ws message: %w", err)
+	}
+
+	receivers, err := c.client.Publish(ctx, c.deliverChannel(instanceID), payload).Result()
+	if err != nil {
+		return fmt.Errorf("failed to forward ws message to %s: %w", instanceID, err)
+	}
+
+	if receivers == 0 {
+		// Nobody listens on the channel anymore, the instance is gone
+		c.cleanupInstance(ctx, instanceID)
+		return ErrConnectionNotFound
+	}
+
+	return nil
+}
+
+// Run listens for messages forwarded by other instances and hands them to deliver,
+// while keeping this instance and its users alive in the registry. It blocks until
+// ctx is cancelled and then removes the presence of this instance.
+func (c *WSCluster) Run(ctx context.Context, deliver func(userID string, message contracts.WSMessage) error) {
+	pubsub := c.client.Subscribe(ctx, c.deliverChannel(c.instanceID))
+	defer pubsub.Close()
+
+	c.heartbeat(ctx)
+	ticker := time.NewTicker(wsHeartbeat)
+	defer ticker.Stop()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			cleanupCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			c.cleanupInstance(cleanupCtx, c.instanceID)
+			cancel()
+			return
+		case <-ticker.C:
+			c.heartbeat(ctx)
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var remote remoteWSMessage
+			if err := json.Unmarshal([]byte(msg.Payload), &remote); err != nil {
+				log.Printf("[WS] failed to decode forwarded message: %v", err)
+				continue
+			}
+
+			if err := deliver(remote.UserID, remote.Message); err != nil {
+				log.Printf("[WS] failed to deliver forwarded message to user %s: %v", remote.UserID, err)
+			}
+		}
+	}
+}
+
+func (c *WSCluster) heartbeat(ctx context.Context) {
+	if err := c.client.Set(ctx, c.instanceKey(c.instanceID), time.Now().Unix(), wsPresenceTTL).Err(); err != nil {
+		log.Printf("[WS] failed to refresh instance %s: %v", c.instanceID, err)
+		return
+	}
+
+	users, err := c.client.SMembers(ctx, c.usersKey(c.instanceID)).Result()
+	if err != nil {
+		log.Printf("[WS] failed to list users of instance %s: %v", c.instanceID, err)
+		return
+	}
+
+	pipe := c.client.Pipeline()
+	for _, userID := range users {
+		pipe.Expire(ctx, c.presenceKey(userID), wsPresenceTTL)
+	}
+	pipe.Expire(ctx, c.usersKey(c.instanceID), 2*wsPresenceTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("[WS] failed to refresh presence of instance %s: %v", c.instanceID, err)
+	}
+}
+
+// cleanupInstance removes the presence of every user held by the instance.
+func (c *WSCluster) cleanupInstance(ctx context.Context, instanceID string) {
+	users, err := c.client.SMembers(ctx, c.usersKey(instanceID)).Result()
+	if err != nil {
+		log.Printf("[WS] failed to list users of instance %s: %v", instanceID, err)
+		return
+	}
+
+	for _, userID := range users {
+		unregisterScript.Run(ctx, c.client, []string{c.presenceKey(userID)}, instanceID)
+	}
+
+	if err := c.client.Del(ctx, c.usersKey(instanceID), c.instanceKey(instanceID)).Err(); err != nil {
+		log.Printf("[WS] failed to cleanup instance %s: %v", instanceID, err)
+		return
+	}
+
+	log.Printf("[WS] cleaned up presence of instance %s (%d users)", instanceID, len(users))
+}
+
+func (c *WSCluster) presenceKey(userID string) string {
+	return fmt.Sprintf("%s:%s", wsPresenceKey, userID)
+}
+
+func (c *WSCluster) instanceKey(instanceID string) string {
+	return fmt.Sprintf("%s:%s", wsInstanceKey, instanceID)
+}
+
+func (c *WSCluster) usersKey(instanceID string) string {
+	return fmt.Sprintf("%s:%s:users", wsInstanceKey, instanceID)
+}
+
+func (c *WSCluster) deliverChannel(instanceID string) string {
+	return fmt.Sprintf("%s:%s", wsDeliverPrefix, instanceID)
+}
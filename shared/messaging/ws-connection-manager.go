@@ -1,30 +1,81 @@
 package messaging
 
 import (
+	"context"
 	"errors"
+	"hash/fnv"
 	"log"
 	"net/http"
 	"sync"
+	"time"
 
 	"go-ride/shared/contracts"
 
 	"github.com/gorilla/websocket"
 )
 
+const (
+	clusterTimeout = 5 * time.Second
+
+	// WriteWait is the time allowed to write a message to the peer
+	WriteWait = 10 * time.Second
+	// PongWait is the time allowed to read the next pong message from the peer
+	PongWait = 60 * time.Second
+	// pingPeriod must be less than PongWait so the peer answers before the read deadline
+	pingPeriod = (PongWait * 9) / 10
+	// sendQueueSize bounds the messages waiting to be written to a single connection
+	sendQueueSize = 32
+	// sequenceLocks stripes the locks keeping the messages of a user queued in sequence order
+	sequenceLocks = 64
+)
+
+// SlowConsumerPolicy decides what happens when a connection send queue is full.
+type SlowConsumerPolicy string
+
+const (
+	// DropMessages discards the new message and keeps the connection open
+	DropMessages SlowConsumerPolicy = "drop"
+	// Disconnect closes the connection, the client is expected to reconnect
+	Disconnect SlowConsumerPolicy = "disconnect"
+)
+
 var (
 	ErrConnectionNotFound = errors.New("connection not found")
+	ErrSlowConsumer       = errors.New("connection send queue is full")
 )
 
 // connWrapper is a wrapper around the websocket connection to allow for thread-safe operations
-// This is necessary because the websocket connection is not thread-safe
+// The websocket connection supports a single concurrent writer, so outbound messages go through
+// a bounded queue drained by a writer goroutine, which also sends the keepalive pings
 type connWrapper struct {
-	conn  *websocket.Conn
-	mutex sync.Mutex
+	conn      *websocket.Conn
+	send      chan contracts.WSMessage
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// close sends a close frame to the peer and closes the connection, stopping the writer goroutine.
+func (w *connWrapper) close(code int, reason string) {
+	w.closeOnce.Do(func() {
+		close(w.done)
+		w.conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(code, reason),
+			time.Now().Add(WriteWait),
+		)
+		w.conn.Close()
+	})
 }
 
 type ConnectionManager struct {
-	connections map[string]*connWrapper // Local connections storage (userId -> connection)
-	mutex       sync.RWMutex
+	connections        map[string]*connWrapper // Local connections storage (userId -> connection)
+	mutex              sync.RWMutex
+	cluster            *WSCluster      // Optional, delivers to users connected to other gateway replicas
+	sessions           *WSSessionStore // Optional, buffers messages so clients can resume their session
+	slowConsumerPolicy SlowConsumerPolicy
+	// sequenceMutexes hold a user while a message is numbered and queued, or the session
+	// replayed, so the messages of a user reach its connection in sequence order
+	sequenceMutexes [sequenceLocks]sync.Mutex
 }
 
 var upgrader = websocket.Upgrader{
@@ -33,13 +84,40 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-// Note that on multiple instances of the API gateway, the connection manager needs to store the connections on a separate shared storage.
+// Connections are kept in local memory. When running multiple instances of the API gateway,
+// call EnableCluster so messages for users connected to other replicas are forwarded to them.
 func NewConnectionManager() *ConnectionManager {
 	return &ConnectionManager{
-		connections: make(map[string]*connWrapper),
+		connections:        make(map[string]*connWrapper),
+		slowConsumerPolicy: DropMessages,
 	}
 }
 
+// EnableCluster registers local connections in the shared presence registry and
+// forwards messages for users that are not connected to this instance.
+func (cm *ConnectionManager) EnableCluster(cluster *WSCluster) {
+	cm.cluster = cluster
+}
+
+// EnableSessions numbers and buffers every outbound message so clients can resume a dropped session.
+func (cm *ConnectionManager) EnableSessions(sessions *WSSessionStore) {
+	cm.sessions = sessions
+}
+
+// SetSlowConsumerPolicy sets what happens to connections that cannot keep up with their messages.
+func (cm *ConnectionManager) SetSlowConsumerPolicy(policy SlowConsumerPolicy) {
+	cm.slowConsumerPolicy = policy
+}
+
+// RunCluster delivers the messages forwarded by other instances until ctx is cancelled.
+func (cm *ConnectionManager) RunCluster(ctx context.Context) {
+	if cm.cluster == nil {
+		return
+	}
+
+	cm.cluster.Run(ctx, cm.sendLocal)
+}
+
 func (cm *ConnectionManager) Upgrade(w http.ResponseWriter, r *http.Request) (*websocket.Conn, error) {
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -49,20 +127,76 @@ func (cm *ConnectionManager) Upgrade(w http.ResponseWriter, r *http.Request) (*w
 }
 
 func (cm *ConnectionManager) Add(id string, conn *websocket.Conn) {
+	wrapper := &connWrapper{
+		conn: conn,
+		send: make(chan contracts.WSMessage, sendQueueSize),
+		done: make(chan struct{}),
+	}
+
 	cm.mutex.Lock()
-	defer cm.mutex.Unlock()
-	cm.connections[id] = &connWrapper{
-		conn:  conn,
-		mutex: sync.Mutex{},
+	previous, exists := cm.connections[id]
+	cm.connections[id] = wrapper
+	cm.mutex.Unlock()
+
+	// A user holds a single connection, an older one is replaced
+	if exists {
+		previous.close(websocket.ClosePolicyViolation, "replaced by a new connection")
 	}
 
+	go cm.writePump(wrapper)
+
 	log.Printf("Added connection for user %s", id)
+
+	if cm.cluster != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+		defer cancel()
+
+		if err := cm.cluster.Register(ctx, id); err != nil {
+			log.Printf("failed to register presence for user %s: %v", id, err)
+		}
+	}
 }
 
-func (cm *ConnectionManager) Remove(id string) {
+// Remove forgets the connection of the user, unless it was already replaced by a newer one.
+// It reports whether the connection was removed.
+func (cm *ConnectionManager) Remove(id string, conn *websocket.Conn) bool {
 	cm.mutex.Lock()
-	defer cm.mutex.Unlock()
+	wrapper, exists := cm.connections[id]
+	if !exists || wrapper.conn != conn {
+		cm.mutex.Unlock()
+		return false
+	}
 	delete(cm.connections, id)
+	cm.mutex.Unlock()
+
+	wrapper.close(websocket.CloseNormalClosure, "")
+
+	if cm.cluster != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+		defer cancel()
+
+		if err := cm.cluster.Unregister(ctx, id); err != nil {
+			log.Printf("failed to unregister presence for user %s: %v", id, err)
+		}
+	}
+
+	return true
+}
+
+// CloseAll sends a close frame to every local connection, used on gateway shutdown.
+func (cm *ConnectionManager) CloseAll() {
+	cm.mutex.RLock()
+	wrappers := make([]*connWrapper, 0, len(cm.connections))
+	for _, wrapper := range cm.connections {
+		wrappers = append(wrappers, wrapper)
+	}
+	cm.mutex.RUnlock()
+
+	for _, wrapper := range wrappers {
+		wrapper.close(websocket.CloseGoingAway, "server shutting down")
+	}
+
+	log.Printf("Closed %d connections", len(wrappers))
 }
 
 func (cm *ConnectionManager) Get(id string) (*websocket.Conn, bool) {
@@ -75,7 +209,56 @@ func (cm *ConnectionManager) Get(id string) (*websocket.Conn, bool) {
 	return wrapper.conn, true
 }
 
+// SendMessage delivers the message to the user, wherever the user is connected.
+// Local messages are queued, so it never blocks on a slow connection.
+// With sessions enabled the message is buffered first, so a user that is not connected
+// receives it when resuming the session.
 func (cm *ConnectionManager) SendMessage(id string, message contracts.WSMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+
+	if cm.sessions != nil {
+		lock := cm.sequenceLock(id)
+		lock.Lock()
+		defer lock.Unlock()
+
+		recorded, err := cm.sessions.Record(ctx, id, message)
+		if err != nil {
+			log.Printf("failed to record message %s for user %s: %v", message.Type, id, err)
+		} else {
+			message = recorded
+		}
+	}
+
+	err := cm.sendLocal(id, message)
+	if !errors.Is(err, ErrConnectionNotFound) || cm.cluster == nil {
+		return err
+	}
+
+	return cm.cluster.Deliver(ctx, id, message)
+}
+
+// SendTransient delivers a message that is only relevant right now, e.g. a driver location.
+// It is neither numbered nor buffered, so a reconnecting client does not receive it again.
+func (cm *ConnectionManager) SendTransient(id string, message contracts.WSMessage) error {
+	err := cm.sendLocal(id, message)
+	if !errors.Is(err, ErrConnectionNotFound) || cm.cluster == nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+
+	return cm.cluster.Deliver(ctx, id, message)
+}
+
+// Resume sends to a reconnected user the messages sent after lastSeq and the ones still
+// waiting for an acknowledgement. A nil lastSeq only resends the unacknowledged messages.
+func (cm *ConnectionManager) Resume(id string, lastSeq *uint64) error {
+	if cm.sessions == nil {
+		return nil
+	}
+
 	cm.mutex.RLock()
 	wrapper, exists := cm.connections[id]
 	cm.mutex.RUnlock()
@@ -84,8 +267,107 @@ func (cm *ConnectionManager) SendMessage(id string, message contracts.WSMessage)
 		return ErrConnectionNotFound
 	}
 
-	wrapper.mutex.Lock()
-	defer wrapper.mutex.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
 
-	return wrapper.conn.WriteJSON(message)
+	// A message sent while replaying would otherwise be queued before older replayed ones
+	lock := cm.sequenceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	messages, err := cm.sessions.Replay(ctx, id, lastSeq)
+	if err != nil {
+		return err
+	}
+
+	// Replayed messages wait for room in the queue instead of being dropped
+	for _, message := range messages {
+		select {
+		case wrapper.send <- message:
+		case <-wrapper.done:
+			return ErrConnectionNotFound
+		case <-time.After(WriteWait):
+			return ErrSlowConsumer
+		}
+	}
+
+	if len(messages) > 0 {
+		log.Printf("Resumed session for user %s with %d messages", id, len(messages))
+	}
+
+	return nil
+}
+
+// Ack records that the user received a message that required acknowledgement.
+func (cm *ConnectionManager) Ack(id string, seq uint64) error {
+	if cm.sessions == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+
+	return cm.sessions.Ack(ctx, id, seq)
+}
+
+func (cm *ConnectionManager) sequenceLock(id string) *sync.Mutex {
+	hash := fnv.New32a()
+	hash.Write([]byte(id))
+	return &cm.sequenceMutexes[hash.Sum32()%sequenceLocks]
+}
+
+func (cm *ConnectionManager) sendLocal(id string, message contracts.WSMessage) error {
+	cm.mutex.RLock()
+	wrapper, exists := cm.connections[id]
+	cm.mutex.RUnlock()
+
+	if !exists {
+		return ErrConnectionNotFound
+	}
+
+	select {
+	case <-wrapper.done:
+		return ErrConnectionNotFound
+	default:
+	}
+
+	select {
+	case wrapper.send <- message:
+		return nil
+	default:
+	}
+
+	if cm.slowConsumerPolicy == Disconnect {
+		log.Printf("disconnecting slow consumer %s", id)
+		wrapper.close(websocket.CloseTryAgainLater, "slow consumer")
+	} else {
+		log.Printf("dropping message %s for slow consumer %s", message.Type, id)
+	}
+
+	return ErrSlowConsumer
+}
+
+// writePump is the only goroutine writing data frames to the connection.
+func (cm *ConnectionManager) writePump(wrapper *connWrapper) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-wrapper.done:
+			return
+		case message := <-wrapper.send:
+			wrapper.conn.SetWriteDeadline(time.Now().Add(WriteWait))
+			if err := wrapper.conn.WriteJSON(message); err != nil {
+				log.Printf("failed to write message %s: %v", message.Type, err)
+				wrapper.close(websocket.CloseInternalServerErr, "write failed")
+				return
+			}
+		case <-ticker.C:
+			if err := wrapper.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(WriteWait)); err != nil {
+				wrapper.close(websocket.CloseGoingAway, "ping failed")
+				return
+			}
+		}
+	}
 }
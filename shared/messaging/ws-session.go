@@ -0,0 +1,126 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go-ride/shared/contracts"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	wsSeqKey     = "ws:seq"     // ws:seq:<userID> -> last sequence number, never expires so the numbering never starts over
+	wsBufferKey  = "ws:buffer"  // ws:buffer:<userID> -> sorted set of recent messages scored by sequence
+	wsPendingKey = "ws:pending" // ws:pending:<userID> -> hash of unacknowledged messages by sequence
+
+	wsBufferSize = 50
+	wsSessionTTL = 30 * time.Minute
+)
+
+// WSSessionStore keeps the recent messages of every user in Redis so a client can resume
+// its session after a reconnection, on any gateway replica, without losing messages.
+type WSSessionStore struct {
+	client *redis.Client
+}
+
+func NewWSSessionStore(client *redis.Client) *WSSessionStore {
+	return &WSSessionStore{
+		client: client,
+	}
+}
+
+// Record assigns the next sequence number of the user to the message and buffers it.
+// The sequence outlives the buffer, a client resuming after a long idle finds nothing
+// to replay but keeps receiving higher sequence numbers than the ones it has seen.
+func (s *WSSessionStore) Record(ctx context.Context, userID string, message contracts.WSMessage) (contracts.WSMessage, error) {
+	seq, err := s.client.Incr(ctx, s.key(wsSeqKey, userID)).Result()
+	if err != nil {
+		return message, fmt.Errorf("failed to assign sequence number: %w", err)
+	}
+	message.Seq = uint64(seq)
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return message, fmt.Errorf("failed to marshal ws message: %w", err)
+	}
+
+	bufferKey := s.key(wsBufferKey, userID)
+	pendingKey := s.key(wsPendingKey, userID)
+
+	pipe := s.client.TxPipeline()
+	pipe.ZAdd(ctx, bufferKey, redis.Z{Score: float64(seq), Member: payload})
+	pipe.ZRemRangeByRank(ctx, bufferKey, 0, -wsBufferSize-1)
+	pipe.Expire(ctx, bufferKey, wsSessionTTL)
+	if message.AckRequired {
+		pipe.HSet(ctx, pendingKey, strconv.FormatUint(message.Seq, 10), payload)
+		pipe.Expire(ctx, pendingKey, wsSessionTTL)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return message, fmt.Errorf("failed to buffer ws message: %w", err)
+	}
+
+	return message, nil
+}
+
+// Ack marks a message that required acknowledgement as received by the client.
+func (s *WSSessionStore) Ack(ctx context.Context, userID string, seq uint64) error {
+	return s.client.HDel(ctx, s.key(wsPendingKey, userID), strconv.FormatUint(seq, 10)).Err()
+}
+
+// Replay returns, ordered by sequence, the buffered messages after lastSeq together with
+// every message still waiting for an acknowledgement. Without a lastSeq only the
+// unacknowledged messages are returned.
+func (s *WSSessionStore) Replay(ctx context.Context, userID string, lastSeq *uint64) ([]contracts.WSMessage, error) {
+	bySeq := make(map[uint64]contracts.WSMessage)
+
+	if lastSeq != nil {
+		buffered, err := s.client.ZRangeByScore(ctx, s.key(wsBufferKey, userID), &redis.ZRangeBy{
+			Min: "(" + strconv.FormatUint(*lastSeq, 10),
+			Max: "+inf",
+		}).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read buffered messages: %w", err)
+		}
+
+		for _, payload := range buffered {
+			var message contracts.WSMessage
+			if err := json.Unmarshal([]byte(payload), &message); err != nil {
+				return nil, fmt.Errorf("failed to decode buffered message: %w", err)
+			}
+			bySeq[message.Seq] = message
+		}
+	}
+
+	pending, err := s.client.HVals(ctx, s.key(wsPendingKey, userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pending messages: %w", err)
+	}
+
+	for _, payload := range pending {
+		var message contracts.WSMessage
+		if err := json.Unmarshal([]byte(payload), &message); err != nil {
+			return nil, fmt.Errorf("failed to decode pending message: %w", err)
+		}
+		bySeq[message.Seq] = message
+	}
+
+	messages := make([]contracts.WSMessage, 0, len(bySeq))
+	for _, message := range bySeq {
+		messages = append(messages, message)
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].Seq < messages[j].Seq
+	})
+
+	return messages, nil
+}
+
+func (s *WSSessionStore) key(prefix, userID string) string {
+	return fmt.Sprintf("%s:%s", prefix, userID)
+}
@@ -27,6 +27,10 @@ const (
 	DriverStatusType_STATUS_TYPE_UNSPECIFIED DriverStatusType = 0
 	DriverStatusType_ONLINE                  DriverStatusType = 1
 	DriverStatusType_OFFLINE                 DriverStatusType = 2
+	DriverStatusType_ON_TRIP                 DriverStatusType = 3
+	DriverStatusType_EN_ROUTE_TO_PICKUP      DriverStatusType = 4
+	DriverStatusType_PAUSED                  DriverStatusType = 5
+	DriverStatusType_BREAK                   DriverStatusType = 6
 )
 
 // Enum value maps for DriverStatusType.
@@ -35,11 +39,19 @@ var (
 		0: "STATUS_TYPE_UNSPECIFIED",
 		1: "ONLINE",
 		2: "OFFLINE",
+		3: "ON_TRIP",
+		4: "EN_ROUTE_TO_PICKUP",
+		5: "PAUSED",
+		6: "BREAK",
 	}
 	DriverStatusType_value = map[string]int32{
 		"STATUS_TYPE_UNSPECIFIED": 0,
 		"ONLINE":                  1,
 		"OFFLINE":                 2,
+		"ON_TRIP":                 3,
+		"EN_ROUTE_TO_PICKUP":      4,
+		"PAUSED":                  5,
+		"BREAK":                   6,
 	}
 )
 
@@ -70,6 +82,163 @@ func (DriverStatusType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_driver_proto_rawDescGZIP(), []int{0}
 }
 
+// OnboardingState gates who can go ONLINE, only APPROVED drivers are offered trips
+type OnboardingState int32
+
+const (
+	OnboardingState_ONBOARDING_STATE_UNSPECIFIED OnboardingState = 0
+	OnboardingState_APPLIED                      OnboardingState = 1
+	OnboardingState_DOCUMENTS_PENDING            OnboardingState = 2
+	OnboardingState_UNDER_REVIEW                 OnboardingState = 3
+	OnboardingState_APPROVED                     OnboardingState = 4
+	OnboardingState_SUSPENDED                    OnboardingState = 5
+)
+
+// Enum value maps for OnboardingState.
+var (
+	OnboardingState_name = map[int32]string{
+		0: "ONBOARDING_STATE_UNSPECIFIED",
+		1: "APPLIED",
+		2: "DOCUMENTS_PENDING",
+		3: "UNDER_REVIEW",
+		4: "APPROVED",
+		5: "SUSPENDED",
+	}
+	OnboardingState_value = map[string]int32{
+		"ONBOARDING_STATE_UNSPECIFIED": 0,
+		"APPLIED":                      1,
+		"DOCUMENTS_PENDING":            2,
+		"UNDER_REVIEW":                 3,
+		"APPROVED":                     4,
+		"SUSPENDED":                    5,
+	}
+)
+
+func (x OnboardingState) Enum() *OnboardingState {
+	p := new(OnboardingState)
+	*p = x
+	return p
+}
+
+func (x OnboardingState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnboardingState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_driver_proto_enumTypes[1].Descriptor()
+}
+
+func (OnboardingState) Type() protoreflect.EnumType {
+	return &file_proto_driver_proto_enumTypes[1]
+}
+
+func (x OnboardingState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnboardingState.Descriptor instead.
+func (OnboardingState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{1}
+}
+
+type DocumentType int32
+
+const (
+	DocumentType_DOCUMENT_TYPE_UNSPECIFIED DocumentType = 0
+	DocumentType_DRIVER_LICENSE            DocumentType = 1
+	DocumentType_VEHICLE_REGISTRATION      DocumentType = 2
+)
+
+// Enum value maps for DocumentType.
+var (
+	DocumentType_name = map[int32]string{
+		0: "DOCUMENT_TYPE_UNSPECIFIED",
+		1: "DRIVER_LICENSE",
+		2: "VEHICLE_REGISTRATION",
+	}
+	DocumentType_value = map[string]int32{
+		"DOCUMENT_TYPE_UNSPECIFIED": 0,
+		"DRIVER_LICENSE":            1,
+		"VEHICLE_REGISTRATION":      2,
+	}
+)
+
+func (x DocumentType) Enum() *DocumentType {
+	p := new(DocumentType)
+	*p = x
+	return p
+}
+
+func (x DocumentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_driver_proto_enumTypes[2].Descriptor()
+}
+
+func (DocumentType) Type() protoreflect.EnumType {
+	return &file_proto_driver_proto_enumTypes[2]
+}
+
+func (x DocumentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentType.Descriptor instead.
+func (DocumentType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{2}
+}
+
+type EarningsPeriod int32
+
+const (
+	EarningsPeriod_EARNINGS_PERIOD_UNSPECIFIED EarningsPeriod = 0
+	EarningsPeriod_DAILY                       EarningsPeriod = 1
+	EarningsPeriod_WEEKLY                      EarningsPeriod = 2 // weeks start on Monday, like payouts
+)
+
+// Enum value maps for EarningsPeriod.
+var (
+	EarningsPeriod_name = map[int32]string{
+		0: "EARNINGS_PERIOD_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+	}
+	EarningsPeriod_value = map[string]int32{
+		"EARNINGS_PERIOD_UNSPECIFIED": 0,
+		"DAILY":                       1,
+		"WEEKLY":                      2,
+	}
+)
+
+func (x EarningsPeriod) Enum() *EarningsPeriod {
+	p := new(EarningsPeriod)
+	*p = x
+	return p
+}
+
+func (x EarningsPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EarningsPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_driver_proto_enumTypes[3].Descriptor()
+}
+
+func (EarningsPeriod) Type() protoreflect.EnumType {
+	return &file_proto_driver_proto_enumTypes[3]
+}
+
+func (x EarningsPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EarningsPeriod.Descriptor instead.
+func (EarningsPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{3}
+}
+
 type UpdateStatusRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	DriverID       string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
@@ -174,28 +343,27 @@ func (x *UpdateStatusResponse) GetSuccess() bool {
 	return false
 }
 
-type Coordinate struct {
+type GetDriverStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Coordinate) Reset() {
-	*x = Coordinate{}
+func (x *GetDriverStatusRequest) Reset() {
+	*x = GetDriverStatusRequest{}
 	mi := &file_proto_driver_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Coordinate) String() string {
+func (x *GetDriverStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Coordinate) ProtoMessage() {}
+func (*GetDriverStatusRequest) ProtoMessage() {}
 
-func (x *Coordinate) ProtoReflect() protoreflect.Message {
+func (x *GetDriverStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_driver_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -207,47 +375,2761 @@ func (x *Coordinate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
-func (*Coordinate) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDriverStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetDriverStatusRequest) Descriptor() ([]byte, []int) {
 	return file_proto_driver_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Coordinate) GetLatitude() float64 {
+func (x *GetDriverStatusRequest) GetDriverID() string {
 	if x != nil {
-		return x.Latitude
+		return x.DriverID
+	}
+	return ""
+}
+
+type GetDriverStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Status        DriverStatusType       `protobuf:"varint,2,opt,name=Status,proto3,enum=driver.DriverStatusType" json:"Status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverStatusResponse) Reset() {
+	*x = GetDriverStatusResponse{}
+	mi := &file_proto_driver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverStatusResponse) ProtoMessage() {}
+
+func (x *GetDriverStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetDriverStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDriverStatusResponse) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *GetDriverStatusResponse) GetStatus() DriverStatusType {
+	if x != nil {
+		return x.Status
+	}
+	return DriverStatusType_STATUS_TYPE_UNSPECIFIED
+}
+
+type GetDriverLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverLocationRequest) Reset() {
+	*x = GetDriverLocationRequest{}
+	mi := &file_proto_driver_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverLocationRequest) ProtoMessage() {}
+
+func (x *GetDriverLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverLocationRequest.ProtoReflect.Descriptor instead.
+func (*GetDriverLocationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDriverLocationRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type GetDriverLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Location      *Coordinate            `protobuf:"bytes,2,opt,name=Location,proto3" json:"Location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverLocationResponse) Reset() {
+	*x = GetDriverLocationResponse{}
+	mi := &file_proto_driver_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverLocationResponse) ProtoMessage() {}
+
+func (x *GetDriverLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverLocationResponse.ProtoReflect.Descriptor instead.
+func (*GetDriverLocationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDriverLocationResponse) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *GetDriverLocationResponse) GetLocation() *Coordinate {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetNearbyDriversRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Coordinate            `protobuf:"bytes,1,opt,name=Location,proto3" json:"Location,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,2,opt,name=RadiusKm,proto3" json:"RadiusKm,omitempty"`
+	PackageSlug   string                 `protobuf:"bytes,3,opt,name=PackageSlug,proto3" json:"PackageSlug,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearbyDriversRequest) Reset() {
+	*x = GetNearbyDriversRequest{}
+	mi := &file_proto_driver_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearbyDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearbyDriversRequest) ProtoMessage() {}
+
+func (x *GetNearbyDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearbyDriversRequest.ProtoReflect.Descriptor instead.
+func (*GetNearbyDriversRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetNearbyDriversRequest) GetLocation() *Coordinate {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetNearbyDriversRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
 	}
 	return 0
 }
 
-func (x *Coordinate) GetLongitude() float64 {
+func (x *GetNearbyDriversRequest) GetPackageSlug() string {
 	if x != nil {
-		return x.Longitude
+		return x.PackageSlug
+	}
+	return ""
+}
+
+func (x *GetNearbyDriversRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-var File_proto_driver_proto protoreflect.FileDescriptor
+// NearbyDriver carries an anonymised ID and a coarsened position, never the exact driver location
+type NearbyDriver struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Location      *Coordinate            `protobuf:"bytes,2,opt,name=Location,proto3" json:"Location,omitempty"`
+	Heading       float64                `protobuf:"fixed64,3,opt,name=Heading,proto3" json:"Heading,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_driver_proto_rawDesc = "" +
-	"\n" +
-	"\x12proto/driver.proto\x12\x06driver\"\x9f\x01\n" +
-	"\x13UpdateStatusRequest\x12\x1a\n" +
-	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x120\n" +
-	"\x06Status\x18\x02 \x01(\x0e2\x18.driver.DriverStatusTypeR\x06Status\x12:\n" +
-	"\x0eActualLocation\x18\x03 \x01(\v2\x12.driver.CoordinateR\x0eActualLocation\"0\n" +
-	"\x14UpdateStatusResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
-	"\n" +
-	"Coordinate\x12\x1a\n" +
-	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x02 \x01(\x01R\tlongitude*H\n" +
-	"\x10DriverStatusType\x12\x1b\n" +
-	"\x17STATUS_TYPE_UNSPECIFIED\x10\x00\x12\n" +
-	"\n" +
-	"\x06ONLINE\x10\x01\x12\v\n" +
-	"\aOFFLINE\x10\x022Z\n" +
+func (x *NearbyDriver) Reset() {
+	*x = NearbyDriver{}
+	mi := &file_proto_driver_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyDriver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyDriver) ProtoMessage() {}
+
+func (x *NearbyDriver) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyDriver.ProtoReflect.Descriptor instead.
+func (*NearbyDriver) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NearbyDriver) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *NearbyDriver) GetLocation() *Coordinate {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NearbyDriver) GetHeading() float64 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+type GetNearbyDriversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drivers       []*NearbyDriver        `protobuf:"bytes,1,rep,name=Drivers,proto3" json:"Drivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearbyDriversResponse) Reset() {
+	*x = GetNearbyDriversResponse{}
+	mi := &file_proto_driver_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearbyDriversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearbyDriversResponse) ProtoMessage() {}
+
+func (x *GetNearbyDriversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearbyDriversResponse.ProtoReflect.Descriptor instead.
+func (*GetNearbyDriversResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetNearbyDriversResponse) GetDrivers() []*NearbyDriver {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+type LocationUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Coordinate            `protobuf:"bytes,1,opt,name=Location,proto3" json:"Location,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"` // unix milliseconds, as reported by the driver app
+	Speed         float64                `protobuf:"fixed64,3,opt,name=Speed,proto3" json:"Speed,omitempty"`        // meters per second
+	Heading       float64                `protobuf:"fixed64,4,opt,name=Heading,proto3" json:"Heading,omitempty"`    // degrees clockwise from north
+	Accuracy      float64                `protobuf:"fixed64,5,opt,name=Accuracy,proto3" json:"Accuracy,omitempty"`  // meters, zero when unknown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationUpdate) Reset() {
+	*x = LocationUpdate{}
+	mi := &file_proto_driver_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationUpdate) ProtoMessage() {}
+
+func (x *LocationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationUpdate.ProtoReflect.Descriptor instead.
+func (*LocationUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LocationUpdate) GetLocation() *Coordinate {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *LocationUpdate) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetHeading() float64 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+type LocationBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Updates       []*LocationUpdate      `protobuf:"bytes,2,rep,name=Updates,proto3" json:"Updates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationBatch) Reset() {
+	*x = LocationBatch{}
+	mi := &file_proto_driver_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationBatch) ProtoMessage() {}
+
+func (x *LocationBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationBatch.ProtoReflect.Descriptor instead.
+func (*LocationBatch) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LocationBatch) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *LocationBatch) GetUpdates() []*LocationUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type StreamLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Received      int64                  `protobuf:"varint,1,opt,name=Received,proto3" json:"Received,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLocationResponse) Reset() {
+	*x = StreamLocationResponse{}
+	mi := &file_proto_driver_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLocationResponse) ProtoMessage() {}
+
+func (x *StreamLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLocationResponse.ProtoReflect.Descriptor instead.
+func (*StreamLocationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StreamLocationResponse) GetReceived() int64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+type GetTripTraceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=TripID,proto3" json:"TripID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripTraceRequest) Reset() {
+	*x = GetTripTraceRequest{}
+	mi := &file_proto_driver_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripTraceRequest) ProtoMessage() {}
+
+func (x *GetTripTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripTraceRequest.ProtoReflect.Descriptor instead.
+func (*GetTripTraceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTripTraceRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+type TracePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Coordinate            `protobuf:"bytes,1,opt,name=Location,proto3" json:"Location,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"` // unix milliseconds
+	Speed         float64                `protobuf:"fixed64,3,opt,name=Speed,proto3" json:"Speed,omitempty"`        // meters per second
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TracePoint) Reset() {
+	*x = TracePoint{}
+	mi := &file_proto_driver_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TracePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracePoint) ProtoMessage() {}
+
+func (x *TracePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracePoint.ProtoReflect.Descriptor instead.
+func (*TracePoint) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TracePoint) GetLocation() *Coordinate {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *TracePoint) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TracePoint) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type GetTripTraceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=TripID,proto3" json:"TripID,omitempty"`
+	DriverID      string                 `protobuf:"bytes,2,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Points        []*TracePoint          `protobuf:"bytes,3,rep,name=Points,proto3" json:"Points,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,4,opt,name=DistanceKm,proto3" json:"DistanceKm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripTraceResponse) Reset() {
+	*x = GetTripTraceResponse{}
+	mi := &file_proto_driver_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripTraceResponse) ProtoMessage() {}
+
+func (x *GetTripTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripTraceResponse.ProtoReflect.Descriptor instead.
+func (*GetTripTraceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTripTraceResponse) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *GetTripTraceResponse) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *GetTripTraceResponse) GetPoints() []*TracePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetTripTraceResponse) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type Vehicle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Make          string                 `protobuf:"bytes,2,opt,name=Make,proto3" json:"Make,omitempty"`
+	Model         string                 `protobuf:"bytes,3,opt,name=Model,proto3" json:"Model,omitempty"`
+	Colour        string                 `protobuf:"bytes,4,opt,name=Colour,proto3" json:"Colour,omitempty"`
+	Plate         string                 `protobuf:"bytes,5,opt,name=Plate,proto3" json:"Plate,omitempty"`
+	Year          int32                  `protobuf:"varint,6,opt,name=Year,proto3" json:"Year,omitempty"`
+	Seats         int32                  `protobuf:"varint,7,opt,name=Seats,proto3" json:"Seats,omitempty"`
+	Packages      []string               `protobuf:"bytes,8,rep,name=Packages,proto3" json:"Packages,omitempty"` // package slugs the vehicle is eligible for, e.g. UBERX, BLACK
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vehicle) Reset() {
+	*x = Vehicle{}
+	mi := &file_proto_driver_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vehicle) ProtoMessage() {}
+
+func (x *Vehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vehicle.ProtoReflect.Descriptor instead.
+func (*Vehicle) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Vehicle) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Vehicle) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *Vehicle) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Vehicle) GetColour() string {
+	if x != nil {
+		return x.Colour
+	}
+	return ""
+}
+
+func (x *Vehicle) GetPlate() string {
+	if x != nil {
+		return x.Plate
+	}
+	return ""
+}
+
+func (x *Vehicle) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Vehicle) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *Vehicle) GetPackages() []string {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+type DriverProfile struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DriverID        string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	ProfilePicture  string                 `protobuf:"bytes,3,opt,name=ProfilePicture,proto3" json:"ProfilePicture,omitempty"`
+	PhoneNumber     string                 `protobuf:"bytes,4,opt,name=PhoneNumber,proto3" json:"PhoneNumber,omitempty"`
+	ActiveVehicleID string                 `protobuf:"bytes,5,opt,name=ActiveVehicleID,proto3" json:"ActiveVehicleID,omitempty"`
+	Vehicles        []*Vehicle             `protobuf:"bytes,6,rep,name=Vehicles,proto3" json:"Vehicles,omitempty"`
+	RatingAverage   float64                `protobuf:"fixed64,7,opt,name=RatingAverage,proto3" json:"RatingAverage,omitempty"` // over the latest ratings given by passengers, zero without ratings
+	RatingCount     int32                  `protobuf:"varint,8,opt,name=RatingCount,proto3" json:"RatingCount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DriverProfile) Reset() {
+	*x = DriverProfile{}
+	mi := &file_proto_driver_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverProfile) ProtoMessage() {}
+
+func (x *DriverProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverProfile.ProtoReflect.Descriptor instead.
+func (*DriverProfile) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DriverProfile) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *DriverProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DriverProfile) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *DriverProfile) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *DriverProfile) GetActiveVehicleID() string {
+	if x != nil {
+		return x.ActiveVehicleID
+	}
+	return ""
+}
+
+func (x *DriverProfile) GetVehicles() []*Vehicle {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *DriverProfile) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *DriverProfile) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type UpsertDriverProfileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DriverID       string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	ProfilePicture string                 `protobuf:"bytes,3,opt,name=ProfilePicture,proto3" json:"ProfilePicture,omitempty"`
+	PhoneNumber    string                 `protobuf:"bytes,4,opt,name=PhoneNumber,proto3" json:"PhoneNumber,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpsertDriverProfileRequest) Reset() {
+	*x = UpsertDriverProfileRequest{}
+	mi := &file_proto_driver_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertDriverProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertDriverProfileRequest) ProtoMessage() {}
+
+func (x *UpsertDriverProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertDriverProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpsertDriverProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpsertDriverProfileRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *UpsertDriverProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertDriverProfileRequest) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *UpsertDriverProfileRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+type GetDriverProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverProfileRequest) Reset() {
+	*x = GetDriverProfileRequest{}
+	mi := &file_proto_driver_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverProfileRequest) ProtoMessage() {}
+
+func (x *GetDriverProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetDriverProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetDriverProfileRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type DeleteDriverProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDriverProfileRequest) Reset() {
+	*x = DeleteDriverProfileRequest{}
+	mi := &file_proto_driver_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDriverProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDriverProfileRequest) ProtoMessage() {}
+
+func (x *DeleteDriverProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDriverProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDriverProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteDriverProfileRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type DeleteDriverProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDriverProfileResponse) Reset() {
+	*x = DeleteDriverProfileResponse{}
+	mi := &file_proto_driver_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDriverProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDriverProfileResponse) ProtoMessage() {}
+
+func (x *DeleteDriverProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDriverProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDriverProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteDriverProfileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AddVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Vehicle       *Vehicle               `protobuf:"bytes,2,opt,name=Vehicle,proto3" json:"Vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVehicleRequest) Reset() {
+	*x = AddVehicleRequest{}
+	mi := &file_proto_driver_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVehicleRequest) ProtoMessage() {}
+
+func (x *AddVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVehicleRequest.ProtoReflect.Descriptor instead.
+func (*AddVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddVehicleRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *AddVehicleRequest) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type UpdateVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Vehicle       *Vehicle               `protobuf:"bytes,2,opt,name=Vehicle,proto3" json:"Vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleRequest) Reset() {
+	*x = UpdateVehicleRequest{}
+	mi := &file_proto_driver_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleRequest) ProtoMessage() {}
+
+func (x *UpdateVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateVehicleRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type DeleteVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	VehicleID     string                 `protobuf:"bytes,2,opt,name=VehicleID,proto3" json:"VehicleID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleRequest) Reset() {
+	*x = DeleteVehicleRequest{}
+	mi := &file_proto_driver_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleRequest) ProtoMessage() {}
+
+func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteVehicleRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *DeleteVehicleRequest) GetVehicleID() string {
+	if x != nil {
+		return x.VehicleID
+	}
+	return ""
+}
+
+type DeleteVehicleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleResponse) Reset() {
+	*x = DeleteVehicleResponse{}
+	mi := &file_proto_driver_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleResponse) ProtoMessage() {}
+
+func (x *DeleteVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteVehicleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetActiveVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	VehicleID     string                 `protobuf:"bytes,2,opt,name=VehicleID,proto3" json:"VehicleID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetActiveVehicleRequest) Reset() {
+	*x = SetActiveVehicleRequest{}
+	mi := &file_proto_driver_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetActiveVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActiveVehicleRequest) ProtoMessage() {}
+
+func (x *SetActiveVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActiveVehicleRequest.ProtoReflect.Descriptor instead.
+func (*SetActiveVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetActiveVehicleRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *SetActiveVehicleRequest) GetVehicleID() string {
+	if x != nil {
+		return x.VehicleID
+	}
+	return ""
+}
+
+type DriverDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          DocumentType           `protobuf:"varint,1,opt,name=Type,proto3,enum=driver.DocumentType" json:"Type,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`             // bytes
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`   // unix milliseconds
+	UploadedAt    int64                  `protobuf:"varint,5,opt,name=UploadedAt,proto3" json:"UploadedAt,omitempty"` // unix milliseconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverDocument) Reset() {
+	*x = DriverDocument{}
+	mi := &file_proto_driver_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverDocument) ProtoMessage() {}
+
+func (x *DriverDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverDocument.ProtoReflect.Descriptor instead.
+func (*DriverDocument) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DriverDocument) GetType() DocumentType {
+	if x != nil {
+		return x.Type
+	}
+	return DocumentType_DOCUMENT_TYPE_UNSPECIFIED
+}
+
+func (x *DriverDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DriverDocument) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DriverDocument) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *DriverDocument) GetUploadedAt() int64 {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return 0
+}
+
+type DriverOnboarding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	State         OnboardingState        `protobuf:"varint,2,opt,name=State,proto3,enum=driver.OnboardingState" json:"State,omitempty"`
+	Documents     []*DriverDocument      `protobuf:"bytes,3,rep,name=Documents,proto3" json:"Documents,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`        // set by the reviewer when rejecting or suspending
+	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"` // unix milliseconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverOnboarding) Reset() {
+	*x = DriverOnboarding{}
+	mi := &file_proto_driver_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverOnboarding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverOnboarding) ProtoMessage() {}
+
+func (x *DriverOnboarding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverOnboarding.ProtoReflect.Descriptor instead.
+func (*DriverOnboarding) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DriverOnboarding) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *DriverOnboarding) GetState() OnboardingState {
+	if x != nil {
+		return x.State
+	}
+	return OnboardingState_ONBOARDING_STATE_UNSPECIFIED
+}
+
+func (x *DriverOnboarding) GetDocuments() []*DriverDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *DriverOnboarding) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DriverOnboarding) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetOnboardingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnboardingRequest) Reset() {
+	*x = GetOnboardingRequest{}
+	mi := &file_proto_driver_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingRequest) ProtoMessage() {}
+
+func (x *GetOnboardingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingRequest.ProtoReflect.Descriptor instead.
+func (*GetOnboardingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetOnboardingRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type UploadDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Type          DocumentType           `protobuf:"varint,2,opt,name=Type,proto3,enum=driver.DocumentType" json:"Type,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"` // unix milliseconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentRequest) Reset() {
+	*x = UploadDocumentRequest{}
+	mi := &file_proto_driver_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentRequest) ProtoMessage() {}
+
+func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UploadDocumentRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetType() DocumentType {
+	if x != nil {
+		return x.Type
+	}
+	return DocumentType_DOCUMENT_TYPE_UNSPECIFIED
+}
+
+func (x *UploadDocumentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UploadDocumentRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type GetDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Type          DocumentType           `protobuf:"varint,2,opt,name=Type,proto3,enum=driver.DocumentType" json:"Type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentRequest) Reset() {
+	*x = GetDocumentRequest{}
+	mi := &file_proto_driver_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentRequest) ProtoMessage() {}
+
+func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetDocumentRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *GetDocumentRequest) GetType() DocumentType {
+	if x != nil {
+		return x.Type
+	}
+	return DocumentType_DOCUMENT_TYPE_UNSPECIFIED
+}
+
+type DocumentContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *DriverDocument        `protobuf:"bytes,1,opt,name=Document,proto3" json:"Document,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=Content,proto3" json:"Content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentContent) Reset() {
+	*x = DocumentContent{}
+	mi := &file_proto_driver_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentContent) ProtoMessage() {}
+
+func (x *DocumentContent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentContent.ProtoReflect.Descriptor instead.
+func (*DocumentContent) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DocumentContent) GetDocument() *DriverDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *DocumentContent) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ReviewDriverRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DriverID string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	// APPROVED, DOCUMENTS_PENDING to ask for new documents, or SUSPENDED
+	State         OnboardingState `protobuf:"varint,2,opt,name=State,proto3,enum=driver.OnboardingState" json:"State,omitempty"`
+	Reason        string          `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewDriverRequest) Reset() {
+	*x = ReviewDriverRequest{}
+	mi := &file_proto_driver_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewDriverRequest) ProtoMessage() {}
+
+func (x *ReviewDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewDriverRequest.ProtoReflect.Descriptor instead.
+func (*ReviewDriverRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReviewDriverRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *ReviewDriverRequest) GetState() OnboardingState {
+	if x != nil {
+		return x.State
+	}
+	return OnboardingState_ONBOARDING_STATE_UNSPECIFIED
+}
+
+func (x *ReviewDriverRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListOnboardingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         OnboardingState        `protobuf:"varint,1,opt,name=State,proto3,enum=driver.OnboardingState" json:"State,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnboardingsRequest) Reset() {
+	*x = ListOnboardingsRequest{}
+	mi := &file_proto_driver_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnboardingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnboardingsRequest) ProtoMessage() {}
+
+func (x *ListOnboardingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnboardingsRequest.ProtoReflect.Descriptor instead.
+func (*ListOnboardingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListOnboardingsRequest) GetState() OnboardingState {
+	if x != nil {
+		return x.State
+	}
+	return OnboardingState_ONBOARDING_STATE_UNSPECIFIED
+}
+
+type ListOnboardingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onboardings   []*DriverOnboarding    `protobuf:"bytes,1,rep,name=Onboardings,proto3" json:"Onboardings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnboardingsResponse) Reset() {
+	*x = ListOnboardingsResponse{}
+	mi := &file_proto_driver_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnboardingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnboardingsResponse) ProtoMessage() {}
+
+func (x *ListOnboardingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnboardingsResponse.ProtoReflect.Descriptor instead.
+func (*ListOnboardingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListOnboardingsResponse) GetOnboardings() []*DriverOnboarding {
+	if x != nil {
+		return x.Onboardings
+	}
+	return nil
+}
+
+type Shift struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ID             string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	StartedAt      int64                  `protobuf:"varint,2,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"` // unix milliseconds
+	EndedAt        int64                  `protobuf:"varint,3,opt,name=EndedAt,proto3" json:"EndedAt,omitempty"`     // unix milliseconds, zero while the shift is open
+	DrivingSeconds int64                  `protobuf:"varint,4,opt,name=DrivingSeconds,proto3" json:"DrivingSeconds,omitempty"`
+	BreakSeconds   int64                  `protobuf:"varint,5,opt,name=BreakSeconds,proto3" json:"BreakSeconds,omitempty"`
+	ForcedBreaks   int32                  `protobuf:"varint,6,opt,name=ForcedBreaks,proto3" json:"ForcedBreaks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Shift) Reset() {
+	*x = Shift{}
+	mi := &file_proto_driver_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shift) ProtoMessage() {}
+
+func (x *Shift) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shift.ProtoReflect.Descriptor instead.
+func (*Shift) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Shift) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Shift) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Shift) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *Shift) GetDrivingSeconds() int64 {
+	if x != nil {
+		return x.DrivingSeconds
+	}
+	return 0
+}
+
+func (x *Shift) GetBreakSeconds() int64 {
+	if x != nil {
+		return x.BreakSeconds
+	}
+	return 0
+}
+
+func (x *Shift) GetForcedBreaks() int32 {
+	if x != nil {
+		return x.ForcedBreaks
+	}
+	return 0
+}
+
+type ListShiftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShiftsRequest) Reset() {
+	*x = ListShiftsRequest{}
+	mi := &file_proto_driver_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShiftsRequest) ProtoMessage() {}
+
+func (x *ListShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListShiftsRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *ListShiftsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListShiftsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Shifts                []*Shift               `protobuf:"bytes,1,rep,name=Shifts,proto3" json:"Shifts,omitempty"` // the open shift first, then the ended ones, newest first
+	DrivingSecondsLast24H int64                  `protobuf:"varint,2,opt,name=DrivingSecondsLast24h,proto3" json:"DrivingSecondsLast24h,omitempty"`
+	DrivingLimitSeconds   int64                  `protobuf:"varint,3,opt,name=DrivingLimitSeconds,proto3" json:"DrivingLimitSeconds,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListShiftsResponse) Reset() {
+	*x = ListShiftsResponse{}
+	mi := &file_proto_driver_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShiftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShiftsResponse) ProtoMessage() {}
+
+func (x *ListShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShiftsResponse.ProtoReflect.Descriptor instead.
+func (*ListShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListShiftsResponse) GetShifts() []*Shift {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+func (x *ListShiftsResponse) GetDrivingSecondsLast24H() int64 {
+	if x != nil {
+		return x.DrivingSecondsLast24H
+	}
+	return 0
+}
+
+func (x *ListShiftsResponse) GetDrivingLimitSeconds() int64 {
+	if x != nil {
+		return x.DrivingLimitSeconds
+	}
+	return 0
+}
+
+// LedgerEntry amounts are in cents
+type LedgerEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ID              string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	TripID          string                 `protobuf:"bytes,2,opt,name=TripID,proto3" json:"TripID,omitempty"`
+	Kind            string                 `protobuf:"bytes,3,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	PackageSlug     string                 `protobuf:"bytes,4,opt,name=PackageSlug,proto3" json:"PackageSlug,omitempty"`
+	FareCents       int64                  `protobuf:"varint,5,opt,name=FareCents,proto3" json:"FareCents,omitempty"`
+	CommissionRate  float64                `protobuf:"fixed64,6,opt,name=CommissionRate,proto3" json:"CommissionRate,omitempty"`
+	CommissionCents int64                  `protobuf:"varint,7,opt,name=CommissionCents,proto3" json:"CommissionCents,omitempty"`
+	EarningsCents   int64                  `protobuf:"varint,8,opt,name=EarningsCents,proto3" json:"EarningsCents,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"` // unix milliseconds
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_proto_driver_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LedgerEntry) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetPackageSlug() string {
+	if x != nil {
+		return x.PackageSlug
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetFareCents() int64 {
+	if x != nil {
+		return x.FareCents
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetCommissionRate() float64 {
+	if x != nil {
+		return x.CommissionRate
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetCommissionCents() int64 {
+	if x != nil {
+		return x.CommissionCents
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetEarningsCents() int64 {
+	if x != nil {
+		return x.EarningsCents
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type EarningsSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart     int64                  `protobuf:"varint,1,opt,name=PeriodStart,proto3" json:"PeriodStart,omitempty"` // unix milliseconds, inclusive
+	PeriodEnd       int64                  `protobuf:"varint,2,opt,name=PeriodEnd,proto3" json:"PeriodEnd,omitempty"`     // unix milliseconds, exclusive
+	Trips           int32                  `protobuf:"varint,3,opt,name=Trips,proto3" json:"Trips,omitempty"`
+	FareCents       int64                  `protobuf:"varint,4,opt,name=FareCents,proto3" json:"FareCents,omitempty"`
+	CommissionCents int64                  `protobuf:"varint,5,opt,name=CommissionCents,proto3" json:"CommissionCents,omitempty"`
+	EarningsCents   int64                  `protobuf:"varint,6,opt,name=EarningsCents,proto3" json:"EarningsCents,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EarningsSummary) Reset() {
+	*x = EarningsSummary{}
+	mi := &file_proto_driver_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EarningsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EarningsSummary) ProtoMessage() {}
+
+func (x *EarningsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EarningsSummary.ProtoReflect.Descriptor instead.
+func (*EarningsSummary) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EarningsSummary) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *EarningsSummary) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *EarningsSummary) GetTrips() int32 {
+	if x != nil {
+		return x.Trips
+	}
+	return 0
+}
+
+func (x *EarningsSummary) GetFareCents() int64 {
+	if x != nil {
+		return x.FareCents
+	}
+	return 0
+}
+
+func (x *EarningsSummary) GetCommissionCents() int64 {
+	if x != nil {
+		return x.CommissionCents
+	}
+	return 0
+}
+
+func (x *EarningsSummary) GetEarningsCents() int64 {
+	if x != nil {
+		return x.EarningsCents
+	}
+	return 0
+}
+
+type GetEarningsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=From,proto3" json:"From,omitempty"` // unix milliseconds
+	To            int64                  `protobuf:"varint,3,opt,name=To,proto3" json:"To,omitempty"`     // unix milliseconds
+	Period        EarningsPeriod         `protobuf:"varint,4,opt,name=Period,proto3,enum=driver.EarningsPeriod" json:"Period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEarningsRequest) Reset() {
+	*x = GetEarningsRequest{}
+	mi := &file_proto_driver_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEarningsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEarningsRequest) ProtoMessage() {}
+
+func (x *GetEarningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEarningsRequest.ProtoReflect.Descriptor instead.
+func (*GetEarningsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetEarningsRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *GetEarningsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetEarningsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetEarningsRequest) GetPeriod() EarningsPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return EarningsPeriod_EARNINGS_PERIOD_UNSPECIFIED
+}
+
+type GetEarningsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summaries     []*EarningsSummary     `protobuf:"bytes,1,rep,name=Summaries,proto3" json:"Summaries,omitempty"`
+	Total         *EarningsSummary       `protobuf:"bytes,2,opt,name=Total,proto3" json:"Total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEarningsResponse) Reset() {
+	*x = GetEarningsResponse{}
+	mi := &file_proto_driver_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEarningsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEarningsResponse) ProtoMessage() {}
+
+func (x *GetEarningsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEarningsResponse.ProtoReflect.Descriptor instead.
+func (*GetEarningsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetEarningsResponse) GetSummaries() []*EarningsSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+func (x *GetEarningsResponse) GetTotal() *EarningsSummary {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type GetPayoutStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=DriverID,proto3" json:"DriverID,omitempty"`
+	At            int64                  `protobuf:"varint,2,opt,name=At,proto3" json:"At,omitempty"` // unix milliseconds, any time within the payout period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutStatementRequest) Reset() {
+	*x = GetPayoutStatementRequest{}
+	mi := &file_proto_driver_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutStatementRequest) ProtoMessage() {}
+
+func (x *GetPayoutStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutStatementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPayoutStatementRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *GetPayoutStatementRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+type GetPayoutStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         *EarningsSummary       `protobuf:"bytes,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,2,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutStatementResponse) Reset() {
+	*x = GetPayoutStatementResponse{}
+	mi := &file_proto_driver_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutStatementResponse) ProtoMessage() {}
+
+func (x *GetPayoutStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoutStatementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPayoutStatementResponse) GetTotal() *EarningsSummary {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetPayoutStatementResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Preciso aprender a fazer import entre arquivos .proto para tirar esse Coordinate daqui
+type Coordinate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coordinate) Reset() {
+	*x = Coordinate{}
+	mi := &file_proto_driver_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinate) ProtoMessage() {}
+
+func (x *Coordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
+func (*Coordinate) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Coordinate) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Coordinate) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+var File_proto_driver_proto protoreflect.FileDescriptor
+
+const file_proto_driver_proto_rawDesc = "" +
+	"\n" +
+	"\x12proto/driver.proto\x12\x06driver\"\x9f\x01\n" +
+	"\x13UpdateStatusRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x120\n" +
+	"\x06Status\x18\x02 \x01(\x0e2\x18.driver.DriverStatusTypeR\x06Status\x12:\n" +
+	"\x0eActualLocation\x18\x03 \x01(\v2\x12.driver.CoordinateR\x0eActualLocation\"0\n" +
+	"\x14UpdateStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x16GetDriverStatusRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\"g\n" +
+	"\x17GetDriverStatusResponse\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x120\n" +
+	"\x06Status\x18\x02 \x01(\x0e2\x18.driver.DriverStatusTypeR\x06Status\"6\n" +
+	"\x18GetDriverLocationRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\"g\n" +
+	"\x19GetDriverLocationResponse\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12.\n" +
+	"\bLocation\x18\x02 \x01(\v2\x12.driver.CoordinateR\bLocation\"\x9d\x01\n" +
+	"\x17GetNearbyDriversRequest\x12.\n" +
+	"\bLocation\x18\x01 \x01(\v2\x12.driver.CoordinateR\bLocation\x12\x1a\n" +
+	"\bRadiusKm\x18\x02 \x01(\x01R\bRadiusKm\x12 \n" +
+	"\vPackageSlug\x18\x03 \x01(\tR\vPackageSlug\x12\x14\n" +
+	"\x05Limit\x18\x04 \x01(\x05R\x05Limit\"h\n" +
+	"\fNearbyDriver\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12.\n" +
+	"\bLocation\x18\x02 \x01(\v2\x12.driver.CoordinateR\bLocation\x12\x18\n" +
+	"\aHeading\x18\x03 \x01(\x01R\aHeading\"J\n" +
+	"\x18GetNearbyDriversResponse\x12.\n" +
+	"\aDrivers\x18\x01 \x03(\v2\x14.driver.NearbyDriverR\aDrivers\"\xaa\x01\n" +
+	"\x0eLocationUpdate\x12.\n" +
+	"\bLocation\x18\x01 \x01(\v2\x12.driver.CoordinateR\bLocation\x12\x1c\n" +
+	"\tTimestamp\x18\x02 \x01(\x03R\tTimestamp\x12\x14\n" +
+	"\x05Speed\x18\x03 \x01(\x01R\x05Speed\x12\x18\n" +
+	"\aHeading\x18\x04 \x01(\x01R\aHeading\x12\x1a\n" +
+	"\bAccuracy\x18\x05 \x01(\x01R\bAccuracy\"]\n" +
+	"\rLocationBatch\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x120\n" +
+	"\aUpdates\x18\x02 \x03(\v2\x16.driver.LocationUpdateR\aUpdates\"4\n" +
+	"\x16StreamLocationResponse\x12\x1a\n" +
+	"\bReceived\x18\x01 \x01(\x03R\bReceived\"-\n" +
+	"\x13GetTripTraceRequest\x12\x16\n" +
+	"\x06TripID\x18\x01 \x01(\tR\x06TripID\"p\n" +
+	"\n" +
+	"TracePoint\x12.\n" +
+	"\bLocation\x18\x01 \x01(\v2\x12.driver.CoordinateR\bLocation\x12\x1c\n" +
+	"\tTimestamp\x18\x02 \x01(\x03R\tTimestamp\x12\x14\n" +
+	"\x05Speed\x18\x03 \x01(\x01R\x05Speed\"\x96\x01\n" +
+	"\x14GetTripTraceResponse\x12\x16\n" +
+	"\x06TripID\x18\x01 \x01(\tR\x06TripID\x12\x1a\n" +
+	"\bDriverID\x18\x02 \x01(\tR\bDriverID\x12*\n" +
+	"\x06Points\x18\x03 \x03(\v2\x12.driver.TracePointR\x06Points\x12\x1e\n" +
+	"\n" +
+	"DistanceKm\x18\x04 \x01(\x01R\n" +
+	"DistanceKm\"\xb7\x01\n" +
+	"\aVehicle\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x12\n" +
+	"\x04Make\x18\x02 \x01(\tR\x04Make\x12\x14\n" +
+	"\x05Model\x18\x03 \x01(\tR\x05Model\x12\x16\n" +
+	"\x06Colour\x18\x04 \x01(\tR\x06Colour\x12\x14\n" +
+	"\x05Plate\x18\x05 \x01(\tR\x05Plate\x12\x12\n" +
+	"\x04Year\x18\x06 \x01(\x05R\x04Year\x12\x14\n" +
+	"\x05Seats\x18\a \x01(\x05R\x05Seats\x12\x1a\n" +
+	"\bPackages\x18\b \x03(\tR\bPackages\"\xa8\x02\n" +
+	"\rDriverProfile\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12&\n" +
+	"\x0eProfilePicture\x18\x03 \x01(\tR\x0eProfilePicture\x12 \n" +
+	"\vPhoneNumber\x18\x04 \x01(\tR\vPhoneNumber\x12(\n" +
+	"\x0fActiveVehicleID\x18\x05 \x01(\tR\x0fActiveVehicleID\x12+\n" +
+	"\bVehicles\x18\x06 \x03(\v2\x0f.driver.VehicleR\bVehicles\x12$\n" +
+	"\rRatingAverage\x18\a \x01(\x01R\rRatingAverage\x12 \n" +
+	"\vRatingCount\x18\b \x01(\x05R\vRatingCount\"\x96\x01\n" +
+	"\x1aUpsertDriverProfileRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12&\n" +
+	"\x0eProfilePicture\x18\x03 \x01(\tR\x0eProfilePicture\x12 \n" +
+	"\vPhoneNumber\x18\x04 \x01(\tR\vPhoneNumber\"5\n" +
+	"\x17GetDriverProfileRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\"8\n" +
+	"\x1aDeleteDriverProfileRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\"7\n" +
+	"\x1bDeleteDriverProfileResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Z\n" +
+	"\x11AddVehicleRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12)\n" +
+	"\aVehicle\x18\x02 \x01(\v2\x0f.driver.VehicleR\aVehicle\"]\n" +
+	"\x14UpdateVehicleRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12)\n" +
+	"\aVehicle\x18\x02 \x01(\v2\x0f.driver.VehicleR\aVehicle\"P\n" +
+	"\x14DeleteVehicleRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12\x1c\n" +
+	"\tVehicleID\x18\x02 \x01(\tR\tVehicleID\"1\n" +
+	"\x15DeleteVehicleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	"\x17SetActiveVehicleRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12\x1c\n" +
+	"\tVehicleID\x18\x02 \x01(\tR\tVehicleID\"\xae\x01\n" +
+	"\x0eDriverDocument\x12(\n" +
+	"\x04Type\x18\x01 \x01(\x0e2\x14.driver.DocumentTypeR\x04Type\x12 \n" +
+	"\vContentType\x18\x02 \x01(\tR\vContentType\x12\x12\n" +
+	"\x04Size\x18\x03 \x01(\x03R\x04Size\x12\x1c\n" +
+	"\tExpiresAt\x18\x04 \x01(\x03R\tExpiresAt\x12\x1e\n" +
+	"\n" +
+	"UploadedAt\x18\x05 \x01(\x03R\n" +
+	"UploadedAt\"\xc9\x01\n" +
+	"\x10DriverOnboarding\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12-\n" +
+	"\x05State\x18\x02 \x01(\x0e2\x17.driver.OnboardingStateR\x05State\x124\n" +
+	"\tDocuments\x18\x03 \x03(\v2\x16.driver.DriverDocumentR\tDocuments\x12\x16\n" +
+	"\x06Reason\x18\x04 \x01(\tR\x06Reason\x12\x1c\n" +
+	"\tUpdatedAt\x18\x05 \x01(\x03R\tUpdatedAt\"2\n" +
+	"\x14GetOnboardingRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\"\xb7\x01\n" +
+	"\x15UploadDocumentRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12(\n" +
+	"\x04Type\x18\x02 \x01(\x0e2\x14.driver.DocumentTypeR\x04Type\x12 \n" +
+	"\vContentType\x18\x03 \x01(\tR\vContentType\x12\x18\n" +
+	"\aContent\x18\x04 \x01(\fR\aContent\x12\x1c\n" +
+	"\tExpiresAt\x18\x05 \x01(\x03R\tExpiresAt\"Z\n" +
+	"\x12GetDocumentRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12(\n" +
+	"\x04Type\x18\x02 \x01(\x0e2\x14.driver.DocumentTypeR\x04Type\"_\n" +
+	"\x0fDocumentContent\x122\n" +
+	"\bDocument\x18\x01 \x01(\v2\x16.driver.DriverDocumentR\bDocument\x12\x18\n" +
+	"\aContent\x18\x02 \x01(\fR\aContent\"x\n" +
+	"\x13ReviewDriverRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12-\n" +
+	"\x05State\x18\x02 \x01(\x0e2\x17.driver.OnboardingStateR\x05State\x12\x16\n" +
+	"\x06Reason\x18\x03 \x01(\tR\x06Reason\"G\n" +
+	"\x16ListOnboardingsRequest\x12-\n" +
+	"\x05State\x18\x01 \x01(\x0e2\x17.driver.OnboardingStateR\x05State\"U\n" +
+	"\x17ListOnboardingsResponse\x12:\n" +
+	"\vOnboardings\x18\x01 \x03(\v2\x18.driver.DriverOnboardingR\vOnboardings\"\xbf\x01\n" +
+	"\x05Shift\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x1c\n" +
+	"\tStartedAt\x18\x02 \x01(\x03R\tStartedAt\x12\x18\n" +
+	"\aEndedAt\x18\x03 \x01(\x03R\aEndedAt\x12&\n" +
+	"\x0eDrivingSeconds\x18\x04 \x01(\x03R\x0eDrivingSeconds\x12\"\n" +
+	"\fBreakSeconds\x18\x05 \x01(\x03R\fBreakSeconds\x12\"\n" +
+	"\fForcedBreaks\x18\x06 \x01(\x05R\fForcedBreaks\"E\n" +
+	"\x11ListShiftsRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12\x14\n" +
+	"\x05Limit\x18\x02 \x01(\x05R\x05Limit\"\xa3\x01\n" +
+	"\x12ListShiftsResponse\x12%\n" +
+	"\x06Shifts\x18\x01 \x03(\v2\r.driver.ShiftR\x06Shifts\x124\n" +
+	"\x15DrivingSecondsLast24h\x18\x02 \x01(\x03R\x15DrivingSecondsLast24h\x120\n" +
+	"\x13DrivingLimitSeconds\x18\x03 \x01(\x03R\x13DrivingLimitSeconds\"\x9f\x02\n" +
+	"\vLedgerEntry\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x16\n" +
+	"\x06TripID\x18\x02 \x01(\tR\x06TripID\x12\x12\n" +
+	"\x04Kind\x18\x03 \x01(\tR\x04Kind\x12 \n" +
+	"\vPackageSlug\x18\x04 \x01(\tR\vPackageSlug\x12\x1c\n" +
+	"\tFareCents\x18\x05 \x01(\x03R\tFareCents\x12&\n" +
+	"\x0eCommissionRate\x18\x06 \x01(\x01R\x0eCommissionRate\x12(\n" +
+	"\x0fCommissionCents\x18\a \x01(\x03R\x0fCommissionCents\x12$\n" +
+	"\rEarningsCents\x18\b \x01(\x03R\rEarningsCents\x12\x1c\n" +
+	"\tCreatedAt\x18\t \x01(\x03R\tCreatedAt\"\xd5\x01\n" +
+	"\x0fEarningsSummary\x12 \n" +
+	"\vPeriodStart\x18\x01 \x01(\x03R\vPeriodStart\x12\x1c\n" +
+	"\tPeriodEnd\x18\x02 \x01(\x03R\tPeriodEnd\x12\x14\n" +
+	"\x05Trips\x18\x03 \x01(\x05R\x05Trips\x12\x1c\n" +
+	"\tFareCents\x18\x04 \x01(\x03R\tFareCents\x12(\n" +
+	"\x0fCommissionCents\x18\x05 \x01(\x03R\x0fCommissionCents\x12$\n" +
+	"\rEarningsCents\x18\x06 \x01(\x03R\rEarningsCents\"\x84\x01\n" +
+	"\x12GetEarningsRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12\x12\n" +
+	"\x04From\x18\x02 \x01(\x03R\x04From\x12\x0e\n" +
+	"\x02To\x18\x03 \x01(\x03R\x02To\x12.\n" +
+	"\x06Period\x18\x04 \x01(\x0e2\x16.driver.EarningsPeriodR\x06Period\"{\n" +
+	"\x13GetEarningsResponse\x125\n" +
+	"\tSummaries\x18\x01 \x03(\v2\x17.driver.EarningsSummaryR\tSummaries\x12-\n" +
+	"\x05Total\x18\x02 \x01(\v2\x17.driver.EarningsSummaryR\x05Total\"G\n" +
+	"\x19GetPayoutStatementRequest\x12\x1a\n" +
+	"\bDriverID\x18\x01 \x01(\tR\bDriverID\x12\x0e\n" +
+	"\x02At\x18\x02 \x01(\x03R\x02At\"z\n" +
+	"\x1aGetPayoutStatementResponse\x12-\n" +
+	"\x05Total\x18\x01 \x01(\v2\x17.driver.EarningsSummaryR\x05Total\x12-\n" +
+	"\aEntries\x18\x02 \x03(\v2\x13.driver.LedgerEntryR\aEntries\"F\n" +
+	"\n" +
+	"Coordinate\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude*\x84\x01\n" +
+	"\x10DriverStatusType\x12\x1b\n" +
+	"\x17STATUS_TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06ONLINE\x10\x01\x12\v\n" +
+	"\aOFFLINE\x10\x02\x12\v\n" +
+	"\aON_TRIP\x10\x03\x12\x16\n" +
+	"\x12EN_ROUTE_TO_PICKUP\x10\x04\x12\n" +
+	"\n" +
+	"\x06PAUSED\x10\x05\x12\t\n" +
+	"\x05BREAK\x10\x06*\x86\x01\n" +
+	"\x0fOnboardingState\x12 \n" +
+	"\x1cONBOARDING_STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aAPPLIED\x10\x01\x12\x15\n" +
+	"\x11DOCUMENTS_PENDING\x10\x02\x12\x10\n" +
+	"\fUNDER_REVIEW\x10\x03\x12\f\n" +
+	"\bAPPROVED\x10\x04\x12\r\n" +
+	"\tSUSPENDED\x10\x05*[\n" +
+	"\fDocumentType\x12\x1d\n" +
+	"\x19DOCUMENT_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eDRIVER_LICENSE\x10\x01\x12\x18\n" +
+	"\x14VEHICLE_REGISTRATION\x10\x02*H\n" +
+	"\x0eEarningsPeriod\x12\x1f\n" +
+	"\x1bEARNINGS_PERIOD_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x022\xe4\f\n" +
 	"\rDriverService\x12I\n" +
-	"\fUpdateStatus\x12\x1b.driver.UpdateStatusRequest\x1a\x1c.driver.UpdateStatusResponseB\x1cZ\x1ashared/proto/driver;driverb\x06proto3"
+	"\fUpdateStatus\x12\x1b.driver.UpdateStatusRequest\x1a\x1c.driver.UpdateStatusResponse\x12R\n" +
+	"\x0fGetDriverStatus\x12\x1e.driver.GetDriverStatusRequest\x1a\x1f.driver.GetDriverStatusResponse\x12U\n" +
+	"\x10GetNearbyDrivers\x12\x1f.driver.GetNearbyDriversRequest\x1a .driver.GetNearbyDriversResponse\x12I\n" +
+	"\x0eStreamLocation\x12\x15.driver.LocationBatch\x1a\x1e.driver.StreamLocationResponse(\x01\x12I\n" +
+	"\fGetTripTrace\x12\x1b.driver.GetTripTraceRequest\x1a\x1c.driver.GetTripTraceResponse\x12X\n" +
+	"\x11GetDriverLocation\x12 .driver.GetDriverLocationRequest\x1a!.driver.GetDriverLocationResponse\x12P\n" +
+	"\x13UpsertDriverProfile\x12\".driver.UpsertDriverProfileRequest\x1a\x15.driver.DriverProfile\x12J\n" +
+	"\x10GetDriverProfile\x12\x1f.driver.GetDriverProfileRequest\x1a\x15.driver.DriverProfile\x12^\n" +
+	"\x13DeleteDriverProfile\x12\".driver.DeleteDriverProfileRequest\x1a#.driver.DeleteDriverProfileResponse\x128\n" +
+	"\n" +
+	"AddVehicle\x12\x19.driver.AddVehicleRequest\x1a\x0f.driver.Vehicle\x12>\n" +
+	"\rUpdateVehicle\x12\x1c.driver.UpdateVehicleRequest\x1a\x0f.driver.Vehicle\x12L\n" +
+	"\rDeleteVehicle\x12\x1c.driver.DeleteVehicleRequest\x1a\x1d.driver.DeleteVehicleResponse\x12J\n" +
+	"\x10SetActiveVehicle\x12\x1f.driver.SetActiveVehicleRequest\x1a\x15.driver.DriverProfile\x12G\n" +
+	"\rGetOnboarding\x12\x1c.driver.GetOnboardingRequest\x1a\x18.driver.DriverOnboarding\x12I\n" +
+	"\x0eUploadDocument\x12\x1d.driver.UploadDocumentRequest\x1a\x18.driver.DriverOnboarding\x12B\n" +
+	"\vGetDocument\x12\x1a.driver.GetDocumentRequest\x1a\x17.driver.DocumentContent\x12E\n" +
+	"\fReviewDriver\x12\x1b.driver.ReviewDriverRequest\x1a\x18.driver.DriverOnboarding\x12R\n" +
+	"\x0fListOnboardings\x12\x1e.driver.ListOnboardingsRequest\x1a\x1f.driver.ListOnboardingsResponse\x12C\n" +
+	"\n" +
+	"ListShifts\x12\x19.driver.ListShiftsRequest\x1a\x1a.driver.ListShiftsResponse\x12F\n" +
+	"\vGetEarnings\x12\x1a.driver.GetEarningsRequest\x1a\x1b.driver.GetEarningsResponse\x12[\n" +
+	"\x12GetPayoutStatement\x12!.driver.GetPayoutStatementRequest\x1a\".driver.GetPayoutStatementResponseB\x1cZ\x1ashared/proto/driver;driverb\x06proto3"
 
 var (
 	file_proto_driver_proto_rawDescOnce sync.Once
@@ -261,24 +3143,136 @@ func file_proto_driver_proto_rawDescGZIP() []byte {
 	return file_proto_driver_proto_rawDescData
 }
 
-var file_proto_driver_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_driver_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_proto_driver_proto_goTypes = []any{
-	(DriverStatusType)(0),        // 0: driver.DriverStatusType
-	(*UpdateStatusRequest)(nil),  // 1: driver.UpdateStatusRequest
-	(*UpdateStatusResponse)(nil), // 2: driver.UpdateStatusResponse
-	(*Coordinate)(nil),           // 3: driver.Coordinate
+	(DriverStatusType)(0),               // 0: driver.DriverStatusType
+	(OnboardingState)(0),                // 1: driver.OnboardingState
+	(DocumentType)(0),                   // 2: driver.DocumentType
+	(EarningsPeriod)(0),                 // 3: driver.EarningsPeriod
+	(*UpdateStatusRequest)(nil),         // 4: driver.UpdateStatusRequest
+	(*UpdateStatusResponse)(nil),        // 5: driver.UpdateStatusResponse
+	(*GetDriverStatusRequest)(nil),      // 6: driver.GetDriverStatusRequest
+	(*GetDriverStatusResponse)(nil),     // 7: driver.GetDriverStatusResponse
+	(*GetDriverLocationRequest)(nil),    // 8: driver.GetDriverLocationRequest
+	(*GetDriverLocationResponse)(nil),   // 9: driver.GetDriverLocationResponse
+	(*GetNearbyDriversRequest)(nil),     // 10: driver.GetNearbyDriversRequest
+	(*NearbyDriver)(nil),                // 11: driver.NearbyDriver
+	(*GetNearbyDriversResponse)(nil),    // 12: driver.GetNearbyDriversResponse
+	(*LocationUpdate)(nil),              // 13: driver.LocationUpdate
+	(*LocationBatch)(nil),               // 14: driver.LocationBatch
+	(*StreamLocationResponse)(nil),      // 15: driver.StreamLocationResponse
+	(*GetTripTraceRequest)(nil),         // 16: driver.GetTripTraceRequest
+	(*TracePoint)(nil),                  // 17: driver.TracePoint
+	(*GetTripTraceResponse)(nil),        // 18: driver.GetTripTraceResponse
+	(*Vehicle)(nil),                     // 19: driver.Vehicle
+	(*DriverProfile)(nil),               // 20: driver.DriverProfile
+	(*UpsertDriverProfileRequest)(nil),  // 21: driver.UpsertDriverProfileRequest
+	(*GetDriverProfileRequest)(nil),     // 22: driver.GetDriverProfileRequest
+	(*DeleteDriverProfileRequest)(nil),  // 23: driver.DeleteDriverProfileRequest
+	(*DeleteDriverProfileResponse)(nil), // 24: driver.DeleteDriverProfileResponse
+	(*AddVehicleRequest)(nil),           // 25: driver.AddVehicleRequest
+	(*UpdateVehicleRequest)(nil),        // 26: driver.UpdateVehicleRequest
+	(*DeleteVehicleRequest)(nil),        // 27: driver.DeleteVehicleRequest
+	(*DeleteVehicleResponse)(nil),       // 28: driver.DeleteVehicleResponse
+	(*SetActiveVehicleRequest)(nil),     // 29: driver.SetActiveVehicleRequest
+	(*DriverDocument)(nil),              // 30: driver.DriverDocument
+	(*DriverOnboarding)(nil),            // 31: driver.DriverOnboarding
+	(*GetOnboardingRequest)(nil),        // 32: driver.GetOnboardingRequest
+	(*UploadDocumentRequest)(nil),       // 33: driver.UploadDocumentRequest
+	(*GetDocumentRequest)(nil),          // 34: driver.GetDocumentRequest
+	(*DocumentContent)(nil),             // 35: driver.DocumentContent
+	(*ReviewDriverRequest)(nil),         // 36: driver.ReviewDriverRequest
+	(*ListOnboardingsRequest)(nil),      // 37: driver.ListOnboardingsRequest
+	(*ListOnboardingsResponse)(nil),     // 38: driver.ListOnboardingsResponse
+	(*Shift)(nil),                       // 39: driver.Shift
+	(*ListShiftsRequest)(nil),           // 40: driver.ListShiftsRequest
+	(*ListShiftsResponse)(nil),          // 41: driver.ListShiftsResponse
+	(*LedgerEntry)(nil),                 // 42: driver.LedgerEntry
+	(*EarningsSummary)(nil),             // 43: driver.EarningsSummary
+	(*GetEarningsRequest)(nil),          // 44: driver.GetEarningsRequest
+	(*GetEarningsResponse)(nil),         // 45: driver.GetEarningsResponse
+	(*GetPayoutStatementRequest)(nil),   // 46: driver.GetPayoutStatementRequest
+	(*GetPayoutStatementResponse)(nil),  // 47: driver.GetPayoutStatementResponse
+	(*Coordinate)(nil),                  // 48: driver.Coordinate
 }
 var file_proto_driver_proto_depIdxs = []int32{
-	0, // 0: driver.UpdateStatusRequest.Status:type_name -> driver.DriverStatusType
-	3, // 1: driver.UpdateStatusRequest.ActualLocation:type_name -> driver.Coordinate
-	1, // 2: driver.DriverService.UpdateStatus:input_type -> driver.UpdateStatusRequest
-	2, // 3: driver.DriverService.UpdateStatus:output_type -> driver.UpdateStatusResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: driver.UpdateStatusRequest.Status:type_name -> driver.DriverStatusType
+	48, // 1: driver.UpdateStatusRequest.ActualLocation:type_name -> driver.Coordinate
+	0,  // 2: driver.GetDriverStatusResponse.Status:type_name -> driver.DriverStatusType
+	48, // 3: driver.GetDriverLocationResponse.Location:type_name -> driver.Coordinate
+	48, // 4: driver.GetNearbyDriversRequest.Location:type_name -> driver.Coordinate
+	48, // 5: driver.NearbyDriver.Location:type_name -> driver.Coordinate
+	11, // 6: driver.GetNearbyDriversResponse.Drivers:type_name -> driver.NearbyDriver
+	48, // 7: driver.LocationUpdate.Location:type_name -> driver.Coordinate
+	13, // 8: driver.LocationBatch.Updates:type_name -> driver.LocationUpdate
+	48, // 9: driver.TracePoint.Location:type_name -> driver.Coordinate
+	17, // 10: driver.GetTripTraceResponse.Points:type_name -> driver.TracePoint
+	19, // 11: driver.DriverProfile.Vehicles:type_name -> driver.Vehicle
+	19, // 12: driver.AddVehicleRequest.Vehicle:type_name -> driver.Vehicle
+	19, // 13: driver.UpdateVehicleRequest.Vehicle:type_name -> driver.Vehicle
+	2,  // 14: driver.DriverDocument.Type:type_name -> driver.DocumentType
+	1,  // 15: driver.DriverOnboarding.State:type_name -> driver.OnboardingState
+	30, // 16: driver.DriverOnboarding.Documents:type_name -> driver.DriverDocument
+	2,  // 17: driver.UploadDocumentRequest.Type:type_name -> driver.DocumentType
+	2,  // 18: driver.GetDocumentRequest.Type:type_name -> driver.DocumentType
+	30, // 19: driver.DocumentContent.Document:type_name -> driver.DriverDocument
+	1,  // 20: driver.ReviewDriverRequest.State:type_name -> driver.OnboardingState
+	1,  // 21: driver.ListOnboardingsRequest.State:type_name -> driver.OnboardingState
+	31, // 22: driver.ListOnboardingsResponse.Onboardings:type_name -> driver.DriverOnboarding
+	39, // 23: driver.ListShiftsResponse.Shifts:type_name -> driver.Shift
+	3,  // 24: driver.GetEarningsRequest.Period:type_name -> driver.EarningsPeriod
+	43, // 25: driver.GetEarningsResponse.Summaries:type_name -> driver.EarningsSummary
+	43, // 26: driver.GetEarningsResponse.Total:type_name -> driver.EarningsSummary
+	43, // 27: driver.GetPayoutStatementResponse.Total:type_name -> driver.EarningsSummary
+	42, // 28: driver.GetPayoutStatementResponse.Entries:type_name -> driver.LedgerEntry
+	4,  // 29: driver.DriverService.UpdateStatus:input_type -> driver.UpdateStatusRequest
+	6,  // 30: driver.DriverService.GetDriverStatus:input_type -> driver.GetDriverStatusRequest
+	10, // 31: driver.DriverService.GetNearbyDrivers:input_type -> driver.GetNearbyDriversRequest
+	14, // 32: driver.DriverService.StreamLocation:input_type -> driver.LocationBatch
+	16, // 33: driver.DriverService.GetTripTrace:input_type -> driver.GetTripTraceRequest
+	8,  // 34: driver.DriverService.GetDriverLocation:input_type -> driver.GetDriverLocationRequest
+	21, // 35: driver.DriverService.UpsertDriverProfile:input_type -> driver.UpsertDriverProfileRequest
+	22, // 36: driver.DriverService.GetDriverProfile:input_type -> driver.GetDriverProfileRequest
+	23, // 37: driver.DriverService.DeleteDriverProfile:input_type -> driver.DeleteDriverProfileRequest
+	25, // 38: driver.DriverService.AddVehicle:input_type -> driver.AddVehicleRequest
+	26, // 39: driver.DriverService.UpdateVehicle:input_type -> driver.UpdateVehicleRequest
+	27, // 40: driver.DriverService.DeleteVehicle:input_type -> driver.DeleteVehicleRequest
+	29, // 41: driver.DriverService.SetActiveVehicle:input_type -> driver.SetActiveVehicleRequest
+	32, // 42: driver.DriverService.GetOnboarding:input_type -> driver.GetOnboardingRequest
+	33, // 43: driver.DriverService.UploadDocument:input_type -> driver.UploadDocumentRequest
+	34, // 44: driver.DriverService.GetDocument:input_type -> driver.GetDocumentRequest
+	36, // 45: driver.DriverService.ReviewDriver:input_type -> driver.ReviewDriverRequest
+	37, // 46: driver.DriverService.ListOnboardings:input_type -> driver.ListOnboardingsRequest
+	40, // 47: driver.DriverService.ListShifts:input_type -> driver.ListShiftsRequest
+	44, // 48: driver.DriverService.GetEarnings:input_type -> driver.GetEarningsRequest
+	46, // 49: driver.DriverService.GetPayoutStatement:input_type -> driver.GetPayoutStatementRequest
+	5,  // 50: driver.DriverService.UpdateStatus:output_type -> driver.UpdateStatusResponse
+	7,  // 51: driver.DriverService.GetDriverStatus:output_type -> driver.GetDriverStatusResponse
+	12, // 52: driver.DriverService.GetNearbyDrivers:output_type -> driver.GetNearbyDriversResponse
+	15, // 53: driver.DriverService.StreamLocation:output_type -> driver.StreamLocationResponse
+	18, // 54: driver.DriverService.GetTripTrace:output_type -> driver.GetTripTraceResponse
+	9,  // 55: driver.DriverService.GetDriverLocation:output_type -> driver.GetDriverLocationResponse
+	20, // 56: driver.DriverService.UpsertDriverProfile:output_type -> driver.DriverProfile
+	20, // 57: driver.DriverService.GetDriverProfile:output_type -> driver.DriverProfile
+	24, // 58: driver.DriverService.DeleteDriverProfile:output_type -> driver.DeleteDriverProfileResponse
+	19, // 59: driver.DriverService.AddVehicle:output_type -> driver.Vehicle
+	19, // 60: driver.DriverService.UpdateVehicle:output_type -> driver.Vehicle
+	28, // 61: driver.DriverService.DeleteVehicle:output_type -> driver.DeleteVehicleResponse
+	20, // 62: driver.DriverService.SetActiveVehicle:output_type -> driver.DriverProfile
+	31, // 63: driver.DriverService.GetOnboarding:output_type -> driver.DriverOnboarding
+	31, // 64: driver.DriverService.UploadDocument:output_type -> driver.DriverOnboarding
+	35, // 65: driver.DriverService.GetDocument:output_type -> driver.DocumentContent
+	31, // 66: driver.DriverService.ReviewDriver:output_type -> driver.DriverOnboarding
+	38, // 67: driver.DriverService.ListOnboardings:output_type -> driver.ListOnboardingsResponse
+	41, // 68: driver.DriverService.ListShifts:output_type -> driver.ListShiftsResponse
+	45, // 69: driver.DriverService.GetEarnings:output_type -> driver.GetEarningsResponse
+	47, // 70: driver.DriverService.GetPayoutStatement:output_type -> driver.GetPayoutStatementResponse
+	50, // [50:71] is the sub-list for method output_type
+	29, // [29:50] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_proto_driver_proto_init() }
@@ -291,8 +3285,8 @@ func file_proto_driver_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_driver_proto_rawDesc), len(file_proto_driver_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      4,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
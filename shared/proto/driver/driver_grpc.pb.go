@@ -19,7 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DriverService_UpdateStatus_FullMethodName = "/driver.DriverService/UpdateStatus"
+	DriverService_UpdateStatus_FullMethodName        = "/driver.DriverService/UpdateStatus"
+	DriverService_GetDriverStatus_FullMethodName     = "/driver.DriverService/GetDriverStatus"
+	DriverService_GetNearbyDrivers_FullMethodName    = "/driver.DriverService/GetNearbyDrivers"
+	DriverService_StreamLocation_FullMethodName      = "/driver.DriverService/StreamLocation"
+	DriverService_GetTripTrace_FullMethodName        = "/driver.DriverService/GetTripTrace"
+	DriverService_GetDriverLocation_FullMethodName   = "/driver.DriverService/GetDriverLocation"
+	DriverService_UpsertDriverProfile_FullMethodName = "/driver.DriverService/UpsertDriverProfile"
+	DriverService_GetDriverProfile_FullMethodName    = "/driver.DriverService/GetDriverProfile"
+	DriverService_DeleteDriverProfile_FullMethodName = "/driver.DriverService/DeleteDriverProfile"
+	DriverService_AddVehicle_FullMethodName          = "/driver.DriverService/AddVehicle"
+	DriverService_UpdateVehicle_FullMethodName       = "/driver.DriverService/UpdateVehicle"
+	DriverService_DeleteVehicle_FullMethodName       = "/driver.DriverService/DeleteVehicle"
+	DriverService_SetActiveVehicle_FullMethodName    = "/driver.DriverService/SetActiveVehicle"
+	DriverService_GetOnboarding_FullMethodName       = "/driver.DriverService/GetOnboarding"
+	DriverService_UploadDocument_FullMethodName      = "/driver.DriverService/UploadDocument"
+	DriverService_GetDocument_FullMethodName         = "/driver.DriverService/GetDocument"
+	DriverService_ReviewDriver_FullMethodName        = "/driver.DriverService/ReviewDriver"
+	DriverService_ListOnboardings_FullMethodName     = "/driver.DriverService/ListOnboardings"
+	DriverService_ListShifts_FullMethodName          = "/driver.DriverService/ListShifts"
+	DriverService_GetEarnings_FullMethodName         = "/driver.DriverService/GetEarnings"
+	DriverService_GetPayoutStatement_FullMethodName  = "/driver.DriverService/GetPayoutStatement"
 )
 
 // DriverServiceClient is the client API for DriverService service.
@@ -27,6 +47,28 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DriverServiceClient interface {
 	UpdateStatus(ctx context.Context, in *UpdateStatusRequest, opts ...grpc.CallOption) (*UpdateStatusResponse, error)
+	GetDriverStatus(ctx context.Context, in *GetDriverStatusRequest, opts ...grpc.CallOption) (*GetDriverStatusResponse, error)
+	GetNearbyDrivers(ctx context.Context, in *GetNearbyDriversRequest, opts ...grpc.CallOption) (*GetNearbyDriversResponse, error)
+	// StreamLocation receives the location updates of a connected driver, closing the stream marks the driver OFFLINE
+	StreamLocation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LocationBatch, StreamLocationResponse], error)
+	GetTripTrace(ctx context.Context, in *GetTripTraceRequest, opts ...grpc.CallOption) (*GetTripTraceResponse, error)
+	GetDriverLocation(ctx context.Context, in *GetDriverLocationRequest, opts ...grpc.CallOption) (*GetDriverLocationResponse, error)
+	UpsertDriverProfile(ctx context.Context, in *UpsertDriverProfileRequest, opts ...grpc.CallOption) (*DriverProfile, error)
+	GetDriverProfile(ctx context.Context, in *GetDriverProfileRequest, opts ...grpc.CallOption) (*DriverProfile, error)
+	DeleteDriverProfile(ctx context.Context, in *DeleteDriverProfileRequest, opts ...grpc.CallOption) (*DeleteDriverProfileResponse, error)
+	AddVehicle(ctx context.Context, in *AddVehicleRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*DeleteVehicleResponse, error)
+	SetActiveVehicle(ctx context.Context, in *SetActiveVehicleRequest, opts ...grpc.CallOption) (*DriverProfile, error)
+	GetOnboarding(ctx context.Context, in *GetOnboardingRequest, opts ...grpc.CallOption) (*DriverOnboarding, error)
+	UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*DriverOnboarding, error)
+	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*DocumentContent, error)
+	// ReviewDriver is used by operators to approve, reject or suspend a driver
+	ReviewDriver(ctx context.Context, in *ReviewDriverRequest, opts ...grpc.CallOption) (*DriverOnboarding, error)
+	ListOnboardings(ctx context.Context, in *ListOnboardingsRequest, opts ...grpc.CallOption) (*ListOnboardingsResponse, error)
+	ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error)
+	GetEarnings(ctx context.Context, in *GetEarningsRequest, opts ...grpc.CallOption) (*GetEarningsResponse, error)
+	GetPayoutStatement(ctx context.Context, in *GetPayoutStatementRequest, opts ...grpc.CallOption) (*GetPayoutStatementResponse, error)
 }
 
 type driverServiceClient struct {
@@ -47,11 +89,236 @@ func (c *driverServiceClient) UpdateStatus(ctx context.Context, in *UpdateStatus
 	return out, nil
 }
 
+func (c *driverServiceClient) GetDriverStatus(ctx context.Context, in *GetDriverStatusRequest, opts ...grpc.CallOption) (*GetDriverStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriverStatusResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetDriverStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetNearbyDrivers(ctx context.Context, in *GetNearbyDriversRequest, opts ...grpc.CallOption) (*GetNearbyDriversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNearbyDriversResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetNearbyDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) StreamLocation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LocationBatch, StreamLocationResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DriverService_ServiceDesc.Streams[0], DriverService_StreamLocation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LocationBatch, StreamLocationResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverService_StreamLocationClient = grpc.ClientStreamingClient[LocationBatch, StreamLocationResponse]
+
+func (c *driverServiceClient) GetTripTrace(ctx context.Context, in *GetTripTraceRequest, opts ...grpc.CallOption) (*GetTripTraceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTripTraceResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetTripTrace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriverLocation(ctx context.Context, in *GetDriverLocationRequest, opts ...grpc.CallOption) (*GetDriverLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriverLocationResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetDriverLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UpsertDriverProfile(ctx context.Context, in *UpsertDriverProfileRequest, opts ...grpc.CallOption) (*DriverProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverProfile)
+	err := c.cc.Invoke(ctx, DriverService_UpsertDriverProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriverProfile(ctx context.Context, in *GetDriverProfileRequest, opts ...grpc.CallOption) (*DriverProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverProfile)
+	err := c.cc.Invoke(ctx, DriverService_GetDriverProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) DeleteDriverProfile(ctx context.Context, in *DeleteDriverProfileRequest, opts ...grpc.CallOption) (*DeleteDriverProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDriverProfileResponse)
+	err := c.cc.Invoke(ctx, DriverService_DeleteDriverProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) AddVehicle(ctx context.Context, in *AddVehicleRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, DriverService_AddVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, DriverService_UpdateVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*DeleteVehicleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVehicleResponse)
+	err := c.cc.Invoke(ctx, DriverService_DeleteVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) SetActiveVehicle(ctx context.Context, in *SetActiveVehicleRequest, opts ...grpc.CallOption) (*DriverProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverProfile)
+	err := c.cc.Invoke(ctx, DriverService_SetActiveVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetOnboarding(ctx context.Context, in *GetOnboardingRequest, opts ...grpc.CallOption) (*DriverOnboarding, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverOnboarding)
+	err := c.cc.Invoke(ctx, DriverService_GetOnboarding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*DriverOnboarding, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverOnboarding)
+	err := c.cc.Invoke(ctx, DriverService_UploadDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*DocumentContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentContent)
+	err := c.cc.Invoke(ctx, DriverService_GetDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ReviewDriver(ctx context.Context, in *ReviewDriverRequest, opts ...grpc.CallOption) (*DriverOnboarding, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverOnboarding)
+	err := c.cc.Invoke(ctx, DriverService_ReviewDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListOnboardings(ctx context.Context, in *ListOnboardingsRequest, opts ...grpc.CallOption) (*ListOnboardingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnboardingsResponse)
+	err := c.cc.Invoke(ctx, DriverService_ListOnboardings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShiftsResponse)
+	err := c.cc.Invoke(ctx, DriverService_ListShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetEarnings(ctx context.Context, in *GetEarningsRequest, opts ...grpc.CallOption) (*GetEarningsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEarningsResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetEarnings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetPayoutStatement(ctx context.Context, in *GetPayoutStatementRequest, opts ...grpc.CallOption) (*GetPayoutStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayoutStatementResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetPayoutStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverServiceServer is the server API for DriverService service.
 // All implementations must embed UnimplementedDriverServiceServer
 // for forward compatibility.
 type DriverServiceServer interface {
 	UpdateStatus(context.Context, *UpdateStatusRequest) (*UpdateStatusResponse, error)
+	GetDriverStatus(context.Context, *GetDriverStatusRequest) (*GetDriverStatusResponse, error)
+	GetNearbyDrivers(context.Context, *GetNearbyDriversRequest) (*GetNearbyDriversResponse, error)
+	// StreamLocation receives the location updates of a connected driver, closing the stream marks the driver OFFLINE
+	StreamLocation(grpc.ClientStreamingServer[LocationBatch, StreamLocationResponse]) error
+	GetTripTrace(context.Context, *GetTripTraceRequest) (*GetTripTraceResponse, error)
+	GetDriverLocation(context.Context, *GetDriverLocationRequest) (*GetDriverLocationResponse, error)
+	UpsertDriverProfile(context.Context, *UpsertDriverProfileRequest) (*DriverProfile, error)
+	GetDriverProfile(context.Context, *GetDriverProfileRequest) (*DriverProfile, error)
+	DeleteDriverProfile(context.Context, *DeleteDriverProfileRequest) (*DeleteDriverProfileResponse, error)
+	AddVehicle(context.Context, *AddVehicleRequest) (*Vehicle, error)
+	UpdateVehicle(context.Context, *UpdateVehicleRequest) (*Vehicle, error)
+	DeleteVehicle(context.Context, *DeleteVehicleRequest) (*DeleteVehicleResponse, error)
+	SetActiveVehicle(context.Context, *SetActiveVehicleRequest) (*DriverProfile, error)
+	GetOnboarding(context.Context, *GetOnboardingRequest) (*DriverOnboarding, error)
+	UploadDocument(context.Context, *UploadDocumentRequest) (*DriverOnboarding, error)
+	GetDocument(context.Context, *GetDocumentRequest) (*DocumentContent, error)
+	// ReviewDriver is used by operators to approve, reject or suspend a driver
+	ReviewDriver(context.Context, *ReviewDriverRequest) (*DriverOnboarding, error)
+	ListOnboardings(context.Context, *ListOnboardingsRequest) (*ListOnboardingsResponse, error)
+	ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error)
+	GetEarnings(context.Context, *GetEarningsRequest) (*GetEarningsResponse, error)
+	GetPayoutStatement(context.Context, *GetPayoutStatementRequest) (*GetPayoutStatementResponse, error)
 	mustEmbedUnimplementedDriverServiceServer()
 }
 
@@ -65,6 +332,66 @@ type UnimplementedDriverServiceServer struct{}
 func (UnimplementedDriverServiceServer) UpdateStatus(context.Context, *UpdateStatusRequest) (*UpdateStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateStatus not implemented")
 }
+func (UnimplementedDriverServiceServer) GetDriverStatus(context.Context, *GetDriverStatusRequest) (*GetDriverStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDriverStatus not implemented")
+}
+func (UnimplementedDriverServiceServer) GetNearbyDrivers(context.Context, *GetNearbyDriversRequest) (*GetNearbyDriversResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNearbyDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) StreamLocation(grpc.ClientStreamingServer[LocationBatch, StreamLocationResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamLocation not implemented")
+}
+func (UnimplementedDriverServiceServer) GetTripTrace(context.Context, *GetTripTraceRequest) (*GetTripTraceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTripTrace not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriverLocation(context.Context, *GetDriverLocationRequest) (*GetDriverLocationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDriverLocation not implemented")
+}
+func (UnimplementedDriverServiceServer) UpsertDriverProfile(context.Context, *UpsertDriverProfileRequest) (*DriverProfile, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertDriverProfile not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriverProfile(context.Context, *GetDriverProfileRequest) (*DriverProfile, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDriverProfile not implemented")
+}
+func (UnimplementedDriverServiceServer) DeleteDriverProfile(context.Context, *DeleteDriverProfileRequest) (*DeleteDriverProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDriverProfile not implemented")
+}
+func (UnimplementedDriverServiceServer) AddVehicle(context.Context, *AddVehicleRequest) (*Vehicle, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddVehicle not implemented")
+}
+func (UnimplementedDriverServiceServer) UpdateVehicle(context.Context, *UpdateVehicleRequest) (*Vehicle, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateVehicle not implemented")
+}
+func (UnimplementedDriverServiceServer) DeleteVehicle(context.Context, *DeleteVehicleRequest) (*DeleteVehicleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVehicle not implemented")
+}
+func (UnimplementedDriverServiceServer) SetActiveVehicle(context.Context, *SetActiveVehicleRequest) (*DriverProfile, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetActiveVehicle not implemented")
+}
+func (UnimplementedDriverServiceServer) GetOnboarding(context.Context, *GetOnboardingRequest) (*DriverOnboarding, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOnboarding not implemented")
+}
+func (UnimplementedDriverServiceServer) UploadDocument(context.Context, *UploadDocumentRequest) (*DriverOnboarding, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadDocument not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDocument(context.Context, *GetDocumentRequest) (*DocumentContent, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDocument not implemented")
+}
+func (UnimplementedDriverServiceServer) ReviewDriver(context.Context, *ReviewDriverRequest) (*DriverOnboarding, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ListOnboardings(context.Context, *ListOnboardingsRequest) (*ListOnboardingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOnboardings not implemented")
+}
+func (UnimplementedDriverServiceServer) ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShifts not implemented")
+}
+func (UnimplementedDriverServiceServer) GetEarnings(context.Context, *GetEarningsRequest) (*GetEarningsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEarnings not implemented")
+}
+func (UnimplementedDriverServiceServer) GetPayoutStatement(context.Context, *GetPayoutStatementRequest) (*GetPayoutStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPayoutStatement not implemented")
+}
 func (UnimplementedDriverServiceServer) mustEmbedUnimplementedDriverServiceServer() {}
 func (UnimplementedDriverServiceServer) testEmbeddedByValue()                       {}
 
@@ -104,6 +431,355 @@ func _DriverService_UpdateStatus_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DriverService_GetDriverStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriverStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriverStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriverStatus(ctx, req.(*GetDriverStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetNearbyDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearbyDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetNearbyDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetNearbyDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetNearbyDrivers(ctx, req.(*GetNearbyDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_StreamLocation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DriverServiceServer).StreamLocation(&grpc.GenericServerStream[LocationBatch, StreamLocationResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverService_StreamLocationServer = grpc.ClientStreamingServer[LocationBatch, StreamLocationResponse]
+
+func _DriverService_GetTripTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetTripTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetTripTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetTripTrace(ctx, req.(*GetTripTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriverLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriverLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriverLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriverLocation(ctx, req.(*GetDriverLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UpsertDriverProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDriverProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UpsertDriverProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_UpsertDriverProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UpsertDriverProfile(ctx, req.(*UpsertDriverProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriverProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriverProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriverProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriverProfile(ctx, req.(*GetDriverProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_DeleteDriverProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDriverProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).DeleteDriverProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_DeleteDriverProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).DeleteDriverProfile(ctx, req.(*DeleteDriverProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_AddVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).AddVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_AddVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).AddVehicle(ctx, req.(*AddVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UpdateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UpdateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_UpdateVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UpdateVehicle(ctx, req.(*UpdateVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_DeleteVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).DeleteVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_DeleteVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).DeleteVehicle(ctx, req.(*DeleteVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_SetActiveVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetActiveVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).SetActiveVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_SetActiveVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).SetActiveVehicle(ctx, req.(*SetActiveVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetOnboarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnboardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetOnboarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetOnboarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetOnboarding(ctx, req.(*GetOnboardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UploadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UploadDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_UploadDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UploadDocument(ctx, req.(*UploadDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDocument(ctx, req.(*GetDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ReviewDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ReviewDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ReviewDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ReviewDriver(ctx, req.(*ReviewDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListOnboardings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnboardingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListOnboardings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ListOnboardings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListOnboardings(ctx, req.(*ListOnboardingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ListShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListShifts(ctx, req.(*ListShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetEarnings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetEarnings(ctx, req.(*GetEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetPayoutStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetPayoutStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetPayoutStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetPayoutStatement(ctx, req.(*GetPayoutStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DriverService_ServiceDesc is the grpc.ServiceDesc for DriverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +791,89 @@ var DriverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStatus",
 			Handler:    _DriverService_UpdateStatus_Handler,
 		},
+		{
+			MethodName: "GetDriverStatus",
+			Handler:    _DriverService_GetDriverStatus_Handler,
+		},
+		{
+			MethodName: "GetNearbyDrivers",
+			Handler:    _DriverService_GetNearbyDrivers_Handler,
+		},
+		{
+			MethodName: "GetTripTrace",
+			Handler:    _DriverService_GetTripTrace_Handler,
+		},
+		{
+			MethodName: "GetDriverLocation",
+			Handler:    _DriverService_GetDriverLocation_Handler,
+		},
+		{
+			MethodName: "UpsertDriverProfile",
+			Handler:    _DriverService_UpsertDriverProfile_Handler,
+		},
+		{
+			MethodName: "GetDriverProfile",
+			Handler:    _DriverService_GetDriverProfile_Handler,
+		},
+		{
+			MethodName: "DeleteDriverProfile",
+			Handler:    _DriverService_DeleteDriverProfile_Handler,
+		},
+		{
+			MethodName: "AddVehicle",
+			Handler:    _DriverService_AddVehicle_Handler,
+		},
+		{
+			MethodName: "UpdateVehicle",
+			Handler:    _DriverService_UpdateVehicle_Handler,
+		},
+		{
+			MethodName: "DeleteVehicle",
+			Handler:    _DriverService_DeleteVehicle_Handler,
+		},
+		{
+			MethodName: "SetActiveVehicle",
+			Handler:    _DriverService_SetActiveVehicle_Handler,
+		},
+		{
+			MethodName: "GetOnboarding",
+			Handler:    _DriverService_GetOnboarding_Handler,
+		},
+		{
+			MethodName: "UploadDocument",
+			Handler:    _DriverService_UploadDocument_Handler,
+		},
+		{
+			MethodName: "GetDocument",
+			Handler:    _DriverService_GetDocument_Handler,
+		},
+		{
+			MethodName: "ReviewDriver",
+			Handler:    _DriverService_ReviewDriver_Handler,
+		},
+		{
+			MethodName: "ListOnboardings",
+			Handler:    _DriverService_ListOnboardings_Handler,
+		},
+		{
+			MethodName: "ListShifts",
+			Handler:    _DriverService_ListShifts_Handler,
+		},
+		{
+			MethodName: "GetEarnings",
+			Handler:    _DriverService_GetEarnings_Handler,
+		},
+		{
+			MethodName: "GetPayoutStatement",
+			Handler:    _DriverService_GetPayoutStatement_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLocation",
+			Handler:       _DriverService_StreamLocation_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/driver.proto",
 }
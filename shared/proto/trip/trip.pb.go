@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DiscountType int32
+
+const (
+	DiscountType_DISCOUNT_TYPE_UNSPECIFIED DiscountType = 0
+	DiscountType_PERCENTAGE                DiscountType = 1
+	DiscountType_FIXED                     DiscountType = 2
+)
+
+// Enum value maps for DiscountType.
+var (
+	DiscountType_name = map[int32]string{
+		0: "DISCOUNT_TYPE_UNSPECIFIED",
+		1: "PERCENTAGE",
+		2: "FIXED",
+	}
+	DiscountType_value = map[string]int32{
+		"DISCOUNT_TYPE_UNSPECIFIED": 0,
+		"PERCENTAGE":                1,
+		"FIXED":                     2,
+	}
+)
+
+func (x DiscountType) Enum() *DiscountType {
+	p := new(DiscountType)
+	*p = x
+	return p
+}
+
+func (x DiscountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_trip_proto_enumTypes[0].Descriptor()
+}
+
+func (DiscountType) Type() protoreflect.EnumType {
+	return &file_proto_trip_proto_enumTypes[0]
+}
+
+func (x DiscountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountType.Descriptor instead.
+func (DiscountType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{0}
+}
+
 type PackageSlug int32
 
 const (
@@ -41,59 +90,2645 @@ var (
 		"UBERX":                    1,
 		"BLACK":                    2,
 	}
-)
+)
+
+func (x PackageSlug) Enum() *PackageSlug {
+	p := new(PackageSlug)
+	*p = x
+	return p
+}
+
+func (x PackageSlug) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageSlug) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_trip_proto_enumTypes[1].Descriptor()
+}
+
+func (PackageSlug) Type() protoreflect.EnumType {
+	return &file_proto_trip_proto_enumTypes[1]
+}
+
+func (x PackageSlug) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageSlug.Descriptor instead.
+func (PackageSlug) EnumDescriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{1}
+}
+
+type PreviewTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PassengerID   string                 `protobuf:"bytes,1,opt,name=passengerID,proto3" json:"passengerID,omitempty"`
+	StartLocation *Coordinate            `protobuf:"bytes,2,opt,name=startLocation,proto3" json:"startLocation,omitempty"`
+	EndLocation   *Coordinate            `protobuf:"bytes,3,opt,name=endLocation,proto3" json:"endLocation,omitempty"`
+	PromoCode     string                 `protobuf:"bytes,4,opt,name=promoCode,proto3" json:"promoCode,omitempty"` // optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTripRequest) Reset() {
+	*x = PreviewTripRequest{}
+	mi := &file_proto_trip_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTripRequest) ProtoMessage() {}
+
+func (x *PreviewTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTripRequest.ProtoReflect.Descriptor instead.
+func (*PreviewTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviewTripRequest) GetPassengerID() string {
+	if x != nil {
+		return x.PassengerID
+	}
+	return ""
+}
+
+func (x *PreviewTripRequest) GetStartLocation() *Coordinate {
+	if x != nil {
+		return x.StartLocation
+	}
+	return nil
+}
+
+func (x *PreviewTripRequest) GetEndLocation() *Coordinate {
+	if x != nil {
+		return x.EndLocation
+	}
+	return nil
+}
+
+func (x *PreviewTripRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type PreviewTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=tripId,proto3" json:"tripId,omitempty"`
+	Route         *Route                 `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	RideFares     []*RideFare            `protobuf:"bytes,3,rep,name=rideFares,proto3" json:"rideFares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTripResponse) Reset() {
+	*x = PreviewTripResponse{}
+	mi := &file_proto_trip_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTripResponse) ProtoMessage() {}
+
+func (x *PreviewTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTripResponse.ProtoReflect.Descriptor instead.
+func (*PreviewTripResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreviewTripResponse) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *PreviewTripResponse) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *PreviewTripResponse) GetRideFares() []*RideFare {
+	if x != nil {
+		return x.RideFares
+	}
+	return nil
+}
+
+type CreateTripRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RideFareID      string                 `protobuf:"bytes,1,opt,name=rideFareID,proto3" json:"rideFareID,omitempty"`
+	UserID          string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PaymentMethodID string                 `protobuf:"bytes,3,opt,name=paymentMethodID,proto3" json:"paymentMethodID,omitempty"` // the oldest payment method of the user when empty
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTripRequest) Reset() {
+	*x = CreateTripRequest{}
+	mi := &file_proto_trip_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTripRequest) ProtoMessage() {}
+
+func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTripRequest.ProtoReflect.Descriptor instead.
+func (*CreateTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTripRequest) GetRideFareID() string {
+	if x != nil {
+		return x.RideFareID
+	}
+	return ""
+}
+
+func (x *CreateTripRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateTripRequest) GetPaymentMethodID() string {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return ""
+}
+
+type CreateTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	Trip          *Trip                  `protobuf:"bytes,2,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTripResponse) Reset() {
+	*x = CreateTripResponse{}
+	mi := &file_proto_trip_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTripResponse) ProtoMessage() {}
+
+func (x *CreateTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTripResponse.ProtoReflect.Descriptor instead.
+func (*CreateTripResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTripResponse) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *CreateTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type StartTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	DriverID      string                 `protobuf:"bytes,2,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTripRequest) Reset() {
+	*x = StartTripRequest{}
+	mi := &file_proto_trip_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTripRequest) ProtoMessage() {}
+
+func (x *StartTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTripRequest.ProtoReflect.Descriptor instead.
+func (*StartTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StartTripRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *StartTripRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type StartTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTripResponse) Reset() {
+	*x = StartTripResponse{}
+	mi := &file_proto_trip_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTripResponse) ProtoMessage() {}
+
+func (x *StartTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTripResponse.ProtoReflect.Descriptor instead.
+func (*StartTripResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StartTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type CompleteTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	DriverID      string                 `protobuf:"bytes,2,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTripRequest) Reset() {
+	*x = CompleteTripRequest{}
+	mi := &file_proto_trip_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTripRequest) ProtoMessage() {}
+
+func (x *CompleteTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTripRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CompleteTripRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *CompleteTripRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type CompleteTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTripResponse) Reset() {
+	*x = CompleteTripResponse{}
+	mi := &file_proto_trip_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTripResponse) ProtoMessage() {}
+
+func (x *CompleteTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTripResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTripResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompleteTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type ArriveAtPickupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	DriverID      string                 `protobuf:"bytes,2,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArriveAtPickupRequest) Reset() {
+	*x = ArriveAtPickupRequest{}
+	mi := &file_proto_trip_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArriveAtPickupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArriveAtPickupRequest) ProtoMessage() {}
+
+func (x *ArriveAtPickupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArriveAtPickupRequest.ProtoReflect.Descriptor instead.
+func (*ArriveAtPickupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArriveAtPickupRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *ArriveAtPickupRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type ArriveAtPickupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArriveAtPickupResponse) Reset() {
+	*x = ArriveAtPickupResponse{}
+	mi := &file_proto_trip_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArriveAtPickupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArriveAtPickupResponse) ProtoMessage() {}
+
+func (x *ArriveAtPickupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArriveAtPickupResponse.ProtoReflect.Descriptor instead.
+func (*ArriveAtPickupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArriveAtPickupResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type CancelTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	ByDriver      bool                   `protobuf:"varint,3,opt,name=byDriver,proto3" json:"byDriver,omitempty"` // the user is the driver of the trip, not its passenger
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTripRequest) Reset() {
+	*x = CancelTripRequest{}
+	mi := &file_proto_trip_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTripRequest) ProtoMessage() {}
+
+func (x *CancelTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTripRequest.ProtoReflect.Descriptor instead.
+func (*CancelTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelTripRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *CancelTripRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CancelTripRequest) GetByDriver() bool {
+	if x != nil {
+		return x.ByDriver
+	}
+	return false
+}
+
+type CancelTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	FeeCents      int64                  `protobuf:"varint,2,opt,name=feeCents,proto3" json:"feeCents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTripResponse) Reset() {
+	*x = CancelTripResponse{}
+	mi := &file_proto_trip_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTripResponse) ProtoMessage() {}
+
+func (x *CancelTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTripResponse.ProtoReflect.Descriptor instead.
+func (*CancelTripResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+func (x *CancelTripResponse) GetFeeCents() int64 {
+	if x != nil {
+		return x.FeeCents
+	}
+	return 0
+}
+
+type Cancellation struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CanceledBy              string                 `protobuf:"bytes,1,opt,name=canceledBy,proto3" json:"canceledBy,omitempty"` // "passenger", "driver" or "system"
+	Reason                  string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	FeeCents                int64                  `protobuf:"varint,3,opt,name=feeCents,proto3" json:"feeCents,omitempty"`
+	DriverCompensationCents int64                  `protobuf:"varint,4,opt,name=driverCompensationCents,proto3" json:"driverCompensationCents,omitempty"`
+	CanceledAt              int64                  `protobuf:"varint,5,opt,name=canceledAt,proto3" json:"canceledAt,omitempty"` // unix milliseconds
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Cancellation) Reset() {
+	*x = Cancellation{}
+	mi := &file_proto_trip_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cancellation) ProtoMessage() {}
+
+func (x *Cancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cancellation.ProtoReflect.Descriptor instead.
+func (*Cancellation) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Cancellation) GetCanceledBy() string {
+	if x != nil {
+		return x.CanceledBy
+	}
+	return ""
+}
+
+func (x *Cancellation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Cancellation) GetFeeCents() int64 {
+	if x != nil {
+		return x.FeeCents
+	}
+	return 0
+}
+
+func (x *Cancellation) GetDriverCompensationCents() int64 {
+	if x != nil {
+		return x.DriverCompensationCents
+	}
+	return 0
+}
+
+func (x *Cancellation) GetCanceledAt() int64 {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return 0
+}
+
+type PaymentMethod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Brand         string                 `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
+	Last4         string                 `protobuf:"bytes,3,opt,name=last4,proto3" json:"last4,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`    // unix milliseconds
+	Fingerprint   string                 `protobuf:"bytes,5,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"` // identifies the card behind different tokens
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
+	mi := &file_proto_trip_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethod) ProtoMessage() {}
+
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PaymentMethod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+type AddPaymentMethodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // issued by the payment provider
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPaymentMethodRequest) Reset() {
+	*x = AddPaymentMethodRequest{}
+	mi := &file_proto_trip_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentMethodRequest) ProtoMessage() {}
+
+func (x *AddPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*AddPaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddPaymentMethodRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddPaymentMethodRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AddPaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethod *PaymentMethod         `protobuf:"bytes,1,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPaymentMethodResponse) Reset() {
+	*x = AddPaymentMethodResponse{}
+	mi := &file_proto_trip_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentMethodResponse) ProtoMessage() {}
+
+func (x *AddPaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*AddPaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddPaymentMethodResponse) GetPaymentMethod() *PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return nil
+}
+
+type ListPaymentMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentMethodsRequest) Reset() {
+	*x = ListPaymentMethodsRequest{}
+	mi := &file_proto_trip_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentMethodsRequest) ProtoMessage() {}
+
+func (x *ListPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPaymentMethodsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ListPaymentMethodsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethods []*PaymentMethod       `protobuf:"bytes,1,rep,name=paymentMethods,proto3" json:"paymentMethods,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPaymentMethodsResponse) Reset() {
+	*x = ListPaymentMethodsResponse{}
+	mi := &file_proto_trip_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentMethodsResponse) ProtoMessage() {}
+
+func (x *ListPaymentMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentMethodsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListPaymentMethodsResponse) GetPaymentMethods() []*PaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
+type DeletePaymentMethodRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserID          string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PaymentMethodID string                 `protobuf:"bytes,2,opt,name=paymentMethodID,proto3" json:"paymentMethodID,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeletePaymentMethodRequest) Reset() {
+	*x = DeletePaymentMethodRequest{}
+	mi := &file_proto_trip_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePaymentMethodRequest) ProtoMessage() {}
+
+func (x *DeletePaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*DeletePaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeletePaymentMethodRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DeletePaymentMethodRequest) GetPaymentMethodID() string {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return ""
+}
+
+type DeletePaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePaymentMethodResponse) Reset() {
+	*x = DeletePaymentMethodResponse{}
+	mi := &file_proto_trip_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePaymentMethodResponse) ProtoMessage() {}
+
+func (x *DeletePaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*DeletePaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{19}
+}
+
+type Payment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TripID          string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	PaymentMethodID string                 `protobuf:"bytes,2,opt,name=paymentMethodID,proto3" json:"paymentMethodID,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	AmountCents     int64                  `protobuf:"varint,4,opt,name=amountCents,proto3" json:"amountCents,omitempty"`
+	RefundedCents   int64                  `protobuf:"varint,5,opt,name=refundedCents,proto3" json:"refundedCents,omitempty"`
+	WalletCents     int64                  `protobuf:"varint,6,opt,name=walletCents,proto3" json:"walletCents,omitempty"` // paid from the wallet, the rest is charged on the card
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_proto_trip_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Payment) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *Payment) GetPaymentMethodID() string {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Payment) GetRefundedCents() int64 {
+	if x != nil {
+		return x.RefundedCents
+	}
+	return 0
+}
+
+func (x *Payment) GetWalletCents() int64 {
+	if x != nil {
+		return x.WalletCents
+	}
+	return 0
+}
+
+type RefundPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,2,opt,name=amountCents,proto3" json:"amountCents,omitempty"` // the whole remaining amount when zero
+	ToWallet      bool                   `protobuf:"varint,3,opt,name=toWallet,proto3" json:"toWallet,omitempty"`       // credits the wallet instead of refunding the card
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_proto_trip_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RefundPaymentRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *RefundPaymentRequest) GetToWallet() bool {
+	if x != nil {
+		return x.ToWallet
+	}
+	return false
+}
+
+type RefundPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_proto_trip_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RefundPaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type Posting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,2,opt,name=amountCents,proto3" json:"amountCents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Posting) Reset() {
+	*x = Posting{}
+	mi := &file_proto_trip_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Posting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Posting) ProtoMessage() {}
+
+func (x *Posting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Posting.ProtoReflect.Descriptor instead.
+func (*Posting) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Posting) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Posting) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+type JournalEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sequence      int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Postings      []*Posting             `protobuf:"bytes,6,rep,name=postings,proto3" json:"postings,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // unix milliseconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	mi := &file_proto_trip_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntry) ProtoMessage() {}
+
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *JournalEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *JournalEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetPostings() []*Posting {
+	if x != nil {
+		return x.Postings
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // latest entries to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_proto_trip_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetWalletRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetWalletRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BalanceCents  int64                  `protobuf:"varint,1,opt,name=balanceCents,proto3" json:"balanceCents,omitempty"`
+	Entries       []*JournalEntry        `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletResponse) Reset() {
+	*x = GetWalletResponse{}
+	mi := &file_proto_trip_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletResponse) ProtoMessage() {}
+
+func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetWalletResponse) GetBalanceCents() int64 {
+	if x != nil {
+		return x.BalanceCents
+	}
+	return 0
+}
+
+func (x *GetWalletResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type TopUpWalletRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserID          string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PaymentMethodID string                 `protobuf:"bytes,2,opt,name=paymentMethodID,proto3" json:"paymentMethodID,omitempty"` // the oldest payment method of the user when empty
+	AmountCents     int64                  `protobuf:"varint,3,opt,name=amountCents,proto3" json:"amountCents,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TopUpWalletRequest) Reset() {
+	*x = TopUpWalletRequest{}
+	mi := &file_proto_trip_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpWalletRequest) ProtoMessage() {}
+
+func (x *TopUpWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpWalletRequest.ProtoReflect.Descriptor instead.
+func (*TopUpWalletRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TopUpWalletRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *TopUpWalletRequest) GetPaymentMethodID() string {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return ""
+}
+
+func (x *TopUpWalletRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *TopUpWalletRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type TopUpWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	BalanceCents  int64                  `protobuf:"varint,2,opt,name=balanceCents,proto3" json:"balanceCents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpWalletResponse) Reset() {
+	*x = TopUpWalletResponse{}
+	mi := &file_proto_trip_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpWalletResponse) ProtoMessage() {}
+
+func (x *TopUpWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpWalletResponse.ProtoReflect.Descriptor instead.
+func (*TopUpWalletResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TopUpWalletResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *TopUpWalletResponse) GetBalanceCents() int64 {
+	if x != nil {
+		return x.BalanceCents
+	}
+	return 0
+}
+
+type CreditWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,2,opt,name=amountCents,proto3" json:"amountCents,omitempty"`
+	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"` // crediting twice with the same reference has no effect
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditWalletRequest) Reset() {
+	*x = CreditWalletRequest{}
+	mi := &file_proto_trip_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditWalletRequest) ProtoMessage() {}
+
+func (x *CreditWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditWalletRequest.ProtoReflect.Descriptor instead.
+func (*CreditWalletRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreditWalletRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreditWalletRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *CreditWalletRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CreditWalletRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreditWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	BalanceCents  int64                  `protobuf:"varint,2,opt,name=balanceCents,proto3" json:"balanceCents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditWalletResponse) Reset() {
+	*x = CreditWalletResponse{}
+	mi := &file_proto_trip_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditWalletResponse) ProtoMessage() {}
+
+func (x *CreditWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditWalletResponse.ProtoReflect.Descriptor instead.
+func (*CreditWalletResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreditWalletResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *CreditWalletResponse) GetBalanceCents() int64 {
+	if x != nil {
+		return x.BalanceCents
+	}
+	return 0
+}
+
+type ListJournalEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSequence int64                  `protobuf:"varint,1,opt,name=afterSequence,proto3" json:"afterSequence,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesRequest) Reset() {
+	*x = ListJournalEntriesRequest{}
+	mi := &file_proto_trip_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesRequest) ProtoMessage() {}
+
+func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListJournalEntriesRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+func (x *ListJournalEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesResponse) Reset() {
+	*x = ListJournalEntriesResponse{}
+	mi := &file_proto_trip_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesResponse) ProtoMessage() {}
+
+func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListJournalEntriesResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PromoCode struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Code             string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType     DiscountType           `protobuf:"varint,2,opt,name=discountType,proto3,enum=trip.DiscountType" json:"discountType,omitempty"`
+	Value            int64                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`                       // percent for PERCENTAGE, cents for FIXED
+	MaxDiscountCents int64                  `protobuf:"varint,4,opt,name=maxDiscountCents,proto3" json:"maxDiscountCents,omitempty"` // zero for no cap
+	MinFareCents     int64                  `protobuf:"varint,5,opt,name=minFareCents,proto3" json:"minFareCents,omitempty"`
+	Packages         []PackageSlug          `protobuf:"varint,6,rep,packed,name=packages,proto3,enum=trip.PackageSlug" json:"packages,omitempty"` // every package when empty
+	MaxUsesPerUser   int32                  `protobuf:"varint,7,opt,name=maxUsesPerUser,proto3" json:"maxUsesPerUser,omitempty"`                  // zero for no limit
+	MaxUses          int32                  `protobuf:"varint,8,opt,name=maxUses,proto3" json:"maxUses,omitempty"`                                // zero for no limit
+	FirstRideOnly    bool                   `protobuf:"varint,9,opt,name=firstRideOnly,proto3" json:"firstRideOnly,omitempty"`
+	StartsAt         int64                  `protobuf:"varint,10,opt,name=startsAt,proto3" json:"startsAt,omitempty"` // unix milliseconds, zero for no start
+	EndsAt           int64                  `protobuf:"varint,11,opt,name=endsAt,proto3" json:"endsAt,omitempty"`     // unix milliseconds, zero for no end
+	Active           bool                   `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
+	Redemptions      int32                  `protobuf:"varint,13,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // unix milliseconds
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_proto_trip_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetDiscountType() DiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *PromoCode) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxDiscountCents() int64 {
+	if x != nil {
+		return x.MaxDiscountCents
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMinFareCents() int64 {
+	if x != nil {
+		return x.MinFareCents
+	}
+	return 0
+}
+
+func (x *PromoCode) GetPackages() []PackageSlug {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *PromoCode) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *PromoCode) GetFirstRideOnly() bool {
+	if x != nil {
+		return x.FirstRideOnly
+	}
+	return false
+}
+
+func (x *PromoCode) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *PromoCode) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *PromoCode) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PromoCode) GetRedemptions() int32 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreatePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	mi := &file_proto_trip_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreatePromoCodeRequest) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type CreatePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeResponse) Reset() {
+	*x = CreatePromoCodeResponse{}
+	mi := &file_proto_trip_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeResponse) ProtoMessage() {}
+
+func (x *CreatePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreatePromoCodeResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type DeactivatePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePromoCodeRequest) Reset() {
+	*x = DeactivatePromoCodeRequest{}
+	mi := &file_proto_trip_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePromoCodeRequest) ProtoMessage() {}
+
+func (x *DeactivatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*DeactivatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeactivatePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeactivatePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePromoCodeResponse) Reset() {
+	*x = DeactivatePromoCodeResponse{}
+	mi := &file_proto_trip_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePromoCodeResponse) ProtoMessage() {}
+
+func (x *DeactivatePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*DeactivatePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeactivatePromoCodeResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type ListPromoCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesRequest) Reset() {
+	*x = ListPromoCodesRequest{}
+	mi := &file_proto_trip_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesRequest) ProtoMessage() {}
+
+func (x *ListPromoCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{38}
+}
+
+type ListPromoCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCodes    []*PromoCode           `protobuf:"bytes,1,rep,name=promoCodes,proto3" json:"promoCodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesResponse) Reset() {
+	*x = ListPromoCodesResponse{}
+	mi := &file_proto_trip_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesResponse) ProtoMessage() {}
+
+func (x *ListPromoCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListPromoCodesResponse) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type Rating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	RaterRole     string                 `protobuf:"bytes,2,opt,name=raterRole,proto3" json:"raterRole,omitempty"` // "passenger" or "driver"
+	Stars         int32                  `protobuf:"varint,3,opt,name=stars,proto3" json:"stars,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // unix milliseconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rating) Reset() {
+	*x = Rating{}
+	mi := &file_proto_trip_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rating) ProtoMessage() {}
+
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Rating) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *Rating) GetRaterRole() string {
+	if x != nil {
+		return x.RaterRole
+	}
+	return ""
+}
+
+func (x *Rating) GetStars() int32 {
+	if x != nil {
+		return x.Stars
+	}
+	return 0
+}
+
+func (x *Rating) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Rating) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Rating) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type RatingSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Average       float64                `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"` // over the latest ratings received, zero without ratings
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingSummary) Reset() {
+	*x = RatingSummary{}
+	mi := &file_proto_trip_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingSummary) ProtoMessage() {}
+
+func (x *RatingSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingSummary.ProtoReflect.Descriptor instead.
+func (*RatingSummary) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RatingSummary) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RateTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Stars         int32                  `protobuf:"varint,3,opt,name=stars,proto3" json:"stars,omitempty"` // 1 to 5
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateTripRequest) Reset() {
+	*x = RateTripRequest{}
+	mi := &file_proto_trip_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateTripRequest) ProtoMessage() {}
+
+func (x *RateTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateTripRequest.ProtoReflect.Descriptor instead.
+func (*RateTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RateTripRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *RateTripRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RateTripRequest) GetStars() int32 {
+	if x != nil {
+		return x.Stars
+	}
+	return 0
+}
+
+func (x *RateTripRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RateTripRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RateTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *Rating                `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateTripResponse) Reset() {
+	*x = RateTripResponse{}
+	mi := &file_proto_trip_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateTripResponse) ProtoMessage() {}
+
+func (x *RateTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateTripResponse.ProtoReflect.Descriptor instead.
+func (*RateTripResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RateTripResponse) GetRating() *Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+type GetRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingRequest) Reset() {
+	*x = GetRatingRequest{}
+	mi := &file_proto_trip_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingRequest) ProtoMessage() {}
+
+func (x *GetRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetRatingRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
 
-func (x PackageSlug) Enum() *PackageSlug {
-	p := new(PackageSlug)
-	*p = x
-	return p
+type GetRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *RatingSummary         `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x PackageSlug) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *GetRatingResponse) Reset() {
+	*x = GetRatingResponse{}
+	mi := &file_proto_trip_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (PackageSlug) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_trip_proto_enumTypes[0].Descriptor()
+func (x *GetRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (PackageSlug) Type() protoreflect.EnumType {
-	return &file_proto_trip_proto_enumTypes[0]
+func (*GetRatingResponse) ProtoMessage() {}
+
+func (x *GetRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x PackageSlug) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use GetRatingResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{45}
 }
 
-// Deprecated: Use PackageSlug.Descriptor instead.
-func (PackageSlug) EnumDescriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{0}
+func (x *GetRatingResponse) GetSummary() *RatingSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
 }
 
-type PreviewTripRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PassengerID   string                 `protobuf:"bytes,1,opt,name=passengerID,proto3" json:"passengerID,omitempty"`
-	StartLocation *Coordinate            `protobuf:"bytes,2,opt,name=startLocation,proto3" json:"startLocation,omitempty"`
-	EndLocation   *Coordinate            `protobuf:"bytes,3,opt,name=endLocation,proto3" json:"endLocation,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Tip struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TripID          string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	DriverID        string                 `protobuf:"bytes,2,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	PaymentMethodID string                 `protobuf:"bytes,3,opt,name=paymentMethodID,proto3" json:"paymentMethodID,omitempty"`
+	AmountCents     int64                  `protobuf:"varint,4,opt,name=amountCents,proto3" json:"amountCents,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // unix milliseconds
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PreviewTripRequest) Reset() {
-	*x = PreviewTripRequest{}
-	mi := &file_proto_trip_proto_msgTypes[0]
+func (x *Tip) Reset() {
+	*x = Tip{}
+	mi := &file_proto_trip_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PreviewTripRequest) String() string {
+func (x *Tip) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreviewTripRequest) ProtoMessage() {}
+func (*Tip) ProtoMessage() {}
 
-func (x *PreviewTripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[0]
+func (x *Tip) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,56 +2739,71 @@ func (x *PreviewTripRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreviewTripRequest.ProtoReflect.Descriptor instead.
-func (*PreviewTripRequest) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Tip.ProtoReflect.Descriptor instead.
+func (*Tip) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *PreviewTripRequest) GetPassengerID() string {
+func (x *Tip) GetTripID() string {
 	if x != nil {
-		return x.PassengerID
+		return x.TripID
 	}
 	return ""
 }
 
-func (x *PreviewTripRequest) GetStartLocation() *Coordinate {
+func (x *Tip) GetDriverID() string {
 	if x != nil {
-		return x.StartLocation
+		return x.DriverID
 	}
-	return nil
+	return ""
 }
 
-func (x *PreviewTripRequest) GetEndLocation() *Coordinate {
+func (x *Tip) GetPaymentMethodID() string {
 	if x != nil {
-		return x.EndLocation
+		return x.PaymentMethodID
 	}
-	return nil
+	return ""
 }
 
-type PreviewTripResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TripId        string                 `protobuf:"bytes,1,opt,name=tripId,proto3" json:"tripId,omitempty"`
-	Route         *Route                 `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
-	RideFares     []*RideFare            `protobuf:"bytes,3,rep,name=rideFares,proto3" json:"rideFares,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Tip) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
 }
 
-func (x *PreviewTripResponse) Reset() {
-	*x = PreviewTripResponse{}
-	mi := &file_proto_trip_proto_msgTypes[1]
+func (x *Tip) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AddTipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TripID          string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	UserID          string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	AmountCents     int64                  `protobuf:"varint,3,opt,name=amountCents,proto3" json:"amountCents,omitempty"`
+	PaymentMethodID string                 `protobuf:"bytes,4,opt,name=paymentMethodID,proto3" json:"paymentMethodID,omitempty"` // the card that paid for the trip, or the oldest one, when empty
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddTipRequest) Reset() {
+	*x = AddTipRequest{}
+	mi := &file_proto_trip_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PreviewTripResponse) String() string {
+func (x *AddTipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreviewTripResponse) ProtoMessage() {}
+func (*AddTipRequest) ProtoMessage() {}
 
-func (x *PreviewTripResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[1]
+func (x *AddTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,55 +2814,107 @@ func (x *PreviewTripResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreviewTripResponse.ProtoReflect.Descriptor instead.
-func (*PreviewTripResponse) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use AddTipRequest.ProtoReflect.Descriptor instead.
+func (*AddTipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PreviewTripResponse) GetTripId() string {
+func (x *AddTipRequest) GetTripID() string {
 	if x != nil {
-		return x.TripId
+		return x.TripID
 	}
 	return ""
 }
 
-func (x *PreviewTripResponse) GetRoute() *Route {
+func (x *AddTipRequest) GetUserID() string {
 	if x != nil {
-		return x.Route
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-func (x *PreviewTripResponse) GetRideFares() []*RideFare {
+func (x *AddTipRequest) GetAmountCents() int64 {
 	if x != nil {
-		return x.RideFares
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *AddTipRequest) GetPaymentMethodID() string {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return ""
+}
+
+type AddTipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tip           *Tip                   `protobuf:"bytes,1,opt,name=tip,proto3" json:"tip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTipResponse) Reset() {
+	*x = AddTipResponse{}
+	mi := &file_proto_trip_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTipResponse) ProtoMessage() {}
+
+func (x *AddTipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTipResponse.ProtoReflect.Descriptor instead.
+func (*AddTipResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddTipResponse) GetTip() *Tip {
+	if x != nil {
+		return x.Tip
 	}
 	return nil
 }
 
-type CreateTripRequest struct {
+type GetReceiptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RideFareID    string                 `protobuf:"bytes,1,opt,name=rideFareID,proto3" json:"rideFareID,omitempty"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
 	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // "html" or "pdf"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTripRequest) Reset() {
-	*x = CreateTripRequest{}
-	mi := &file_proto_trip_proto_msgTypes[2]
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_proto_trip_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTripRequest) String() string {
+func (x *GetReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTripRequest) ProtoMessage() {}
+func (*GetReceiptRequest) ProtoMessage() {}
 
-func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[2]
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -223,48 +2925,56 @@ func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTripRequest.ProtoReflect.Descriptor instead.
-func (*CreateTripRequest) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateTripRequest) GetRideFareID() string {
+func (x *GetReceiptRequest) GetTripID() string {
 	if x != nil {
-		return x.RideFareID
+		return x.TripID
 	}
 	return ""
 }
 
-func (x *CreateTripRequest) GetUserID() string {
+func (x *GetReceiptRequest) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-type CreateTripResponse struct {
+func (x *GetReceiptRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetReceiptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
-	Trip          *Trip                  `protobuf:"bytes,2,opt,name=trip,proto3" json:"trip,omitempty"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTripResponse) Reset() {
-	*x = CreateTripResponse{}
-	mi := &file_proto_trip_proto_msgTypes[3]
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_proto_trip_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTripResponse) String() string {
+func (x *GetReceiptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTripResponse) ProtoMessage() {}
+func (*GetReceiptResponse) ProtoMessage() {}
 
-func (x *CreateTripResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[3]
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_trip_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,23 +2985,30 @@ func (x *CreateTripResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTripResponse.ProtoReflect.Descriptor instead.
-func (*CreateTripResponse) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_trip_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CreateTripResponse) GetTripID() string {
+func (x *GetReceiptResponse) GetContent() []byte {
 	if x != nil {
-		return x.TripID
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetReceiptResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *CreateTripResponse) GetTrip() *Trip {
+func (x *GetReceiptResponse) GetFileName() string {
 	if x != nil {
-		return x.Trip
+		return x.FileName
 	}
-	return nil
+	return ""
 }
 
 type Coordinate struct {
@@ -304,7 +3021,7 @@ type Coordinate struct {
 
 func (x *Coordinate) Reset() {
 	*x = Coordinate{}
-	mi := &file_proto_trip_proto_msgTypes[4]
+	mi := &file_proto_trip_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +3033,7 @@ func (x *Coordinate) String() string {
 func (*Coordinate) ProtoMessage() {}
 
 func (x *Coordinate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[4]
+	mi := &file_proto_trip_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +3046,7 @@ func (x *Coordinate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
 func (*Coordinate) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{4}
+	return file_proto_trip_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Coordinate) GetLatitude() float64 {
@@ -355,7 +3072,7 @@ type Geometry struct {
 
 func (x *Geometry) Reset() {
 	*x = Geometry{}
-	mi := &file_proto_trip_proto_msgTypes[5]
+	mi := &file_proto_trip_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -367,7 +3084,7 @@ func (x *Geometry) String() string {
 func (*Geometry) ProtoMessage() {}
 
 func (x *Geometry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[5]
+	mi := &file_proto_trip_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +3097,7 @@ func (x *Geometry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Geometry.ProtoReflect.Descriptor instead.
 func (*Geometry) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{5}
+	return file_proto_trip_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *Geometry) GetCoordinates() []*Coordinate {
@@ -401,7 +3118,7 @@ type Route struct {
 
 func (x *Route) Reset() {
 	*x = Route{}
-	mi := &file_proto_trip_proto_msgTypes[6]
+	mi := &file_proto_trip_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +3130,7 @@ func (x *Route) String() string {
 func (*Route) ProtoMessage() {}
 
 func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[6]
+	mi := &file_proto_trip_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +3143,7 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Route.ProtoReflect.Descriptor instead.
 func (*Route) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{6}
+	return file_proto_trip_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *Route) GetGeometry() []*Geometry {
@@ -456,13 +3173,16 @@ type RideFare struct {
 	PassengerID       string                 `protobuf:"bytes,2,opt,name=passengerID,proto3" json:"passengerID,omitempty"`
 	PackageSlug       PackageSlug            `protobuf:"varint,3,opt,name=packageSlug,proto3,enum=trip.PackageSlug" json:"packageSlug,omitempty"`
 	TotalPriceInCents float64                `protobuf:"fixed64,4,opt,name=totalPriceInCents,proto3" json:"totalPriceInCents,omitempty"`
+	PickupEtaSeconds  *int64                 `protobuf:"varint,5,opt,name=pickupEtaSeconds,proto3,oneof" json:"pickupEtaSeconds,omitempty"` // unset when no driver is around
+	PromoCode         string                 `protobuf:"bytes,6,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	DiscountCents     int64                  `protobuf:"varint,7,opt,name=discountCents,proto3" json:"discountCents,omitempty"` // already taken off totalPriceInCents
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *RideFare) Reset() {
 	*x = RideFare{}
-	mi := &file_proto_trip_proto_msgTypes[7]
+	mi := &file_proto_trip_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -474,7 +3194,7 @@ func (x *RideFare) String() string {
 func (*RideFare) ProtoMessage() {}
 
 func (x *RideFare) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[7]
+	mi := &file_proto_trip_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +3207,7 @@ func (x *RideFare) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RideFare.ProtoReflect.Descriptor instead.
 func (*RideFare) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{7}
+	return file_proto_trip_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *RideFare) GetId() string {
@@ -518,6 +3238,27 @@ func (x *RideFare) GetTotalPriceInCents() float64 {
 	return 0
 }
 
+func (x *RideFare) GetPickupEtaSeconds() int64 {
+	if x != nil && x.PickupEtaSeconds != nil {
+		return *x.PickupEtaSeconds
+	}
+	return 0
+}
+
+func (x *RideFare) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *RideFare) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
 type Trip struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -526,13 +3267,19 @@ type Trip struct {
 	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
 	Driver        *TripDriver            `protobuf:"bytes,6,opt,name=driver,proto3" json:"driver,omitempty"`
+	Pickup        *Coordinate            `protobuf:"bytes,7,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	Destination   *Coordinate            `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination,omitempty"`
+	AcceptedAt    int64                  `protobuf:"varint,9,opt,name=acceptedAt,proto3" json:"acceptedAt,omitempty"` // unix milliseconds, zero until a driver accepts
+	ArrivedAt     int64                  `protobuf:"varint,10,opt,name=arrivedAt,proto3" json:"arrivedAt,omitempty"`  // unix milliseconds, zero until the driver is at the pickup
+	Cancellation  *Cancellation          `protobuf:"bytes,11,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	CompletedAt   int64                  `protobuf:"varint,12,opt,name=completedAt,proto3" json:"completedAt,omitempty"` // unix milliseconds, zero until the trip is completed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Trip) Reset() {
 	*x = Trip{}
-	mi := &file_proto_trip_proto_msgTypes[8]
+	mi := &file_proto_trip_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -544,7 +3291,7 @@ func (x *Trip) String() string {
 func (*Trip) ProtoMessage() {}
 
 func (x *Trip) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[8]
+	mi := &file_proto_trip_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,7 +3304,7 @@ func (x *Trip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trip.ProtoReflect.Descriptor instead.
 func (*Trip) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{8}
+	return file_proto_trip_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *Trip) GetId() string {
@@ -602,6 +3349,48 @@ func (x *Trip) GetDriver() *TripDriver {
 	return nil
 }
 
+func (x *Trip) GetPickup() *Coordinate {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+func (x *Trip) GetDestination() *Coordinate {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *Trip) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+func (x *Trip) GetArrivedAt() int64 {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return 0
+}
+
+func (x *Trip) GetCancellation() *Cancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+func (x *Trip) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
 type TripDriver struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -614,7 +3403,7 @@ type TripDriver struct {
 
 func (x *TripDriver) Reset() {
 	*x = TripDriver{}
-	mi := &file_proto_trip_proto_msgTypes[9]
+	mi := &file_proto_trip_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -626,7 +3415,7 @@ func (x *TripDriver) String() string {
 func (*TripDriver) ProtoMessage() {}
 
 func (x *TripDriver) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_trip_proto_msgTypes[9]
+	mi := &file_proto_trip_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +3428,7 @@ func (x *TripDriver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TripDriver.ProtoReflect.Descriptor instead.
 func (*TripDriver) Descriptor() ([]byte, []int) {
-	return file_proto_trip_proto_rawDescGZIP(), []int{9}
+	return file_proto_trip_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *TripDriver) GetId() string {
@@ -674,24 +3463,204 @@ var File_proto_trip_proto protoreflect.FileDescriptor
 
 const file_proto_trip_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/trip.proto\x12\x04trip\"\xa2\x01\n" +
+	"\x10proto/trip.proto\x12\x04trip\"\xc0\x01\n" +
 	"\x12PreviewTripRequest\x12 \n" +
 	"\vpassengerID\x18\x01 \x01(\tR\vpassengerID\x126\n" +
 	"\rstartLocation\x18\x02 \x01(\v2\x10.trip.CoordinateR\rstartLocation\x122\n" +
-	"\vendLocation\x18\x03 \x01(\v2\x10.trip.CoordinateR\vendLocation\"~\n" +
+	"\vendLocation\x18\x03 \x01(\v2\x10.trip.CoordinateR\vendLocation\x12\x1c\n" +
+	"\tpromoCode\x18\x04 \x01(\tR\tpromoCode\"~\n" +
 	"\x13PreviewTripResponse\x12\x16\n" +
 	"\x06tripId\x18\x01 \x01(\tR\x06tripId\x12!\n" +
 	"\x05route\x18\x02 \x01(\v2\v.trip.RouteR\x05route\x12,\n" +
-	"\trideFares\x18\x03 \x03(\v2\x0e.trip.RideFareR\trideFares\"K\n" +
+	"\trideFares\x18\x03 \x03(\v2\x0e.trip.RideFareR\trideFares\"u\n" +
 	"\x11CreateTripRequest\x12\x1e\n" +
 	"\n" +
 	"rideFareID\x18\x01 \x01(\tR\n" +
 	"rideFareID\x12\x16\n" +
-	"\x06userID\x18\x02 \x01(\tR\x06userID\"L\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12(\n" +
+	"\x0fpaymentMethodID\x18\x03 \x01(\tR\x0fpaymentMethodID\"L\n" +
 	"\x12CreateTripResponse\x12\x16\n" +
 	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x1e\n" +
 	"\x04trip\x18\x02 \x01(\v2\n" +
 	".trip.TripR\x04trip\"F\n" +
+	"\x10StartTripRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x1a\n" +
+	"\bdriverID\x18\x02 \x01(\tR\bdriverID\"3\n" +
+	"\x11StartTripResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\"I\n" +
+	"\x13CompleteTripRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x1a\n" +
+	"\bdriverID\x18\x02 \x01(\tR\bdriverID\"6\n" +
+	"\x14CompleteTripResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\"K\n" +
+	"\x15ArriveAtPickupRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x1a\n" +
+	"\bdriverID\x18\x02 \x01(\tR\bdriverID\"8\n" +
+	"\x16ArriveAtPickupResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\"_\n" +
+	"\x11CancelTripRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x1a\n" +
+	"\bbyDriver\x18\x03 \x01(\bR\bbyDriver\"P\n" +
+	"\x12CancelTripResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\x12\x1a\n" +
+	"\bfeeCents\x18\x02 \x01(\x03R\bfeeCents\"\xbc\x01\n" +
+	"\fCancellation\x12\x1e\n" +
+	"\n" +
+	"canceledBy\x18\x01 \x01(\tR\n" +
+	"canceledBy\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bfeeCents\x18\x03 \x01(\x03R\bfeeCents\x128\n" +
+	"\x17driverCompensationCents\x18\x04 \x01(\x03R\x17driverCompensationCents\x12\x1e\n" +
+	"\n" +
+	"canceledAt\x18\x05 \x01(\x03R\n" +
+	"canceledAt\"\x8b\x01\n" +
+	"\rPaymentMethod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05brand\x18\x02 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05last4\x18\x03 \x01(\tR\x05last4\x12\x1c\n" +
+	"\tcreatedAt\x18\x04 \x01(\x03R\tcreatedAt\x12 \n" +
+	"\vfingerprint\x18\x05 \x01(\tR\vfingerprint\"G\n" +
+	"\x17AddPaymentMethodRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"U\n" +
+	"\x18AddPaymentMethodResponse\x129\n" +
+	"\rpaymentMethod\x18\x01 \x01(\v2\x13.trip.PaymentMethodR\rpaymentMethod\"3\n" +
+	"\x19ListPaymentMethodsRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"Y\n" +
+	"\x1aListPaymentMethodsResponse\x12;\n" +
+	"\x0epaymentMethods\x18\x01 \x03(\v2\x13.trip.PaymentMethodR\x0epaymentMethods\"^\n" +
+	"\x1aDeletePaymentMethodRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12(\n" +
+	"\x0fpaymentMethodID\x18\x02 \x01(\tR\x0fpaymentMethodID\"\x1d\n" +
+	"\x1bDeletePaymentMethodResponse\"\xcd\x01\n" +
+	"\aPayment\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12(\n" +
+	"\x0fpaymentMethodID\x18\x02 \x01(\tR\x0fpaymentMethodID\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12 \n" +
+	"\vamountCents\x18\x04 \x01(\x03R\vamountCents\x12$\n" +
+	"\rrefundedCents\x18\x05 \x01(\x03R\rrefundedCents\x12 \n" +
+	"\vwalletCents\x18\x06 \x01(\x03R\vwalletCents\"l\n" +
+	"\x14RefundPaymentRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12 \n" +
+	"\vamountCents\x18\x02 \x01(\x03R\vamountCents\x12\x1a\n" +
+	"\btoWallet\x18\x03 \x01(\bR\btoWallet\"@\n" +
+	"\x15RefundPaymentResponse\x12'\n" +
+	"\apayment\x18\x01 \x01(\v2\r.trip.PaymentR\apayment\"E\n" +
+	"\aPosting\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12 \n" +
+	"\vamountCents\x18\x02 \x01(\x03R\vamountCents\"\xcb\x01\n" +
+	"\fJournalEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12)\n" +
+	"\bpostings\x18\x06 \x03(\v2\r.trip.PostingR\bpostings\x12\x1c\n" +
+	"\tcreatedAt\x18\a \x01(\x03R\tcreatedAt\"@\n" +
+	"\x10GetWalletRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"e\n" +
+	"\x11GetWalletResponse\x12\"\n" +
+	"\fbalanceCents\x18\x01 \x01(\x03R\fbalanceCents\x12,\n" +
+	"\aentries\x18\x02 \x03(\v2\x12.trip.JournalEntryR\aentries\"\xa0\x01\n" +
+	"\x12TopUpWalletRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12(\n" +
+	"\x0fpaymentMethodID\x18\x02 \x01(\tR\x0fpaymentMethodID\x12 \n" +
+	"\vamountCents\x18\x03 \x01(\x03R\vamountCents\x12&\n" +
+	"\x0eidempotencyKey\x18\x04 \x01(\tR\x0eidempotencyKey\"c\n" +
+	"\x13TopUpWalletResponse\x12(\n" +
+	"\x05entry\x18\x01 \x01(\v2\x12.trip.JournalEntryR\x05entry\x12\"\n" +
+	"\fbalanceCents\x18\x02 \x01(\x03R\fbalanceCents\"\x8f\x01\n" +
+	"\x13CreditWalletRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12 \n" +
+	"\vamountCents\x18\x02 \x01(\x03R\vamountCents\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"d\n" +
+	"\x14CreditWalletResponse\x12(\n" +
+	"\x05entry\x18\x01 \x01(\v2\x12.trip.JournalEntryR\x05entry\x12\"\n" +
+	"\fbalanceCents\x18\x02 \x01(\x03R\fbalanceCents\"W\n" +
+	"\x19ListJournalEntriesRequest\x12$\n" +
+	"\rafterSequence\x18\x01 \x01(\x03R\rafterSequence\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"J\n" +
+	"\x1aListJournalEntriesResponse\x12,\n" +
+	"\aentries\x18\x01 \x03(\v2\x12.trip.JournalEntryR\aentries\"\xe0\x03\n" +
+	"\tPromoCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x126\n" +
+	"\fdiscountType\x18\x02 \x01(\x0e2\x12.trip.DiscountTypeR\fdiscountType\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x03R\x05value\x12*\n" +
+	"\x10maxDiscountCents\x18\x04 \x01(\x03R\x10maxDiscountCents\x12\"\n" +
+	"\fminFareCents\x18\x05 \x01(\x03R\fminFareCents\x12-\n" +
+	"\bpackages\x18\x06 \x03(\x0e2\x11.trip.PackageSlugR\bpackages\x12&\n" +
+	"\x0emaxUsesPerUser\x18\a \x01(\x05R\x0emaxUsesPerUser\x12\x18\n" +
+	"\amaxUses\x18\b \x01(\x05R\amaxUses\x12$\n" +
+	"\rfirstRideOnly\x18\t \x01(\bR\rfirstRideOnly\x12\x1a\n" +
+	"\bstartsAt\x18\n" +
+	" \x01(\x03R\bstartsAt\x12\x16\n" +
+	"\x06endsAt\x18\v \x01(\x03R\x06endsAt\x12\x16\n" +
+	"\x06active\x18\f \x01(\bR\x06active\x12 \n" +
+	"\vredemptions\x18\r \x01(\x05R\vredemptions\x12\x1c\n" +
+	"\tcreatedAt\x18\x0e \x01(\x03R\tcreatedAt\"G\n" +
+	"\x16CreatePromoCodeRequest\x12-\n" +
+	"\tpromoCode\x18\x01 \x01(\v2\x0f.trip.PromoCodeR\tpromoCode\"H\n" +
+	"\x17CreatePromoCodeResponse\x12-\n" +
+	"\tpromoCode\x18\x01 \x01(\v2\x0f.trip.PromoCodeR\tpromoCode\"0\n" +
+	"\x1aDeactivatePromoCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"L\n" +
+	"\x1bDeactivatePromoCodeResponse\x12-\n" +
+	"\tpromoCode\x18\x01 \x01(\v2\x0f.trip.PromoCodeR\tpromoCode\"\x17\n" +
+	"\x15ListPromoCodesRequest\"I\n" +
+	"\x16ListPromoCodesResponse\x12/\n" +
+	"\n" +
+	"promoCodes\x18\x01 \x03(\v2\x0f.trip.PromoCodeR\n" +
+	"promoCodes\"\xa0\x01\n" +
+	"\x06Rating\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x1c\n" +
+	"\traterRole\x18\x02 \x01(\tR\traterRole\x12\x14\n" +
+	"\x05stars\x18\x03 \x01(\x05R\x05stars\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\x03R\tcreatedAt\"?\n" +
+	"\rRatingSummary\x12\x18\n" +
+	"\aaverage\x18\x01 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x85\x01\n" +
+	"\x0fRateTripRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x14\n" +
+	"\x05stars\x18\x03 \x01(\x05R\x05stars\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"8\n" +
+	"\x10RateTripResponse\x12$\n" +
+	"\x06rating\x18\x01 \x01(\v2\f.trip.RatingR\x06rating\"*\n" +
+	"\x10GetRatingRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"B\n" +
+	"\x11GetRatingResponse\x12-\n" +
+	"\asummary\x18\x01 \x01(\v2\x13.trip.RatingSummaryR\asummary\"\xa3\x01\n" +
+	"\x03Tip\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x1a\n" +
+	"\bdriverID\x18\x02 \x01(\tR\bdriverID\x12(\n" +
+	"\x0fpaymentMethodID\x18\x03 \x01(\tR\x0fpaymentMethodID\x12 \n" +
+	"\vamountCents\x18\x04 \x01(\x03R\vamountCents\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\x03R\tcreatedAt\"\x8b\x01\n" +
+	"\rAddTipRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12 \n" +
+	"\vamountCents\x18\x03 \x01(\x03R\vamountCents\x12(\n" +
+	"\x0fpaymentMethodID\x18\x04 \x01(\tR\x0fpaymentMethodID\"-\n" +
+	"\x0eAddTipResponse\x12\x1b\n" +
+	"\x03tip\x18\x01 \x01(\v2\t.trip.TipR\x03tip\"[\n" +
+	"\x11GetReceiptRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"l\n" +
+	"\x12GetReceiptResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12 \n" +
+	"\vcontentType\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\"F\n" +
 	"\n" +
 	"Coordinate\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
@@ -701,33 +3670,72 @@ const file_proto_trip_proto_rawDesc = "" +
 	"\x05Route\x12*\n" +
 	"\bgeometry\x18\x01 \x03(\v2\x0e.trip.GeometryR\bgeometry\x12\x1a\n" +
 	"\bdistance\x18\x02 \x01(\x01R\bdistance\x12\x1a\n" +
-	"\bduration\x18\x03 \x01(\x01R\bduration\"\x9f\x01\n" +
+	"\bduration\x18\x03 \x01(\x01R\bduration\"\xa9\x02\n" +
 	"\bRideFare\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
 	"\vpassengerID\x18\x02 \x01(\tR\vpassengerID\x123\n" +
 	"\vpackageSlug\x18\x03 \x01(\x0e2\x11.trip.PackageSlugR\vpackageSlug\x12,\n" +
-	"\x11totalPriceInCents\x18\x04 \x01(\x01R\x11totalPriceInCents\"\xc7\x01\n" +
+	"\x11totalPriceInCents\x18\x04 \x01(\x01R\x11totalPriceInCents\x12/\n" +
+	"\x10pickupEtaSeconds\x18\x05 \x01(\x03H\x00R\x10pickupEtaSeconds\x88\x01\x01\x12\x1c\n" +
+	"\tpromoCode\x18\x06 \x01(\tR\tpromoCode\x12$\n" +
+	"\rdiscountCents\x18\a \x01(\x03R\rdiscountCentsB\x13\n" +
+	"\x11_pickupEtaSeconds\"\xbd\x03\n" +
 	"\x04Trip\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
 	"\fselectedFare\x18\x02 \x01(\v2\x0e.trip.RideFareR\fselectedFare\x12!\n" +
 	"\x05route\x18\x03 \x01(\v2\v.trip.RouteR\x05route\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
 	"\x06userId\x18\x05 \x01(\tR\x06userId\x12(\n" +
-	"\x06driver\x18\x06 \x01(\v2\x10.trip.TripDriverR\x06driver\"t\n" +
+	"\x06driver\x18\x06 \x01(\v2\x10.trip.TripDriverR\x06driver\x12(\n" +
+	"\x06pickup\x18\a \x01(\v2\x10.trip.CoordinateR\x06pickup\x122\n" +
+	"\vdestination\x18\b \x01(\v2\x10.trip.CoordinateR\vdestination\x12\x1e\n" +
+	"\n" +
+	"acceptedAt\x18\t \x01(\x03R\n" +
+	"acceptedAt\x12\x1c\n" +
+	"\tarrivedAt\x18\n" +
+	" \x01(\x03R\tarrivedAt\x126\n" +
+	"\fcancellation\x18\v \x01(\v2\x12.trip.CancellationR\fcancellation\x12 \n" +
+	"\vcompletedAt\x18\f \x01(\x03R\vcompletedAt\"t\n" +
 	"\n" +
 	"TripDriver\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
 	"\x0eprofilePicture\x18\x03 \x01(\tR\x0eprofilePicture\x12\x1a\n" +
-	"\bcarPlate\x18\x04 \x01(\tR\bcarPlate*A\n" +
+	"\bcarPlate\x18\x04 \x01(\tR\bcarPlate*H\n" +
+	"\fDiscountType\x12\x1d\n" +
+	"\x19DISCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"PERCENTAGE\x10\x01\x12\t\n" +
+	"\x05FIXED\x10\x02*A\n" +
 	"\vPackageSlug\x12\x1c\n" +
 	"\x18PACKAGE_SLUG_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05UBERX\x10\x01\x12\t\n" +
-	"\x05BLACK\x10\x022\x92\x01\n" +
+	"\x05BLACK\x10\x022\x81\f\n" +
 	"\vTripService\x12B\n" +
 	"\vPreviewTrip\x12\x18.trip.PreviewTripRequest\x1a\x19.trip.PreviewTripResponse\x12?\n" +
 	"\n" +
-	"CreateTrip\x12\x17.trip.CreateTripRequest\x1a\x18.trip.CreateTripResponseB\x18Z\x16shared/proto/trip;tripb\x06proto3"
+	"CreateTrip\x12\x17.trip.CreateTripRequest\x1a\x18.trip.CreateTripResponse\x12<\n" +
+	"\tStartTrip\x12\x16.trip.StartTripRequest\x1a\x17.trip.StartTripResponse\x12E\n" +
+	"\fCompleteTrip\x12\x19.trip.CompleteTripRequest\x1a\x1a.trip.CompleteTripResponse\x12K\n" +
+	"\x0eArriveAtPickup\x12\x1b.trip.ArriveAtPickupRequest\x1a\x1c.trip.ArriveAtPickupResponse\x12?\n" +
+	"\n" +
+	"CancelTrip\x12\x17.trip.CancelTripRequest\x1a\x18.trip.CancelTripResponse\x12Q\n" +
+	"\x10AddPaymentMethod\x12\x1d.trip.AddPaymentMethodRequest\x1a\x1e.trip.AddPaymentMethodResponse\x12W\n" +
+	"\x12ListPaymentMethods\x12\x1f.trip.ListPaymentMethodsRequest\x1a .trip.ListPaymentMethodsResponse\x12Z\n" +
+	"\x13DeletePaymentMethod\x12 .trip.DeletePaymentMethodRequest\x1a!.trip.DeletePaymentMethodResponse\x12H\n" +
+	"\rRefundPayment\x12\x1a.trip.RefundPaymentRequest\x1a\x1b.trip.RefundPaymentResponse\x12<\n" +
+	"\tGetWallet\x12\x16.trip.GetWalletRequest\x1a\x17.trip.GetWalletResponse\x12B\n" +
+	"\vTopUpWallet\x12\x18.trip.TopUpWalletRequest\x1a\x19.trip.TopUpWalletResponse\x12E\n" +
+	"\fCreditWallet\x12\x19.trip.CreditWalletRequest\x1a\x1a.trip.CreditWalletResponse\x12W\n" +
+	"\x12ListJournalEntries\x12\x1f.trip.ListJournalEntriesRequest\x1a .trip.ListJournalEntriesResponse\x12N\n" +
+	"\x0fCreatePromoCode\x12\x1c.trip.CreatePromoCodeRequest\x1a\x1d.trip.CreatePromoCodeResponse\x12Z\n" +
+	"\x13DeactivatePromoCode\x12 .trip.DeactivatePromoCodeRequest\x1a!.trip.DeactivatePromoCodeResponse\x12K\n" +
+	"\x0eListPromoCodes\x12\x1b.trip.ListPromoCodesRequest\x1a\x1c.trip.ListPromoCodesResponse\x129\n" +
+	"\bRateTrip\x12\x15.trip.RateTripRequest\x1a\x16.trip.RateTripResponse\x12<\n" +
+	"\tGetRating\x12\x16.trip.GetRatingRequest\x1a\x17.trip.GetRatingResponse\x123\n" +
+	"\x06AddTip\x12\x13.trip.AddTipRequest\x1a\x14.trip.AddTipResponse\x12?\n" +
+	"\n" +
+	"GetReceipt\x12\x17.trip.GetReceiptRequest\x1a\x18.trip.GetReceiptResponseB\x18Z\x16shared/proto/trip;tripb\x06proto3"
 
 var (
 	file_proto_trip_proto_rawDescOnce sync.Once
@@ -741,42 +3749,152 @@ func file_proto_trip_proto_rawDescGZIP() []byte {
 	return file_proto_trip_proto_rawDescData
 }
 
-var file_proto_trip_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_trip_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_proto_trip_proto_goTypes = []any{
-	(PackageSlug)(0),            // 0: trip.PackageSlug
-	(*PreviewTripRequest)(nil),  // 1: trip.PreviewTripRequest
-	(*PreviewTripResponse)(nil), // 2: trip.PreviewTripResponse
-	(*CreateTripRequest)(nil),   // 3: trip.CreateTripRequest
-	(*CreateTripResponse)(nil),  // 4: trip.CreateTripResponse
-	(*Coordinate)(nil),          // 5: trip.Coordinate
-	(*Geometry)(nil),            // 6: trip.Geometry
-	(*Route)(nil),               // 7: trip.Route
-	(*RideFare)(nil),            // 8: trip.RideFare
-	(*Trip)(nil),                // 9: trip.Trip
-	(*TripDriver)(nil),          // 10: trip.TripDriver
+	(DiscountType)(0),                   // 0: trip.DiscountType
+	(PackageSlug)(0),                    // 1: trip.PackageSlug
+	(*PreviewTripRequest)(nil),          // 2: trip.PreviewTripRequest
+	(*PreviewTripResponse)(nil),         // 3: trip.PreviewTripResponse
+	(*CreateTripRequest)(nil),           // 4: trip.CreateTripRequest
+	(*CreateTripResponse)(nil),          // 5: trip.CreateTripResponse
+	(*StartTripRequest)(nil),            // 6: trip.StartTripRequest
+	(*StartTripResponse)(nil),           // 7: trip.StartTripResponse
+	(*CompleteTripRequest)(nil),         // 8: trip.CompleteTripRequest
+	(*CompleteTripResponse)(nil),        // 9: trip.CompleteTripResponse
+	(*ArriveAtPickupRequest)(nil),       // 10: trip.ArriveAtPickupRequest
+	(*ArriveAtPickupResponse)(nil),      // 11: trip.ArriveAtPickupResponse
+	(*CancelTripRequest)(nil),           // 12: trip.CancelTripRequest
+	(*CancelTripResponse)(nil),          // 13: trip.CancelTripResponse
+	(*Cancellation)(nil),                // 14: trip.Cancellation
+	(*PaymentMethod)(nil),               // 15: trip.PaymentMethod
+	(*AddPaymentMethodRequest)(nil),     // 16: trip.AddPaymentMethodRequest
+	(*AddPaymentMethodResponse)(nil),    // 17: trip.AddPaymentMethodResponse
+	(*ListPaymentMethodsRequest)(nil),   // 18: trip.ListPaymentMethodsRequest
+	(*ListPaymentMethodsResponse)(nil),  // 19: trip.ListPaymentMethodsResponse
+	(*DeletePaymentMethodRequest)(nil),  // 20: trip.DeletePaymentMethodRequest
+	(*DeletePaymentMethodResponse)(nil), // 21: trip.DeletePaymentMethodResponse
+	(*Payment)(nil),                     // 22: trip.Payment
+	(*RefundPaymentRequest)(nil),        // 23: trip.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),       // 24: trip.RefundPaymentResponse
+	(*Posting)(nil),                     // 25: trip.Posting
+	(*JournalEntry)(nil),                // 26: trip.JournalEntry
+	(*GetWalletRequest)(nil),            // 27: trip.GetWalletRequest
+	(*GetWalletResponse)(nil),           // 28: trip.GetWalletResponse
+	(*TopUpWalletRequest)(nil),          // 29: trip.TopUpWalletRequest
+	(*TopUpWalletResponse)(nil),         // 30: trip.TopUpWalletResponse
+	(*CreditWalletRequest)(nil),         // 31: trip.CreditWalletRequest
+	(*CreditWalletResponse)(nil),        // 32: trip.CreditWalletResponse
+	(*ListJournalEntriesRequest)(nil),   // 33: trip.ListJournalEntriesRequest
+	(*ListJournalEntriesResponse)(nil),  // 34: trip.ListJournalEntriesResponse
+	(*PromoCode)(nil),                   // 35: trip.PromoCode
+	(*CreatePromoCodeRequest)(nil),      // 36: trip.CreatePromoCodeRequest
+	(*CreatePromoCodeResponse)(nil),     // 37: trip.CreatePromoCodeResponse
+	(*DeactivatePromoCodeRequest)(nil),  // 38: trip.DeactivatePromoCodeRequest
+	(*DeactivatePromoCodeResponse)(nil), // 39: trip.DeactivatePromoCodeResponse
+	(*ListPromoCodesRequest)(nil),       // 40: trip.ListPromoCodesRequest
+	(*ListPromoCodesResponse)(nil),      // 41: trip.ListPromoCodesResponse
+	(*Rating)(nil),                      // 42: trip.Rating
+	(*RatingSummary)(nil),               // 43: trip.RatingSummary
+	(*RateTripRequest)(nil),             // 44: trip.RateTripRequest
+	(*RateTripResponse)(nil),            // 45: trip.RateTripResponse
+	(*GetRatingRequest)(nil),            // 46: trip.GetRatingRequest
+	(*GetRatingResponse)(nil),           // 47: trip.GetRatingResponse
+	(*Tip)(nil),                         // 48: trip.Tip
+	(*AddTipRequest)(nil),               // 49: trip.AddTipRequest
+	(*AddTipResponse)(nil),              // 50: trip.AddTipResponse
+	(*GetReceiptRequest)(nil),           // 51: trip.GetReceiptRequest
+	(*GetReceiptResponse)(nil),          // 52: trip.GetReceiptResponse
+	(*Coordinate)(nil),                  // 53: trip.Coordinate
+	(*Geometry)(nil),                    // 54: trip.Geometry
+	(*Route)(nil),                       // 55: trip.Route
+	(*RideFare)(nil),                    // 56: trip.RideFare
+	(*Trip)(nil),                        // 57: trip.Trip
+	(*TripDriver)(nil),                  // 58: trip.TripDriver
 }
 var file_proto_trip_proto_depIdxs = []int32{
-	5,  // 0: trip.PreviewTripRequest.startLocation:type_name -> trip.Coordinate
-	5,  // 1: trip.PreviewTripRequest.endLocation:type_name -> trip.Coordinate
-	7,  // 2: trip.PreviewTripResponse.route:type_name -> trip.Route
-	8,  // 3: trip.PreviewTripResponse.rideFares:type_name -> trip.RideFare
-	9,  // 4: trip.CreateTripResponse.trip:type_name -> trip.Trip
-	5,  // 5: trip.Geometry.coordinates:type_name -> trip.Coordinate
-	6,  // 6: trip.Route.geometry:type_name -> trip.Geometry
-	0,  // 7: trip.RideFare.packageSlug:type_name -> trip.PackageSlug
-	8,  // 8: trip.Trip.selectedFare:type_name -> trip.RideFare
-	7,  // 9: trip.Trip.route:type_name -> trip.Route
-	10, // 10: trip.Trip.driver:type_name -> trip.TripDriver
-	1,  // 11: trip.TripService.PreviewTrip:input_type -> trip.PreviewTripRequest
-	3,  // 12: trip.TripService.CreateTrip:input_type -> trip.CreateTripRequest
-	2,  // 13: trip.TripService.PreviewTrip:output_type -> trip.PreviewTripResponse
-	4,  // 14: trip.TripService.CreateTrip:output_type -> trip.CreateTripResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	53, // 0: trip.PreviewTripRequest.startLocation:type_name -> trip.Coordinate
+	53, // 1: trip.PreviewTripRequest.endLocation:type_name -> trip.Coordinate
+	55, // 2: trip.PreviewTripResponse.route:type_name -> trip.Route
+	56, // 3: trip.PreviewTripResponse.rideFares:type_name -> trip.RideFare
+	57, // 4: trip.CreateTripResponse.trip:type_name -> trip.Trip
+	57, // 5: trip.StartTripResponse.trip:type_name -> trip.Trip
+	57, // 6: trip.CompleteTripResponse.trip:type_name -> trip.Trip
+	57, // 7: trip.ArriveAtPickupResponse.trip:type_name -> trip.Trip
+	57, // 8: trip.CancelTripResponse.trip:type_name -> trip.Trip
+	15, // 9: trip.AddPaymentMethodResponse.paymentMethod:type_name -> trip.PaymentMethod
+	15, // 10: trip.ListPaymentMethodsResponse.paymentMethods:type_name -> trip.PaymentMethod
+	22, // 11: trip.RefundPaymentResponse.payment:type_name -> trip.Payment
+	25, // 12: trip.JournalEntry.postings:type_name -> trip.Posting
+	26, // 13: trip.GetWalletResponse.entries:type_name -> trip.JournalEntry
+	26, // 14: trip.TopUpWalletResponse.entry:type_name -> trip.JournalEntry
+	26, // 15: trip.CreditWalletResponse.entry:type_name -> trip.JournalEntry
+	26, // 16: trip.ListJournalEntriesResponse.entries:type_name -> trip.JournalEntry
+	0,  // 17: trip.PromoCode.discountType:type_name -> trip.DiscountType
+	1,  // 18: trip.PromoCode.packages:type_name -> trip.PackageSlug
+	35, // 19: trip.CreatePromoCodeRequest.promoCode:type_name -> trip.PromoCode
+	35, // 20: trip.CreatePromoCodeResponse.promoCode:type_name -> trip.PromoCode
+	35, // 21: trip.DeactivatePromoCodeResponse.promoCode:type_name -> trip.PromoCode
+	35, // 22: trip.ListPromoCodesResponse.promoCodes:type_name -> trip.PromoCode
+	42, // 23: trip.RateTripResponse.rating:type_name -> trip.Rating
+	43, // 24: trip.GetRatingResponse.summary:type_name -> trip.RatingSummary
+	48, // 25: trip.AddTipResponse.tip:type_name -> trip.Tip
+	53, // 26: trip.Geometry.coordinates:type_name -> trip.Coordinate
+	54, // 27: trip.Route.geometry:type_name -> trip.Geometry
+	1,  // 28: trip.RideFare.packageSlug:type_name -> trip.PackageSlug
+	56, // 29: trip.Trip.selectedFare:type_name -> trip.RideFare
+	55, // 30: trip.Trip.route:type_name -> trip.Route
+	58, // 31: trip.Trip.driver:type_name -> trip.TripDriver
+	53, // 32: trip.Trip.pickup:type_name -> trip.Coordinate
+	53, // 33: trip.Trip.destination:type_name -> trip.Coordinate
+	14, // 34: trip.Trip.cancellation:type_name -> trip.Cancellation
+	2,  // 35: trip.TripService.PreviewTrip:input_type -> trip.PreviewTripRequest
+	4,  // 36: trip.TripService.CreateTrip:input_type -> trip.CreateTripRequest
+	6,  // 37: trip.TripService.StartTrip:input_type -> trip.StartTripRequest
+	8,  // 38: trip.TripService.CompleteTrip:input_type -> trip.CompleteTripRequest
+	10, // 39: trip.TripService.ArriveAtPickup:input_type -> trip.ArriveAtPickupRequest
+	12, // 40: trip.TripService.CancelTrip:input_type -> trip.CancelTripRequest
+	16, // 41: trip.TripService.AddPaymentMethod:input_type -> trip.AddPaymentMethodRequest
+	18, // 42: trip.TripService.ListPaymentMethods:input_type -> trip.ListPaymentMethodsRequest
+	20, // 43: trip.TripService.DeletePaymentMethod:input_type -> trip.DeletePaymentMethodRequest
+	23, // 44: trip.TripService.RefundPayment:input_type -> trip.RefundPaymentRequest
+	27, // 45: trip.TripService.GetWallet:input_type -> trip.GetWalletRequest
+	29, // 46: trip.TripService.TopUpWallet:input_type -> trip.TopUpWalletRequest
+	31, // 47: trip.TripService.CreditWallet:input_type -> trip.CreditWalletRequest
+	33, // 48: trip.TripService.ListJournalEntries:input_type -> trip.ListJournalEntriesRequest
+	36, // 49: trip.TripService.CreatePromoCode:input_type -> trip.CreatePromoCodeRequest
+	38, // 50: trip.TripService.DeactivatePromoCode:input_type -> trip.DeactivatePromoCodeRequest
+	40, // 51: trip.TripService.ListPromoCodes:input_type -> trip.ListPromoCodesRequest
+	44, // 52: trip.TripService.RateTrip:input_type -> trip.RateTripRequest
+	46, // 53: trip.TripService.GetRating:input_type -> trip.GetRatingRequest
+	49, // 54: trip.TripService.AddTip:input_type -> trip.AddTipRequest
+	51, // 55: trip.TripService.GetReceipt:input_type -> trip.GetReceiptRequest
+	3,  // 56: trip.TripService.PreviewTrip:output_type -> trip.PreviewTripResponse
+	5,  // 57: trip.TripService.CreateTrip:output_type -> trip.CreateTripResponse
+	7,  // 58: trip.TripService.StartTrip:output_type -> trip.StartTripResponse
+	9,  // 59: trip.TripService.CompleteTrip:output_type -> trip.CompleteTripResponse
+	11, // 60: trip.TripService.ArriveAtPickup:output_type -> trip.ArriveAtPickupResponse
+	13, // 61: trip.TripService.CancelTrip:output_type -> trip.CancelTripResponse
+	17, // 62: trip.TripService.AddPaymentMethod:output_type -> trip.AddPaymentMethodResponse
+	19, // 63: trip.TripService.ListPaymentMethods:output_type -> trip.ListPaymentMethodsResponse
+	21, // 64: trip.TripService.DeletePaymentMethod:output_type -> trip.DeletePaymentMethodResponse
+	24, // 65: trip.TripService.RefundPayment:output_type -> trip.RefundPaymentResponse
+	28, // 66: trip.TripService.GetWallet:output_type -> trip.GetWalletResponse
+	30, // 67: trip.TripService.TopUpWallet:output_type -> trip.TopUpWalletResponse
+	32, // 68: trip.TripService.CreditWallet:output_type -> trip.CreditWalletResponse
+	34, // 69: trip.TripService.ListJournalEntries:output_type -> trip.ListJournalEntriesResponse
+	37, // 70: trip.TripService.CreatePromoCode:output_type -> trip.CreatePromoCodeResponse
+	39, // 71: trip.TripService.DeactivatePromoCode:output_type -> trip.DeactivatePromoCodeResponse
+	41, // 72: trip.TripService.ListPromoCodes:output_type -> trip.ListPromoCodesResponse
+	45, // 73: trip.TripService.RateTrip:output_type -> trip.RateTripResponse
+	47, // 74: trip.TripService.GetRating:output_type -> trip.GetRatingResponse
+	50, // 75: trip.TripService.AddTip:output_type -> trip.AddTipResponse
+	52, // 76: trip.TripService.GetReceipt:output_type -> trip.GetReceiptResponse
+	56, // [56:77] is the sub-list for method output_type
+	35, // [35:56] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_proto_trip_proto_init() }
@@ -784,13 +3902,14 @@ func file_proto_trip_proto_init() {
 	if File_proto_trip_proto != nil {
 		return
 	}
+	file_proto_trip_proto_msgTypes[54].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_trip_proto_rawDesc), len(file_proto_trip_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
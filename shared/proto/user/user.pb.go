@@ -76,6 +76,8 @@ type CreateUserRequest struct {
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	Type          UserType               `protobuf:"varint,4,opt,name=type,proto3,enum=user.UserType" json:"type,omitempty"`
+	ReferralCode  string                 `protobuf:"bytes,5,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"` // optional, the code of the user who invited this one
+	DeviceId      string                 `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`             // identifies the device the account is created from
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -138,6 +140,20 @@ func (x *CreateUserRequest) GetType() UserType {
 	return UserType_USER_TYPE_UNSPECIFIED
 }
 
+func (x *CreateUserRequest) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -578,16 +594,146 @@ func (x *User) GetType() UserType {
 	return UserType_USER_TYPE_UNSPECIFIED
 }
 
+type GetReferralStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralStatsRequest) Reset() {
+	*x = GetReferralStatsRequest{}
+	mi := &file_proto_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralStatsRequest) ProtoMessage() {}
+
+func (x *GetReferralStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReferralStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetReferralStatsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetReferralStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferralCode  string                 `protobuf:"bytes,1,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	Invited       int32                  `protobuf:"varint,2,opt,name=invited,proto3" json:"invited,omitempty"`
+	Pending       int32                  `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"` // signed up, waiting for their first completed trip
+	Rewarded      int32                  `protobuf:"varint,4,opt,name=rewarded,proto3" json:"rewarded,omitempty"`
+	Rejected      int32                  `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	EarnedCents   int64                  `protobuf:"varint,6,opt,name=earned_cents,json=earnedCents,proto3" json:"earned_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralStatsResponse) Reset() {
+	*x = GetReferralStatsResponse{}
+	mi := &file_proto_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralStatsResponse) ProtoMessage() {}
+
+func (x *GetReferralStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReferralStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetReferralStatsResponse) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+func (x *GetReferralStatsResponse) GetInvited() int32 {
+	if x != nil {
+		return x.Invited
+	}
+	return 0
+}
+
+func (x *GetReferralStatsResponse) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *GetReferralStatsResponse) GetRewarded() int32 {
+	if x != nil {
+		return x.Rewarded
+	}
+	return 0
+}
+
+func (x *GetReferralStatsResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *GetReferralStatsResponse) GetEarnedCents() int64 {
+	if x != nil {
+		return x.EarnedCents
+	}
+	return 0
+}
+
 var File_proto_user_proto protoreflect.FileDescriptor
 
 const file_proto_user_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/user.proto\x12\x04user\"}\n" +
+	"\x10proto/user.proto\x12\x04user\"\xbf\x01\n" +
 	"\x11CreateUserRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\"\n" +
-	"\x04type\x18\x04 \x01(\x0e2\x0e.user.UserTypeR\x04type\"$\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x0e.user.UserTypeR\x04type\x12#\n" +
+	"\rreferral_code\x18\x05 \x01(\tR\freferralCode\x12\x1b\n" +
+	"\tdevice_id\x18\x06 \x01(\tR\bdeviceId\"$\n" +
 	"\x12CreateUserResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
@@ -614,18 +760,28 @@ const file_proto_user_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12'\n" +
 	"\x0fpassword_hashed\x18\x04 \x01(\tR\x0epasswordHashed\x12\"\n" +
-	"\x04type\x18\x05 \x01(\x0e2\x0e.user.UserTypeR\x04type*@\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x0e.user.UserTypeR\x04type\"1\n" +
+	"\x17GetReferralStatsRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\xce\x01\n" +
+	"\x18GetReferralStatsResponse\x12#\n" +
+	"\rreferral_code\x18\x01 \x01(\tR\freferralCode\x12\x18\n" +
+	"\ainvited\x18\x02 \x01(\x05R\ainvited\x12\x18\n" +
+	"\apending\x18\x03 \x01(\x05R\apending\x12\x1a\n" +
+	"\brewarded\x18\x04 \x01(\x05R\brewarded\x12\x1a\n" +
+	"\brejected\x18\x05 \x01(\x05R\brejected\x12!\n" +
+	"\fearned_cents\x18\x06 \x01(\x03R\vearnedCents*@\n" +
 	"\bUserType\x12\x19\n" +
 	"\x15USER_TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06DRIVER\x10\x01\x12\r\n" +
-	"\tPASSENGER\x10\x022\xfc\x01\n" +
+	"\tPASSENGER\x10\x022\xcf\x02\n" +
 	"\vUserService\x12?\n" +
 	"\n" +
 	"CreateUser\x12\x17.user.CreateUserRequest\x1a\x18.user.CreateUserResponse\x120\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x13.user.LoginResponse\x123\n" +
 	"\x06Logout\x12\x13.user.LogoutRequest\x1a\x14.user.LogoutResponse\x12E\n" +
-	"\fRefreshToken\x12\x19.user.RefreshTokenRequest\x1a\x1a.user.RefreshTokenResponseB\x18Z\x16shared/proto/user;userb\x06proto3"
+	"\fRefreshToken\x12\x19.user.RefreshTokenRequest\x1a\x1a.user.RefreshTokenResponse\x12Q\n" +
+	"\x10GetReferralStats\x12\x1d.user.GetReferralStatsRequest\x1a\x1e.user.GetReferralStatsResponseB\x18Z\x16shared/proto/user;userb\x06proto3"
 
 var (
 	file_proto_user_proto_rawDescOnce sync.Once
@@ -640,35 +796,39 @@ func file_proto_user_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_proto_user_proto_goTypes = []any{
-	(UserType)(0),                // 0: user.UserType
-	(*CreateUserRequest)(nil),    // 1: user.CreateUserRequest
-	(*CreateUserResponse)(nil),   // 2: user.CreateUserResponse
-	(*LoginRequest)(nil),         // 3: user.LoginRequest
-	(*LoginResponse)(nil),        // 4: user.LoginResponse
-	(*LogoutRequest)(nil),        // 5: user.LogoutRequest
-	(*LogoutResponse)(nil),       // 6: user.LogoutResponse
-	(*RefreshTokenRequest)(nil),  // 7: user.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil), // 8: user.RefreshTokenResponse
-	(*User)(nil),                 // 9: user.User
+	(UserType)(0),                    // 0: user.UserType
+	(*CreateUserRequest)(nil),        // 1: user.CreateUserRequest
+	(*CreateUserResponse)(nil),       // 2: user.CreateUserResponse
+	(*LoginRequest)(nil),             // 3: user.LoginRequest
+	(*LoginResponse)(nil),            // 4: user.LoginResponse
+	(*LogoutRequest)(nil),            // 5: user.LogoutRequest
+	(*LogoutResponse)(nil),           // 6: user.LogoutResponse
+	(*RefreshTokenRequest)(nil),      // 7: user.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),     // 8: user.RefreshTokenResponse
+	(*User)(nil),                     // 9: user.User
+	(*GetReferralStatsRequest)(nil),  // 10: user.GetReferralStatsRequest
+	(*GetReferralStatsResponse)(nil), // 11: user.GetReferralStatsResponse
 }
 var file_proto_user_proto_depIdxs = []int32{
-	0, // 0: user.CreateUserRequest.type:type_name -> user.UserType
-	0, // 1: user.User.type:type_name -> user.UserType
-	1, // 2: user.UserService.CreateUser:input_type -> user.CreateUserRequest
-	3, // 3: user.UserService.Login:input_type -> user.LoginRequest
-	5, // 4: user.UserService.Logout:input_type -> user.LogoutRequest
-	7, // 5: user.UserService.RefreshToken:input_type -> user.RefreshTokenRequest
-	2, // 6: user.UserService.CreateUser:output_type -> user.CreateUserResponse
-	4, // 7: user.UserService.Login:output_type -> user.LoginResponse
-	6, // 8: user.UserService.Logout:output_type -> user.LogoutResponse
-	8, // 9: user.UserService.RefreshToken:output_type -> user.RefreshTokenResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: user.CreateUserRequest.type:type_name -> user.UserType
+	0,  // 1: user.User.type:type_name -> user.UserType
+	1,  // 2: user.UserService.CreateUser:input_type -> user.CreateUserRequest
+	3,  // 3: user.UserService.Login:input_type -> user.LoginRequest
+	5,  // 4: user.UserService.Logout:input_type -> user.LogoutRequest
+	7,  // 5: user.UserService.RefreshToken:input_type -> user.RefreshTokenRequest
+	10, // 6: user.UserService.GetReferralStats:input_type -> user.GetReferralStatsRequest
+	2,  // 7: user.UserService.CreateUser:output_type -> user.CreateUserResponse
+	4,  // 8: user.UserService.Login:output_type -> user.LoginResponse
+	6,  // 9: user.UserService.Logout:output_type -> user.LogoutResponse
+	8,  // 10: user.UserService.RefreshToken:output_type -> user.RefreshTokenResponse
+	11, // 11: user.UserService.GetReferralStats:output_type -> user.GetReferralStatsResponse
+	7,  // [7:12] is the sub-list for method output_type
+	2,  // [2:7] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_proto_init() }
@@ -682,7 +842,7 @@ func file_proto_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_proto_rawDesc), len(file_proto_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
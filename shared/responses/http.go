@@ -2,9 +2,12 @@ package responses
 
 import (
 	"encoding/json"
+	"go-ride/shared/contracts"
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func WriteJSON(w http.ResponseWriter, status int, data any) error {
@@ -22,3 +25,35 @@ func ParseValidationErrors(err error) map[string]string {
 	}
 	return errors
 }
+
+// WriteGRPCError answers with the HTTP status matching the gRPC error of a downstream service.
+// Internal errors hide their details behind the fallback message.
+func WriteGRPCError(w http.ResponseWriter, err error, fallback string) error {
+	st := status.Convert(err)
+
+	httpStatus := http.StatusInternalServerError
+	message := fallback
+	switch st.Code() {
+	case codes.InvalidArgument:
+		httpStatus, message = http.StatusUnprocessableEntity, st.Message()
+	case codes.NotFound:
+		httpStatus, message = http.StatusNotFound, st.Message()
+	case codes.AlreadyExists:
+		httpStatus, message = http.StatusConflict, st.Message()
+	case codes.FailedPrecondition:
+		httpStatus, message = http.StatusConflict, st.Message()
+	case codes.Aborted:
+		httpStatus, message = http.StatusConflict, st.Message()
+	case codes.PermissionDenied:
+		httpStatus, message = http.StatusForbidden, st.Message()
+	case codes.Unavailable:
+		httpStatus, message = http.StatusServiceUnavailable, st.Message()
+	}
+
+	return WriteJSON(w, httpStatus, contracts.APIResponse{
+		Error: &contracts.APIError{
+			Code:    int64(httpStatus),
+			Message: message,
+		},
+	})
+}
@@ -14,10 +14,24 @@ const (
 )
 
 const (
-	ONLINE  DriverStatus = "ONLINE"
-	OFFLINE DriverStatus = "OFFLINE"
+	ONLINE             DriverStatus = "ONLINE"
+	OFFLINE            DriverStatus = "OFFLINE"
+	ON_TRIP            DriverStatus = "ON_TRIP"
+	EN_ROUTE_TO_PICKUP DriverStatus = "EN_ROUTE_TO_PICKUP"
+	PAUSED             DriverStatus = "PAUSED"
+	BREAK              DriverStatus = "BREAK"
 )
 
+// IsIdle reports whether a driver with this status can be offered a trip.
+func (s DriverStatus) IsIdle() bool {
+	return s == ONLINE
+}
+
+// IsBusy reports whether the driver is serving a trip.
+func (s DriverStatus) IsBusy() bool {
+	return s == EN_ROUTE_TO_PICKUP || s == ON_TRIP
+}
+
 func MapUserTypeDomainToProto(t UserType) pu.UserType {
 	switch t {
 	case DRIVER:
@@ -46,17 +60,37 @@ func MapDriverStatusDomainToProto(s DriverStatus) pd.DriverStatusType {
 		return pd.DriverStatusType_ONLINE
 	case OFFLINE:
 		return pd.DriverStatusType_OFFLINE
+	case ON_TRIP:
+		return pd.DriverStatusType_ON_TRIP
+	case EN_ROUTE_TO_PICKUP:
+		return pd.DriverStatusType_EN_ROUTE_TO_PICKUP
+	case PAUSED:
+		return pd.DriverStatusType_PAUSED
+	case BREAK:
+		return pd.DriverStatusType_BREAK
 	default:
 		return pd.DriverStatusType_STATUS_TYPE_UNSPECIFIED
 	}
 }
 
+// MapProtoDriverStatusToDomain maps an unspecified status to an empty DriverStatus,
+// which keeps the current status of the driver.
 func MapProtoDriverStatusToDomain(s pd.DriverStatusType) DriverStatus {
 	switch s {
 	case pd.DriverStatusType_ONLINE:
 		return ONLINE
 	case pd.DriverStatusType_OFFLINE:
 		return OFFLINE
+	case pd.DriverStatusType_ON_TRIP:
+		return ON_TRIP
+	case pd.DriverStatusType_EN_ROUTE_TO_PICKUP:
+		return EN_ROUTE_TO_PICKUP
+	case pd.DriverStatusType_PAUSED:
+		return PAUSED
+	case pd.DriverStatusType_BREAK:
+		return BREAK
+	case pd.DriverStatusType_STATUS_TYPE_UNSPECIFIED:
+		return ""
 	default:
 		return OFFLINE
 	}